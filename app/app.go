@@ -76,6 +76,16 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibc "github.com/cosmos/ibc-go/v4/modules/core"
+	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client"
+	ibcclientclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/client"
+	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcporttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cast"
@@ -103,6 +113,7 @@ import (
 	customparamskeeper "github.com/CoreumFoundation/coreum/x/customparams/keeper"
 	customparamstypes "github.com/CoreumFoundation/coreum/x/customparams/types"
 	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	deterministicgaskeeper "github.com/CoreumFoundation/coreum/x/deterministicgas/keeper"
 	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	"github.com/CoreumFoundation/coreum/x/feemodel"
 	feemodelkeeper "github.com/CoreumFoundation/coreum/x/feemodel/keeper"
@@ -112,6 +123,7 @@ import (
 	wasmcustomhandler "github.com/CoreumFoundation/coreum/x/wasm/handler"
 	"github.com/CoreumFoundation/coreum/x/wbank"
 	wbankkeeper "github.com/CoreumFoundation/coreum/x/wbank/keeper"
+	"github.com/CoreumFoundation/coreum/x/wibctransfer"
 	"github.com/CoreumFoundation/coreum/x/wnft"
 	wnftkeeper "github.com/CoreumFoundation/coreum/x/wnft/keeper"
 	"github.com/CoreumFoundation/coreum/x/wstaking"
@@ -151,6 +163,8 @@ var (
 				distrclient.ProposalHandler,
 				upgradeclient.ProposalHandler,
 				upgradeclient.CancelProposalHandler,
+				ibcclientclient.UpdateClientProposalHandler,
+				ibcclientclient.UpgradeProposalHandler,
 			}, wasmclient.ProposalHandlers...)...,
 		),
 		params.AppModuleBasic{},
@@ -166,6 +180,9 @@ var (
 		assetft.AppModuleBasic{},
 		assetnft.AppModuleBasic{},
 		customparams.AppModuleBasic{},
+		deterministicgas.AppModuleBasic{},
+		ibc.AppModuleBasic{},
+		wibctransfer.AppModuleBasic{},
 	)
 
 	// module account permissions.
@@ -179,6 +196,7 @@ var (
 		wasm.ModuleName:                {authtypes.Burner},
 		assetfttypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		assetnfttypes.ModuleName:       {authtypes.Burner},
+		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		nft.ModuleName:                 {}, // the line is required by the nft module to have the module account stored in the account keeper
 	}
 )
@@ -229,6 +247,8 @@ type App struct {
 	EvidenceKeeper   evidencekeeper.Keeper
 	FeeGrantKeeper   feegrantkeeper.Keeper
 	WASMKeeper       wasm.Keeper
+	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	TransferKeeper   ibctransferkeeper.Keeper
 
 	AssetFTKeeper      assetftkeeper.Keeper
 	AssetNFTKeeper     assetnftkeeper.Keeper
@@ -237,8 +257,12 @@ type App struct {
 	NFTKeeper          wnftkeeper.Wrapper
 	CustomParamsKeeper customparamskeeper.Keeper
 
+	DeterministicGasKeeper deterministicgaskeeper.Keeper
+
 	// make scoped keepers public for test purposes
-	ScopedWASMKeeper capabilitykeeper.ScopedKeeper
+	ScopedWASMKeeper     capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 
 	// mm is the module manager
 	mm *module.Manager
@@ -264,7 +288,6 @@ func New(
 	appOpts servertypes.AppOptions,
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) *App {
-	deterministicGasConfig := deterministicgas.DefaultConfig()
 	appCodec := encodingConfig.Codec
 	cdc := encodingConfig.Amino
 	interfaceRegistry := encodingConfig.InterfaceRegistry
@@ -273,7 +296,6 @@ func New(
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(interfaceRegistry)
-	bApp.SetRouter(deterministicgastypes.NewDeterministicGasRouter(bApp.Router(), deterministicGasConfig))
 
 	keys := sdk.NewKVStoreKeys(
 		authtypes.StoreKey, authz.ModuleName, banktypes.StoreKey, stakingtypes.StoreKey,
@@ -281,6 +303,7 @@ func New(
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
 		wasm.StoreKey, feemodeltypes.StoreKey, assetfttypes.StoreKey, assetnfttypes.StoreKey, nftkeeper.StoreKey,
+		ibchost.StoreKey, ibctransfertypes.StoreKey, deterministicgastypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, feemodeltypes.TransientStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -301,9 +324,21 @@ func New(
 	// set the BaseApp's parameter store
 	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramskeeper.ConsensusParamsKeyTable()))
 
+	// NOTE: the wasm keeper is created later, so the pointer to it is passed here
+	app.DeterministicGasKeeper = deterministicgaskeeper.NewKeeper(
+		appCodec,
+		keys[deterministicgastypes.StoreKey],
+		app.GetSubspace(deterministicgastypes.ModuleName),
+		&app.WASMKeeper,
+	)
+	deterministicGasConfigProvider := deterministicgas.NewConfigProvider(app.DeterministicGasKeeper)
+	bApp.SetRouter(deterministicgastypes.NewDeterministicGasRouter(bApp.Router(), deterministicGasConfigProvider))
+
 	// add capability keeper and ScopeToModule for ibc module
 	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 
+	app.ScopedIBCKeeper = app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
+	app.ScopedTransferKeeper = app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	app.ScopedWASMKeeper = app.CapabilityKeeper.ScopeToModule(wasm.ModuleName)
 
 	// add keepers
@@ -322,6 +357,9 @@ func New(
 		keys[assetfttypes.StoreKey],
 		// for the assetft we use the clear bank keeper without the assets integration to prevent cycling calls.
 		originalBankKeeper,
+		app.AccountKeeper,
+		// the IBC keeper is created later, since it depends on the staking keeper using the bank keeper
+		ibcChannelKeeper{app: app},
 	)
 
 	app.BankKeeper = wbankkeeper.NewKeeper(
@@ -357,6 +395,18 @@ func New(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
+	app.IBCKeeper = ibckeeper.NewKeeper(
+		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, app.ScopedIBCKeeper,
+	)
+
+	// the transfer keeper uses the bank keeper with the assets integration, so the ICS-20 packets are processed
+	// according to the fungible token rules.
+	app.TransferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, app.ScopedTransferKeeper,
+	)
+
 	app.FeeModelKeeper = feemodelkeeper.NewKeeper(
 		app.GetSubspace(feemodeltypes.ModuleName).WithKeyTable(paramstypes.NewKeyTable().RegisterParamSet(&feemodeltypes.Params{})),
 		keys[feemodeltypes.StoreKey],
@@ -368,11 +418,13 @@ func New(
 	nftKeeper := nftkeeper.NewKeeper(keys[nftkeeper.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
 	app.AssetNFTKeeper = assetnftkeeper.NewKeeper(
 		appCodec,
+		app.interfaceRegistry,
 		app.GetSubspace(assetnfttypes.ModuleName).WithKeyTable(paramstypes.NewKeyTable().RegisterParamSet(&assetnfttypes.Params{})),
 		keys[assetnfttypes.StoreKey],
 		nftKeeper,
-		// for the assetnft we use the clear bank keeper without the assets integration because it interacts only with native token.
-		originalBankKeeper,
+		// the price of the NFT transferred with price might be paid with the fungible token, so we use the bank keeper
+		// with the assets integration.
+		app.BankKeeper,
 	)
 
 	app.NFTKeeper = wnftkeeper.NewWrappedNFTKeeper(nftKeeper, app.AssetNFTKeeper)
@@ -382,7 +434,8 @@ func New(
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
 
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -418,10 +471,10 @@ func New(
 		app.BankKeeper,
 		app.StakingKeeper,
 		app.DistrKeeper,
-		nil,
-		nil,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
 		app.ScopedWASMKeeper,
-		nil,
+		app.TransferKeeper,
 		app.MsgServiceRouter(),
 		app.GRPCQueryRouter(),
 		wasmDir,
@@ -432,6 +485,11 @@ func New(
 
 	govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WASMKeeper, wasm.EnableAllProposals))
 
+	ibcRouter := ibcporttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, wibctransfer.NewIBCModule(app.TransferKeeper))
+	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WASMKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper))
+	app.IBCKeeper.SetRouter(ibcRouter)
+
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -450,9 +508,13 @@ func New(
 	wnftModule := wnft.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry)
 
 	customParamsModule := customparams.NewAppModule(app.CustomParamsKeeper)
+	deterministicGasModule := deterministicgas.NewAppModule(appCodec, app.DeterministicGasKeeper, app.AccountKeeper)
 
 	wstakingModule := wstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.CustomParamsKeeper)
 
+	ibcModule := ibc.NewAppModule(app.IBCKeeper)
+	transferModule := wibctransfer.NewAppModule(app.TransferKeeper)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 
@@ -482,6 +544,9 @@ func New(
 		assetNFTModule,
 		wnftModule,
 		customParamsModule,
+		deterministicGasModule,
+		ibcModule,
+		transferModule,
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -511,6 +576,9 @@ func New(
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
+		deterministicgastypes.ModuleName,
+		ibchost.ModuleName,
+		ibctransfertypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -536,6 +604,9 @@ func New(
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
+		deterministicgastypes.ModuleName,
+		ibchost.ModuleName,
+		ibctransfertypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -543,8 +614,11 @@ func New(
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
+	// NOTE: The deterministicgas module must occur before genutil because the genesis
+	// transactions are charged by the deterministic gas ante handler.
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName,
+		deterministicgastypes.ModuleName,
 		authtypes.ModuleName,
 		authz.ModuleName,
 		banktypes.ModuleName,
@@ -566,13 +640,15 @@ func New(
 		nft.ModuleName,
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
+		ibchost.ModuleName,
+		ibctransfertypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 
 	app.configurator = module.NewConfigurator(app.appCodec,
-		deterministicgastypes.NewDeterministicMsgServer(app.MsgServiceRouter(), deterministicGasConfig), app.GRPCQueryRouter())
+		deterministicgastypes.NewDeterministicMsgServer(app.MsgServiceRouter(), deterministicGasConfigProvider), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -595,6 +671,7 @@ func New(
 		assetNFTModule,
 		wnftModule,
 		customParamsModule,
+		deterministicGasModule,
 	)
 	app.sm.RegisterStoreDecoders()
 
@@ -609,13 +686,14 @@ func New(
 
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			DeterministicGasConfig: deterministicGasConfig,
-			AccountKeeper:          app.AccountKeeper,
-			BankKeeper:             app.BankKeeper,
-			SignModeHandler:        encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:         app.FeeGrantKeeper,
-			FeeModelKeeper:         app.FeeModelKeeper,
-			WasmTXCounterStoreKey:  keys[wasm.StoreKey],
+			DeterministicGasConfigProvider: deterministicGasConfigProvider,
+			AccountKeeper:                  app.AccountKeeper,
+			BankKeeper:                     app.BankKeeper,
+			SignModeHandler:                encodingConfig.TxConfig.SignModeHandler(),
+			FeegrantKeeper:                 app.FeeGrantKeeper,
+			FeeModelKeeper:                 app.FeeModelKeeper,
+			WasmTXCounterStoreKey:          keys[wasm.StoreKey],
+			IBCKeeper:                      app.IBCKeeper,
 		},
 	)
 	if err != nil {
@@ -717,6 +795,16 @@ func (app *App) ModuleAccountAddrs() map[string]bool {
 	return modAccAddrs
 }
 
+// ibcChannelKeeper gives access to the channel keeper of the IBC keeper created after the keepers depending on it.
+type ibcChannelKeeper struct {
+	app *App
+}
+
+// IterateChannels iterates over all the IBC channels.
+func (k ibcChannelKeeper) IterateChannels(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool) {
+	k.app.IBCKeeper.ChannelKeeper.IterateChannels(ctx, cb)
+}
+
 // LegacyAmino returns SimApp's amino codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
@@ -815,6 +903,9 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(customparamstypes.CustomParamsStaking)
 	paramsKeeper.Subspace(assetfttypes.ModuleName)
 	paramsKeeper.Subspace(assetnfttypes.ModuleName)
+	paramsKeeper.Subspace(deterministicgastypes.ModuleName)
+	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -5,11 +5,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 
 	"github.com/CoreumFoundation/coreum/app/upgrade"
 	"github.com/CoreumFoundation/coreum/pkg/config"
 	assetnftkeeper "github.com/CoreumFoundation/coreum/x/asset/nft/keeper"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	"github.com/CoreumFoundation/coreum/x/nft"
 )
 
@@ -21,9 +24,14 @@ func NewV1Upgrade(mm *module.Manager, configurator module.Configurator, chosenNe
 	return upgrade.Upgrade{
 		Name: Name,
 		StoreUpgrades: storetypes.StoreUpgrades{
-			Added: []string{assetnfttypes.ModuleName, nft.ModuleName},
+			Added: []string{
+				assetnfttypes.ModuleName, nft.ModuleName, ibchost.StoreKey, ibctransfertypes.StoreKey,
+				deterministicgastypes.StoreKey,
+			},
 		},
 		Upgrade: func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			// the modules added by the upgrade are initialized with their default genesis, so the deterministic gas
+			// params are set from deterministicgastypes.DefaultParams
 			afterVM, err := mm.RunMigrations(ctx, configurator, vm)
 			if err != nil {
 				return nil, err
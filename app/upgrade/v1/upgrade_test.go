@@ -0,0 +1,95 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/CoreumFoundation/coreum/app/upgrade/v1"
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+func TestV1Upgrade_DeterministicGasParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BeginNextBlock()
+
+	// simulate the chain launched before the deterministicgas module was introduced
+	removeModuleVersion(ctx, testApp, deterministicgastypes.ModuleName)
+	removeModuleParams(ctx, testApp, deterministicgastypes.ModuleName)
+	requireT.False(testApp.GetSubspace(deterministicgastypes.ModuleName).Has(ctx, deterministicgastypes.KeyMsgGas))
+
+	testApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{
+		Name:   v1.Name,
+		Height: ctx.BlockHeight(),
+	})
+
+	// the params, including the gas of the messages introduced after the launch, are set from the defaults
+	params := testApp.DeterministicGasKeeper.GetParams(ctx)
+	requireT.Equal(deterministicgastypes.DefaultParams(), params)
+
+	var setContractGasFound bool
+	for _, msgGas := range params.MsgGas {
+		if msgGas.TypeURL == sdk.MsgTypeURL(&deterministicgastypes.MsgSetContractGas{}) {
+			setContractGasFound = true
+		}
+	}
+	requireT.True(setContractGasFound)
+}
+
+func TestV1Upgrade_FeeModelHistoryRetention(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BeginNextBlock()
+
+	// simulate the chain launched before the min gas price history was introduced
+	testApp.UpgradeKeeper.SetModuleVersionMap(ctx, module.VersionMap{feemodeltypes.ModuleName: 1})
+	store := prefix.NewStore(
+		ctx.KVStore(testApp.GetKey(paramstypes.StoreKey)),
+		append([]byte(feemodeltypes.ModuleName), '/'),
+	)
+	store.Delete(feemodeltypes.KeyHistoryRetentionBlocks)
+
+	testApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{
+		Name:   v1.Name,
+		Height: ctx.BlockHeight(),
+	})
+
+	params := testApp.FeeModelKeeper.GetParams(ctx)
+	requireT.EqualValues(feemodeltypes.DefaultHistoryRetentionBlocks, params.HistoryRetentionBlocks)
+	requireT.Equal(feemodeltypes.DefaultParams().Model.MaxBlockGas, params.Model.MaxBlockGas)
+}
+
+func removeModuleVersion(ctx sdk.Context, testApp *simapp.App, moduleName string) {
+	store := prefix.NewStore(
+		ctx.KVStore(testApp.GetKey(upgradetypes.StoreKey)),
+		[]byte{upgradetypes.VersionMapByte},
+	)
+	store.Delete([]byte(moduleName))
+}
+
+func removeModuleParams(ctx sdk.Context, testApp *simapp.App, moduleName string) {
+	store := prefix.NewStore(
+		ctx.KVStore(testApp.GetKey(paramstypes.StoreKey)),
+		append([]byte(moduleName), '/'),
+	)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
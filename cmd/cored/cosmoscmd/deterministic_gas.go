@@ -0,0 +1,214 @@
+package cosmoscmd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	deterministicgascli "github.com/CoreumFoundation/coreum/x/deterministicgas/client/cli"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+const (
+	deterministicValue = "deterministic"
+
+	// maxDeterministicGasAttempts is the maximum number of attempts to sign the tx with the gas covering its size.
+	maxDeterministicGasAttempts = 3
+)
+
+// addDeterministicGasToAllLeafs adds the support of the deterministic gas value of the gas flag
+// to RunE function of all leaf commands in the tree of the provided command.
+func addDeterministicGasToAllLeafs(cmd *cobra.Command) {
+	if !cmd.HasSubCommands() {
+		if gasFlag := cmd.LocalFlags().Lookup(flags.FlagGas); gasFlag != nil && cmd.RunE != nil {
+			gasFlag.Usage += fmt.Sprintf(
+				"; set to %q to use the deterministic gas of the messages without simulation", deterministicValue,
+			)
+			cmd.RunE = deterministicGasRunE(cmd.RunE)
+		}
+		return
+	}
+
+	for _, cmd := range cmd.Commands() {
+		addDeterministicGasToAllLeafs(cmd)
+	}
+}
+
+// deterministicGasRunE returns the RunE function executing the command once in the generate only mode to get its
+// messages, and then generating or broadcasting the tx with the deterministic gas, instead of executing the command
+// again.
+func deterministicGasRunE(runE func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		gasFlag := cmd.LocalFlags().Lookup(flags.FlagGas)
+		if gasFlag == nil || gasFlag.Value.String() != deterministicValue {
+			return runE(cmd, args)
+		}
+
+		msgs, err := generateMsgs(cmd, args, runE)
+		if err != nil {
+			return err
+		}
+
+		res, err := deterministicgascli.QueryDeterministicGas(cmd, msgs)
+		if err != nil {
+			return err
+		}
+
+		if !res.Deterministic {
+			var nondeterministicMsgs []string
+			for _, msg := range res.Msgs {
+				if !msg.Deterministic {
+					nondeterministicMsgs = append(nondeterministicMsgs, msg.TypeURL)
+				}
+			}
+			return errors.Errorf(
+				"messages %s are nondeterministic, use --%s=%s instead",
+				strings.Join(nondeterministicMsgs, ", "), flags.FlagGas, flags.GasFlagAuto,
+			)
+		}
+
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		gas, err := txGas(cmd, clientCtx, txf, msgs, res.TotalGas)
+		if err != nil {
+			return err
+		}
+
+		return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithGas(gas), msgs...)
+	}
+}
+
+// txGas returns the deterministic gas of the tx. The gas required by the messages doesn't include the gas charged
+// for the tx bytes and signatures exceeding the free ones, so the tx is signed and its gas is queried, and it is
+// signed again with the higher gas if required, the same way as pkg/client.BroadcastTx does it.
+// The unsigned tx produced in the generate only mode gets the gas required by the messages, and in the dry run mode
+// the gas is simulated anyway.
+func txGas(cmd *cobra.Command, clientCtx client.Context, txf tx.Factory, msgs []sdk.Msg, gas uint64) (uint64, error) {
+	if clientCtx.GenerateOnly || clientCtx.Simulate {
+		return gas, nil
+	}
+
+	txf, err := txf.Prepare(clientCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	queryClient := deterministicgastypes.NewQueryClient(clientCtx)
+	for i := 0; i < maxDeterministicGasAttempts; i++ {
+		txBytes, err := signTx(clientCtx, txf.WithGas(gas), msgs)
+		if err != nil {
+			return 0, err
+		}
+
+		res, err := queryClient.DeterministicGas(cmd.Context(), &deterministicgastypes.QueryDeterministicGasRequest{
+			TxBytes: txBytes,
+		})
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		if res.TotalGas <= gas {
+			return gas, nil
+		}
+		gas = res.TotalGas
+	}
+
+	return 0, errors.Errorf("failed to sign the tx with the gas covering its size in %d attempts", maxDeterministicGasAttempts)
+}
+
+func signTx(clientCtx client.Context, txf tx.Factory, msgs []sdk.Msg) ([]byte, error) {
+	txBuilder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return nil, err
+	}
+
+	return clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// generateMsgs executes the command in the generate only mode to get the messages it produces.
+func generateMsgs(cmd *cobra.Command, args []string, runE func(cmd *cobra.Command, args []string) error) ([]sdk.Msg, error) {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	flagValues := map[string]string{
+		flags.FlagGenerateOnly: "true",
+		flags.FlagGas:          "0",
+		cli.OutputFlag:         "json",
+	}
+	// in the generate only mode the sender must be provided as an address
+	if len(clientCtx.GetFromAddress()) > 0 {
+		flagValues[flags.FlagFrom] = clientCtx.GetFromAddress().String()
+	}
+
+	restoreFlags, err := setFlags(cmd, flagValues)
+	if err != nil {
+		return nil, err
+	}
+	defer restoreFlags()
+
+	buf := &bytes.Buffer{}
+	originalClientCtx := client.GetClientContextFromCmd(cmd)
+	if err := client.SetCmdClientContext(cmd, originalClientCtx.WithOutput(buf)); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	//nolint:errcheck // the context has been already set successfully
+	defer client.SetCmdClientContext(cmd, originalClientCtx)
+
+	if err := runE(cmd, args); err != nil {
+		return nil, err
+	}
+
+	generatedTx, err := clientCtx.TxConfig.TxJSONDecoder()(buf.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode generated tx")
+	}
+
+	return generatedTx.GetMsgs(), nil
+}
+
+// setFlags sets the values of the flags and returns the function restoring the original ones.
+func setFlags(cmd *cobra.Command, values map[string]string) (func(), error) {
+	restoreFuncs := make([]func(), 0, len(values))
+	restore := func() {
+		for _, restoreFunc := range restoreFuncs {
+			restoreFunc()
+		}
+	}
+
+	for name, value := range values {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil {
+			continue
+		}
+
+		originalValue, originalChanged := flag.Value.String(), flag.Changed
+		if err := flag.Value.Set(value); err != nil {
+			restore()
+			return nil, errors.Wrapf(err, "failed to set the %s flag", name)
+		}
+		flag.Changed = true
+		restoreFuncs = append(restoreFuncs, func() {
+			flag.Value.Set(originalValue) //nolint:errcheck // the original value has been already parsed
+			flag.Changed = originalChanged
+		})
+	}
+
+	return restore, nil
+}
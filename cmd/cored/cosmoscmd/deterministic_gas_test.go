@@ -0,0 +1,99 @@
+package cosmoscmd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/CoreumFoundation/coreum/testutil/network"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+func TestDeterministicGas(t *testing.T) {
+	requireT := require.New(t)
+
+	// no bytes are free, so the gas of the tx exceeds the gas required by the message
+	cfg := network.DefaultConfig()
+	var deterministicGasState deterministicgastypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[deterministicgastypes.ModuleName], &deterministicGasState)
+	deterministicGasState.Params.FreeBytes = 0
+	cfg.GenesisState[deterministicgastypes.ModuleName] = cfg.Codec.MustMarshalJSON(&deterministicGasState)
+
+	testNetwork := network.New(t, cfg)
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := testNetwork.Config.BondDenom
+
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	args := []string{
+		"send", testNetwork.Validators[0].Address.String(), recipient.String(), fmt.Sprintf("100%s", denom),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagGas, deterministicValue),
+	}
+	bankTx := bankcli.NewTxCmd()
+	addQueryGasPriceToAllLeafs(bankTx)
+	addDeterministicGasToAllLeafs(bankTx)
+
+	bufWriter, err := clitestutil.ExecTestCLICmd(ctx, bankTx, args)
+	requireT.NoError(err)
+
+	txRes := sdk.TxResponse{}
+	requireT.NoError(ctx.Codec.UnmarshalJSON(bufWriter.Bytes(), &txRes))
+	requireT.EqualValues(0, txRes.Code, txRes.RawLog)
+	requireT.NoError(testNetwork.WaitForNextBlock())
+
+	txQuery, err := authtx.QueryTx(ctx, txRes.TxHash)
+	requireT.NoError(err)
+	requireT.EqualValues(0, txQuery.Code, txQuery.RawLog)
+
+	deterministicGasConfig := deterministicgas.NewConfig(deterministicGasState.Params)
+	msgGas, ok := deterministicGasConfig.GasRequiredByMessage(&banktypes.MsgSend{})
+	requireT.True(ok)
+	requireT.Greater(txQuery.GasWanted, int64(deterministicGasConfig.FixedGas+msgGas))
+
+	// the command is executed once, the second time the tx is broadcast with the generated messages
+	var executions int
+	sendCmd := &cobra.Command{
+		Use: "send",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			executions++
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := banktypes.NewMsgSend(
+				clientCtx.GetFromAddress(), recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(sendCmd)
+	addDeterministicGasToAllLeafs(sendCmd)
+
+	bufWriter, err = clitestutil.ExecTestCLICmd(ctx, sendCmd, []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, testNetwork.Validators[0].Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagGas, deterministicValue),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewInt64Coin(denom, 1_000_000)),
+	})
+	requireT.NoError(err)
+	requireT.Equal(1, executions)
+
+	txRes = sdk.TxResponse{}
+	requireT.NoError(ctx.Codec.UnmarshalJSON(bufWriter.Bytes(), &txRes))
+	requireT.EqualValues(0, txRes.Code, txRes.RawLog)
+	requireT.Greater(txRes.GasWanted, int64(deterministicGasConfig.FixedGas+msgGas))
+}
@@ -245,6 +245,7 @@ func txCommand(moduleBasics module.BasicManager) *cobra.Command {
 
 	moduleBasics.AddTxCommands(cmd)
 	addQueryGasPriceToAllLeafs(cmd)
+	addDeterministicGasToAllLeafs(cmd)
 
 	cmd.AddCommand(
 		authcmd.GetSignCommand(),
@@ -209,6 +209,8 @@ func TestAssetIssueAndQueryTokens(t *testing.T) {
 		Description:        msg1.Description,
 		BurnRate:           msg1.BurnRate,
 		SendCommissionRate: msg1.SendCommissionRate,
+		Admin:              issuer1.String(),
+		MaxSupply:          sdk.ZeroInt(),
 	}, gotToken.Tokens[0])
 }
 
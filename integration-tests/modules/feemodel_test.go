@@ -39,6 +39,29 @@ func TestFeeModelQueryingMinGasPrice(t *testing.T) {
 	assert.Equal(t, chain.NetworkConfig.Denom, res.MinGasPrice.Denom)
 }
 
+// TestFeeModelQueryingMinGasPriceHistory checks that it's possible to query the min gas prices recorded for the recent blocks.
+func TestFeeModelQueryingMinGasPriceHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+	requireT := require.New(t)
+
+	feemodelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+	res, err := feemodelClient.MinGasPriceHistory(ctx, &feemodeltypes.QueryMinGasPriceHistoryRequest{})
+	requireT.NoError(err)
+	requireT.NotEmpty(res.Entries)
+
+	model := feemodeltypes.NewModel(chain.NetworkConfig.Fee.FeeModel.Params())
+	for i, entry := range res.Entries {
+		if i > 0 {
+			requireT.Equal(res.Entries[i-1].Height+1, entry.Height)
+		}
+		assert.Equal(t, chain.NetworkConfig.Denom, entry.MinGasPrice.Denom)
+		assert.True(t, entry.MinGasPrice.Amount.GTE(model.CalculateGasPriceWithMaxDiscount()))
+		assert.True(t, entry.MinGasPrice.Amount.LTE(model.CalculateMaxGasPrice()))
+	}
+}
+
 // TestFeeModelProposalParamChange checks that feemodel param change proposal works correctly.
 func TestFeeModelProposalParamChange(t *testing.T) {
 	t.Parallel()
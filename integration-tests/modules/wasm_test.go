@@ -25,6 +25,7 @@ import (
 	"github.com/CoreumFoundation/coreum/testutil/event"
 	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	nfttypes "github.com/CoreumFoundation/coreum/x/nft"
 )
 
@@ -598,6 +599,95 @@ func TestUpdateAndClearAdminOfContract(t *testing.T) {
 	requireT.EqualValues(chain.GasLimitByMsgs(msgClearAdmin), res.GasUsed)
 }
 
+// TestWASMContractDeterministicGas verifies that the admin of the contract is able to declare the deterministic gas
+// of the contract execution and that the execution fails if it consumes more gas than declared.
+func TestWASMContractDeterministicGas(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	admin := chain.GenAccount()
+	notAdmin := chain.GenAccount()
+
+	requireT := require.New(t)
+	requireT.NoError(chain.Faucet.FundAccounts(ctx,
+		integrationtests.NewFundedAccount(admin, chain.NewCoin(sdk.NewInt(5000000000))),
+	))
+	requireT.NoError(chain.Faucet.FundAccountsWithOptions(ctx, notAdmin, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{
+			&deterministicgastypes.MsgSetContractGas{},
+		},
+	}))
+
+	initialPayload, err := json.Marshal(simpleState{
+		Count: 1337,
+	})
+	requireT.NoError(err)
+
+	clientCtx := chain.ClientContext.WithFromAddress(admin)
+	txf := chain.TxFactory().
+		WithSimulateAndExecute(true)
+
+	contractAddr, _, err := deployAndInstantiateWASMContract(
+		ctx,
+		clientCtx,
+		txf,
+		simpleStateWASM,
+		instantiateConfig{
+			accessType: wasmtypes.AccessTypeUnspecified,
+			admin:      admin,
+			payload:    initialPayload,
+			label:      "simple_state",
+		},
+	)
+	requireT.NoError(err)
+
+	// only the admin is allowed to declare the gas
+	const declaredGas = 200000
+	msgSetContractGas := &deterministicgastypes.MsgSetContractGas{
+		Sender:   notAdmin.String(),
+		Contract: contractAddr,
+		MsgName:  string(simpleIncrement),
+		Gas:      declaredGas,
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(notAdmin),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(msgSetContractGas)),
+		msgSetContractGas,
+	)
+	requireT.True(cosmoserrors.ErrUnauthorized.Is(err))
+
+	msgSetContractGas.Sender = admin.String()
+	res, err := client.BroadcastTx(
+		ctx,
+		clientCtx,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(msgSetContractGas)),
+		msgSetContractGas,
+	)
+	requireT.NoError(err)
+	requireT.EqualValues(chain.GasLimitByMsgs(msgSetContractGas), res.GasUsed)
+
+	// the declared gas is charged for the execution
+	gasUsed := incrementAndVerify(ctx, clientCtx, txf, contractAddr, requireT, 1338)
+	requireT.EqualValues(chain.DeterministicGasConfig.FixedGas+declaredGas, gasUsed)
+
+	// the execution fails if it consumes more gas than declared
+	msgSetContractGas.Gas = 1000
+	_, err = client.BroadcastTx(
+		ctx,
+		clientCtx,
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(msgSetContractGas)),
+		msgSetContractGas,
+	)
+	requireT.NoError(err)
+
+	incrementPayload, err := methodToEmptyBodyPayload(simpleIncrement)
+	requireT.NoError(err)
+	_, err = executeWASMContract(ctx, clientCtx, txf, contractAddr, incrementPayload, sdk.Coin{})
+	requireT.True(deterministicgastypes.ErrContractGasExceeded.Is(err))
+}
+
 // TestWASMFungibleTokenInContract verifies that smart contract is able to execute all fungible token message and core queries.
 func TestWASMFungibleTokenInContract(t *testing.T) {
 	t.Parallel()
@@ -680,6 +770,8 @@ func TestWASMFungibleTokenInContract(t *testing.T) {
 		},
 		BurnRate:           burnRate,
 		SendCommissionRate: sendCommissionRate,
+		Admin:              contractAddr,
+		MaxSupply:          sdk.ZeroInt(),
 	}
 	requireT.Equal(
 		expectedToken, tokenRes.Token,
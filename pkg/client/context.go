@@ -470,6 +470,8 @@ func sdkErrorToGRPCError(resp abci.ResponseQuery) error {
 		return status.Error(codes.Unauthenticated, resp.Log)
 	case sdkerrors.ErrKeyNotFound.ABCICode():
 		return status.Error(codes.NotFound, resp.Log)
+	case sdkerrors.ErrUnknownRequest.ABCICode():
+		return status.Error(codes.Unimplemented, resp.Log)
 	default:
 		return status.Error(codes.Unknown, resp.Log)
 	}
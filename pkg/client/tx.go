@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -21,11 +22,17 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/mempool"
 	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/CoreumFoundation/coreum-tools/pkg/retry"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
+// maxDeterministicGasAttempts is the maximum number of attempts to sign the tx with the gas covering its size.
+const maxDeterministicGasAttempts = 3
+
 // Factory is a re-export of the cosmos sdk tx.Factory type, to make usage of this package more convenient.
 // It will help users by removing the need to import tx package from cosmos sdk and help avoid package name collision.
 type Factory = tx.Factory
@@ -50,6 +57,7 @@ func BroadcastTx(ctx context.Context, clientCtx Context, txf Factory, msgs ...sd
 		return nil, err
 	}
 
+	var isDeterministic bool
 	if txf.SimulateAndExecute() {
 		gasPrice, err := GetGasPrice(ctx, clientCtx)
 		if err != nil {
@@ -58,14 +66,77 @@ func BroadcastTx(ctx context.Context, clientCtx Context, txf Factory, msgs ...sd
 		gasPrice.Amount = gasPrice.Amount.Mul(clientCtx.GasPriceAdjustment())
 		txf = txf.WithGasPrices(gasPrice.String())
 
-		_, adjusted, err := CalculateGas(ctx, clientCtx, txf, msgs...)
+		var gas uint64
+		gas, isDeterministic, err = GetDeterministicGas(ctx, clientCtx, msgs...)
+		// the node not serving the deterministic gas query is not upgraded yet, so the gas is simulated
+		if err != nil && !isUnimplemented(err) {
+			return nil, err
+		}
+
+		// simulation is not needed if all the messages are deterministic
+		if !isDeterministic {
+			_, gas, err = CalculateGas(ctx, clientCtx, txf, msgs...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		txf = txf.WithGas(gas)
+	}
+
+	txBytes, err := signTx(clientCtx, txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if isDeterministic {
+		txBytes, err = signTxWithDeterministicGas(ctx, clientCtx, txf, txBytes, msgs...)
 		if err != nil {
 			return nil, err
 		}
+	}
+
+	return BroadcastRawTx(ctx, clientCtx, txBytes)
+}
+
+// signTxWithDeterministicGas verifies the gas of the signed tx and signs it again with the higher gas if required.
+// Gas required by the messages doesn't include the gas charged for the tx bytes and signatures exceeding
+// the free ones.
+func signTxWithDeterministicGas(
+	ctx context.Context,
+	clientCtx Context,
+	txf Factory,
+	txBytes []byte,
+	msgs ...sdk.Msg,
+) ([]byte, error) {
+	queryClient := deterministicgastypes.NewQueryClient(clientCtx)
+	for i := 0; i < maxDeterministicGasAttempts; i++ {
+		res, err := queryClient.DeterministicGas(ctx, &deterministicgastypes.QueryDeterministicGasRequest{
+			TxBytes: txBytes,
+		})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if res.TotalGas <= txf.Gas() {
+			return txBytes, nil
+		}
 
-		txf = txf.WithGas(adjusted)
+		txf = txf.WithGas(res.TotalGas)
+		txBytes, err = signTx(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	return nil, errors.Errorf("failed to sign the tx with the gas covering its size in %d attempts", maxDeterministicGasAttempts)
+}
+
+// isUnimplemented returns true if the node doesn't serve the requested query.
+func isUnimplemented(err error) bool {
+	return status.Code(errors.Cause(err)) == codes.Unimplemented
+}
+
+func signTx(clientCtx Context, txf Factory, msgs ...sdk.Msg) ([]byte, error) {
 	unsignedTx, err := txf.BuildUnsignedTx(msgs...)
 	if err != nil {
 		return nil, err
@@ -88,12 +159,32 @@ func BroadcastTx(ctx context.Context, clientCtx Context, txf Factory, msgs ...sd
 		return nil, err
 	}
 
-	txBytes, err := clientCtx.TxConfig().TxEncoder()(unsignedTx.GetTx())
+	return clientCtx.TxConfig().TxEncoder()(unsignedTx.GetTx())
+}
+
+// GetDeterministicGas returns the deterministic gas required by the messages including the fixed gas,
+// and true if all the messages are deterministic.
+func GetDeterministicGas(ctx context.Context, clientCtx Context, msgs ...sdk.Msg) (uint64, bool, error) {
+	msgAnys := make([]*codectypes.Any, 0, len(msgs))
+	for _, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "failed to pack message %s", sdk.MsgTypeURL(msg))
+		}
+		msgAnys = append(msgAnys, msgAny)
+	}
+
+	res, err := deterministicgastypes.NewQueryClient(clientCtx).DeterministicGas(ctx, &deterministicgastypes.QueryDeterministicGasRequest{
+		Msgs: msgAnys,
+	})
 	if err != nil {
-		return nil, err
+		return 0, false, errors.WithStack(err)
+	}
+	if !res.Deterministic {
+		return 0, false, nil
 	}
 
-	return BroadcastRawTx(ctx, clientCtx, txBytes)
+	return res.TotalGas, true, nil
 }
 
 // CalculateGas simulates the execution of a transaction and returns the
@@ -13,6 +13,7 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -27,6 +28,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
@@ -193,7 +195,8 @@ func init() {
 					Balances: mainFoundationOtherInitialBalance,
 				},
 			},
-			GenTxs: readGenTxs(mainGenTxsFS),
+			GenTxs:           readGenTxs(mainGenTxsFS),
+			PublishedGenesis: true,
 		},
 		constant.ChainIDTest: {
 			ChainID:              constant.ChainIDTest,
@@ -259,7 +262,8 @@ func init() {
 					Balances: testFoundationInitialBalance,
 				},
 			},
-			GenTxs: readGenTxs(testGenTxsFS),
+			GenTxs:           readGenTxs(testGenTxsFS),
+			PublishedGenesis: true,
 		},
 		constant.ChainIDDev: {
 			ChainID:              constant.ChainIDDev,
@@ -409,6 +413,9 @@ type NetworkConfig struct {
 	CustomParamsConfig   CustomParamsConfig
 	AssetFTConfig        AssetFTConfig
 	AssetNFTConfig       AssetNFTConfig
+	// PublishedGenesis marks the networks whose genesis file has already been published and must not change.
+	// The state introduced after the launch of such networks is initialized by the upgrade handlers.
+	PublishedGenesis bool
 }
 
 // Network holds all the configuration for different predefined networks.
@@ -425,6 +432,7 @@ type Network struct {
 	customParams         CustomParamsConfig
 	assetFT              AssetFTConfig
 	assetNFT             AssetNFTConfig
+	publishedGenesis     bool
 
 	mu             *sync.Mutex
 	fundedAccounts []FundedAccount
@@ -446,6 +454,7 @@ func NewNetwork(c NetworkConfig) Network {
 		customParams:         c.CustomParamsConfig,
 		assetFT:              c.AssetFTConfig,
 		assetNFT:             c.AssetNFTConfig,
+		publishedGenesis:     c.PublishedGenesis,
 		mu:                   &sync.Mutex{},
 		fundedAccounts:       append([]FundedAccount{}, c.FundedAccounts...),
 		genTxs:               append([]json.RawMessage{}, c.GenTxs...),
@@ -701,29 +710,41 @@ func genesisByTemplate(n Network) ([]byte, error) {
 		"ToUpper": strings.ToUpper,
 	}
 
+	deterministicGasParams := deterministicgastypes.DefaultParams()
+	deterministicGasParamsJSON, err := codec.ProtoMarshalJSON(&deterministicGasParams, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal deterministic gas params")
+	}
+
 	genesisBuf := new(bytes.Buffer)
-	err := template.Must(template.New("genesis").Funcs(funcMap).Parse(genesisTemplate)).Execute(genesisBuf, struct {
-		GenesisTimeUTC       string
-		ChainID              constant.ChainID
-		MetadataDisplayDenom string
-		Denom                string
-		FeeModelParams       feemodeltypes.ModelParams
-		Gov                  GovConfig
-		Staking              StakingConfig
-		CustomParamsConfig   CustomParamsConfig
-		AssetFTConfig        AssetFTConfig
-		AssetNFTConfig       AssetNFTConfig
+	err = template.Must(template.New("genesis").Funcs(funcMap).Parse(genesisTemplate)).Execute(genesisBuf, struct {
+		GenesisTimeUTC                 string
+		ChainID                        constant.ChainID
+		MetadataDisplayDenom           string
+		Denom                          string
+		FeeModelParams                 feemodeltypes.ModelParams
+		FeeModelHistoryRetentionBlocks uint32
+		Gov                            GovConfig
+		Staking                        StakingConfig
+		CustomParamsConfig             CustomParamsConfig
+		AssetFTConfig                  AssetFTConfig
+		AssetNFTConfig                 AssetNFTConfig
+		DeterministicGasParams         string
+		PublishedGenesis               bool
 	}{
-		GenesisTimeUTC:       n.genesisTime.UTC().Format(time.RFC3339),
-		ChainID:              n.chainID,
-		MetadataDisplayDenom: n.metadataDisplayDenom,
-		Denom:                n.denom,
-		FeeModelParams:       n.FeeModel().Params(),
-		Gov:                  n.gov,
-		Staking:              n.staking,
-		CustomParamsConfig:   n.customParams,
-		AssetFTConfig:        n.assetFT,
-		AssetNFTConfig:       n.assetNFT,
+		GenesisTimeUTC:                 n.genesisTime.UTC().Format(time.RFC3339),
+		ChainID:                        n.chainID,
+		MetadataDisplayDenom:           n.metadataDisplayDenom,
+		Denom:                          n.denom,
+		FeeModelParams:                 n.FeeModel().Params(),
+		FeeModelHistoryRetentionBlocks: feemodeltypes.DefaultHistoryRetentionBlocks,
+		Gov:                            n.gov,
+		Staking:                        n.staking,
+		CustomParamsConfig:             n.customParams,
+		AssetFTConfig:                  n.assetFT,
+		AssetNFTConfig:                 n.assetNFT,
+		DeterministicGasParams:         string(deterministicGasParamsJSON),
+		PublishedGenesis:               n.publishedGenesis,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to template genesis file")
@@ -24,6 +24,7 @@ import (
 	"github.com/CoreumFoundation/coreum/pkg/client"
 	"github.com/CoreumFoundation/coreum/pkg/config"
 	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
@@ -250,6 +251,51 @@ func TestGenesisHash(t *testing.T) {
 	}
 }
 
+func TestPublishedGenesisNotExtended(t *testing.T) {
+	tests := []struct {
+		chainID             constant.ChainID
+		wantPostLaunchState bool
+	}{
+		{
+			chainID:             constant.ChainIDMain,
+			wantPostLaunchState: false,
+		},
+		{
+			chainID:             constant.ChainIDTest,
+			wantPostLaunchState: false,
+		},
+		{
+			chainID:             constant.ChainIDDev,
+			wantPostLaunchState: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(string(tt.chainID), func(t *testing.T) {
+			n, err := config.NetworkByChainID(tt.chainID)
+			require.NoError(t, err)
+
+			unsealConfig()
+			n.SetSDKConfig()
+			genesisDoc, err := n.GenesisDoc()
+			require.NoError(t, err)
+
+			var appStateMapJSONRawMessage map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(genesisDoc.AppState, &appStateMapJSONRawMessage))
+
+			_, ok := appStateMapJSONRawMessage[deterministicgastypes.ModuleName]
+			require.Equal(t, tt.wantPostLaunchState, ok)
+
+			var feeModelGenesis struct {
+				Params map[string]json.RawMessage `json:"params"`
+			}
+			require.NoError(t, json.Unmarshal(appStateMapJSONRawMessage[feemodeltypes.ModuleName], &feeModelGenesis))
+			_, ok = feeModelGenesis.Params["history_retention_blocks"]
+			require.Equal(t, tt.wantPostLaunchState, ok)
+		})
+	}
+}
+
 func TestGenesisCoreTotalSupply(t *testing.T) {
 	tests := []struct {
 		name       string
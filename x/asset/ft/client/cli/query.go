@@ -29,6 +29,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryFrozenBalances())
 	cmd.AddCommand(CmdQueryWhitelistedBalance())
 	cmd.AddCommand(CmdQueryWhitelistedBalances())
+	cmd.AddCommand(CmdQueryLockedBalance())
+	cmd.AddCommand(CmdQueryMintAllowances())
+	cmd.AddCommand(CmdQueryMintAllowance())
+	cmd.AddCommand(CmdQueryRateExemptions())
+	cmd.AddCommand(CmdQueryHolders())
 	return cmd
 }
 
@@ -278,3 +283,208 @@ $ %[1]s query %s whitelisted-balance [account] [denom]
 
 	return cmd
 }
+
+// CmdQueryLockedBalance return the QueryLockedBalance cobra command.
+func CmdQueryLockedBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locked-balance [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query fungible token locked balance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fungible token balance of an account locked by the vesting schedules.
+
+Example:
+$ %[1]s query %s locked-balance [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			account := args[0]
+			denom := args[1]
+			res, err := queryClient.LockedBalance(cmd.Context(), &types.QueryLockedBalanceRequest{
+				Account: account,
+				Denom:   denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryMintAllowances return the QueryMintAllowances cobra command.
+func CmdQueryMintAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-allowances [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token mint allowances",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the mint allowances granted for the fungible token.
+
+Example:
+$ %[1]s query %s mint-allowances [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.MintAllowances(cmd.Context(), &types.QueryMintAllowancesRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint allowances")
+
+	return cmd
+}
+
+// CmdQueryMintAllowance return the QueryMintAllowance cobra command.
+func CmdQueryMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-allowance [denom] [minter]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query fungible token mint allowance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the mint allowance of the fungible token granted to an account.
+
+Example:
+$ %[1]s query %s mint-allowance [denom] [minter]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom := args[0]
+			minter := args[1]
+			res, err := queryClient.MintAllowance(cmd.Context(), &types.QueryMintAllowanceRequest{
+				Denom:  denom,
+				Minter: minter,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRateExemptions return the QueryRateExemptions cobra command.
+func CmdQueryRateExemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-exemptions [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token rate exemptions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the accounts exempted from the burn rate and the send commission of the fungible token.
+
+Example:
+$ %[1]s query %s rate-exemptions [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.RateExemptions(cmd.Context(), &types.QueryRateExemptionsRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate exemptions")
+
+	return cmd
+}
+
+// CmdQueryHolders return the QueryHolders cobra command.
+func CmdQueryHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holders [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token holders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the accounts holding the fungible token together with their frozen, whitelisted and spendable amounts.
+
+Example:
+$ %[1]s query %s holders [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.Holders(cmd.Context(), &types.QueryHoldersRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+
+	return cmd
+}
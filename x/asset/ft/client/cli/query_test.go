@@ -46,6 +46,9 @@ func TestQueryTokens(t *testing.T) {
 	expectedToken := token
 	expectedToken.Denom = denom
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
+	expectedToken.Admin = testNetwork.Validators[0].Address.String()
+	expectedToken.MaxSupply = sdk.ZeroInt()
+	expectedToken.CommissionRecipients = []types.CommissionRecipient{}
 	requireT.Equal(expectedToken, resp.Tokens[0])
 }
 
@@ -64,6 +67,7 @@ func TestQueryToken(t *testing.T) {
 		},
 		BurnRate:           sdk.MustNewDecFromStr("0.1"),
 		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+		MaxSupply:          sdk.NewInt(1000),
 	}
 	ctx := testNetwork.Validators[0].ClientCtx
 
@@ -79,5 +83,34 @@ func TestQueryToken(t *testing.T) {
 	expectedToken := token
 	expectedToken.Denom = denom
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
+	expectedToken.Admin = testNetwork.Validators[0].Address.String()
+	expectedToken.CommissionRecipients = []types.CommissionRecipient{}
 	requireT.Equal(expectedToken, resp.Token)
 }
+
+func TestQueryHolders(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	initialAmount := sdk.NewInt(100)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryHolders(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+
+	var resp types.QueryHoldersResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Len(resp.Holders, 1)
+	requireT.Equal(testNetwork.Validators[0].Address.String(), resp.Holders[0].Address)
+	requireT.Equal(initialAmount.String(), resp.Holders[0].Balance.String())
+	requireT.Equal(initialAmount.String(), resp.Holders[0].Spendable.String())
+}
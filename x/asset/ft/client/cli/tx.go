@@ -24,6 +24,9 @@ const (
 	FeaturesFlag           = "features"
 	BurnRateFlag           = "burn-rate"
 	SendCommissionRateFlag = "send-commission-rate"
+	URIFlag                = "uri"
+	URIHashFlag            = "uri-hash"
+	MaxSupplyFlag          = "max-supply"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -45,6 +48,15 @@ func GetTxCmd() *cobra.Command {
 		CmdTxGloballyFreeze(),
 		CmdTxGloballyUnfreeze(),
 		CmdTxSetWhitelistedLimit(),
+		CmdTxClawback(),
+		CmdTxUpdateMetadata(),
+		CmdTxTransferAdmin(),
+		CmdTxClearAdmin(),
+		CmdTxCreateVestingSchedule(),
+		CmdTxGrantMintAllowance(),
+		CmdTxRevokeMintAllowance(),
+		CmdTxSetRateExemption(),
+		CmdTxUpdateCommissionRecipients(),
 	)
 
 	return cmd
@@ -60,7 +72,7 @@ func CmdTxIssue() *cobra.Command {
 	}
 	sort.Strings(allowedFeatures)
 	cmd := &cobra.Command{
-		Use:   "issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features=" + strings.Join(allowedFeatures, ",") + " --burn-rate=0.12 --send-commission-rate=0.2",
+		Use:   "issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features=" + strings.Join(allowedFeatures, ",") + " --burn-rate=0.12 --send-commission-rate=0.2 --uri https://my-token-meta.invalid/1 --uri-hash e000624 --max-supply=1000000",
 		Args:  cobra.ExactArgs(5),
 		Short: "Issue new fungible token",
 		Long: strings.TrimSpace(
@@ -135,6 +147,29 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 			}
 			description := args[4]
 
+			uri, err := cmd.Flags().GetString(URIFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			uriHash, err := cmd.Flags().GetString(URIHashFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			// if the max supply wasn't provided the supply is not limited
+			maxSupply := sdk.ZeroInt()
+			maxSupplyStr, err := cmd.Flags().GetString(MaxSupplyFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(maxSupplyStr) > 0 {
+				var ok bool
+				maxSupply, ok = sdk.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return errors.Errorf("invalid max-supply %q", maxSupplyStr)
+				}
+			}
+
 			msg := &types.MsgIssue{
 				Issuer:             issuer.String(),
 				Symbol:             symbol,
@@ -145,6 +180,9 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 				Features:           features,
 				BurnRate:           burnRate,
 				SendCommissionRate: sendCommissionRate,
+				URI:                uri,
+				URIHash:            uriHash,
+				MaxSupply:          maxSupply,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -153,6 +191,9 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 	cmd.Flags().StringSlice(FeaturesFlag, []string{}, "Features to be enabled on fungible token. e.g --features="+strings.Join(allowedFeatures, ","))
 	cmd.Flags().String(BurnRateFlag, "0", "Indicates the rate at which coins will be burned on top of the sent amount in every send action. Must be between 0 and 1.")
 	cmd.Flags().String(SendCommissionRateFlag, "0", "Indicates the rate at which coins will be sent to the issuer on top of the sent amount in every send action. Must be between 0 and 1.")
+	cmd.Flags().String(URIFlag, "", "Token URI.")
+	cmd.Flags().String(URIHashFlag, "", "Token URI hash.")
+	cmd.Flags().String(MaxSupplyFlag, "", "Maximum total supply of the token. The supply is not limited if not provided.")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -449,3 +490,406 @@ $ %s tx %s globally-unfreeze ABC-%s --from [sender]
 
 	return cmd
 }
+
+// CmdTxClawback returns Clawback cobra command.
+func CmdTxClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [account_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Return a portion of fungible token from an account to the admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Return a portion of fungible token from an account to the admin.
+
+Example:
+$ %s tx %s clawback [account_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgClawback{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateMetadata returns UpdateMetadata cobra command.
+func CmdTxUpdateMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-metadata [denom] [description] --from [sender] --uri https://my-token-meta.invalid/1 --uri-hash e000624",
+		Args:  cobra.ExactArgs(2),
+		Short: "Update the description, URI and URI hash of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the description, URI and URI hash of fungible token.
+All the values are replaced, so the URI and URI hash are cleared if not provided.
+
+Example:
+$ %s tx %s update-metadata ABC-%s "ABC Token" --uri https://my-token-meta.invalid/1 --uri-hash e000624 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			description := args[1]
+
+			uri, err := cmd.Flags().GetString(URIFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			uriHash, err := cmd.Flags().GetString(URIHashFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgUpdateMetadata{
+				Sender:      sender.String(),
+				Denom:       denom,
+				Description: description,
+				URI:         uri,
+				URIHash:     uriHash,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(URIFlag, "", "Token URI.")
+	cmd.Flags().String(URIHashFlag, "", "Token URI hash.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxTransferAdmin returns TransferAdmin cobra command.
+func CmdTxTransferAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-admin [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the admin role of fungible token to another account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the admin role of fungible token to another account.
+
+Example:
+$ %s tx %s transfer-admin [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgTransferAdmin{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClearAdmin returns ClearAdmin cobra command.
+func CmdTxClearAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-admin [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the admin of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the admin of fungible token, so none of the admin operations can be executed anymore.
+This operation is irreversible.
+
+Example:
+$ %s tx %s clear-admin ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgClearAdmin{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxCreateVestingSchedule returns CreateVestingSchedule cobra command.
+func CmdTxCreateVestingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-schedule [account_address] [amount] [start_time] [cliff_time] [end_time] --from [sender]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Send fungible token to an account locking it until released by the vesting schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send fungible token to an account locking it until released by the vesting schedule.
+The times are unix timestamps in seconds. Nothing is released before the cliff time, then the amount is released
+linearly between the start and end time.
+
+Example:
+$ %s tx %s create-vesting-schedule [account_address] 100000ABC-%s 1700000000 1710000000 1730000000 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			times := make([]int64, 0, 3)
+			for _, arg := range args[2:] {
+				t, err := strconv.ParseInt(arg, 10, 64)
+				if err != nil {
+					return sdkerrors.Wrapf(err, "invalid time: %s", arg)
+				}
+				times = append(times, t)
+			}
+
+			msg := &types.MsgCreateVestingSchedule{
+				Sender:    sender.String(),
+				Account:   account,
+				Coin:      amount,
+				StartTime: times[0],
+				CliffTime: times[1],
+				EndTime:   times[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGrantMintAllowance returns GrantMintAllowance cobra command.
+func CmdTxGrantMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-mint-allowance [minter_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Allow an account to mint up to the amount of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow an account to mint up to the amount of fungible token.
+The previously granted allowance is replaced.
+
+Example:
+$ %s tx %s grant-mint-allowance [minter_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			minter := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgGrantMintAllowance{
+				Sender: sender.String(),
+				Minter: minter,
+				Coin:   amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRevokeMintAllowance returns RevokeMintAllowance cobra command.
+func CmdTxRevokeMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-mint-allowance [minter_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the mint allowance of fungible token granted to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the mint allowance of fungible token granted to an account.
+
+Example:
+$ %s tx %s revoke-mint-allowance [minter_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			msg := &types.MsgRevokeMintAllowance{
+				Sender: sender.String(),
+				Minter: args[0],
+				Denom:  args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetRateExemption returns SetRateExemption cobra command.
+func CmdTxSetRateExemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-exemption [account_address] [denom] [exempt] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Exempt an account from the burn rate and the send commission of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Exempt an account from the burn rate and the send commission of fungible token or remove the exemption.
+
+Example:
+$ %s tx %s set-rate-exemption [account_address] ABC-%s true --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			exempt, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid exempt")
+			}
+
+			sender := clientCtx.GetFromAddress()
+			msg := &types.MsgSetRateExemption{
+				Sender:  sender.String(),
+				Account: args[0],
+				Denom:   args[1],
+				Exempt:  exempt,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateCommissionRecipients returns UpdateCommissionRecipients cobra command.
+func CmdTxUpdateCommissionRecipients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-commission-recipients [denom] [address:weight]... --from [sender]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Replace the accounts receiving the send commission of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the accounts receiving the send commission of fungible token.
+The commission is split between the recipients proportionally to their weights.
+If no recipients are provided, the commission is sent to the admin.
+
+Example:
+$ %s tx %s update-commission-recipients ABC-%s [address1]:3 [address2]:1 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			recipients := make([]types.CommissionRecipient, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 2 {
+					return errors.Errorf("invalid commission recipient %q, expected format is address:weight", arg)
+				}
+				weight, err := strconv.ParseUint(parts[1], 10, 32)
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid weight")
+				}
+				recipients = append(recipients, types.CommissionRecipient{
+					Address: parts[0],
+					Weight:  uint32(weight),
+				})
+			}
+
+			sender := clientCtx.GetFromAddress()
+			msg := &types.MsgUpdateCommissionRecipients{
+				Sender:     sender.String(),
+				Denom:      args[0],
+				Recipients: recipients,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
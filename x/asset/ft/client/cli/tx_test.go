@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -262,6 +263,303 @@ func TestWhitelistAndQueryWhitelisted(t *testing.T) {
 	requireT.Len(balancesResp.Balances, 1)
 }
 
+func TestClawback(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_clawback,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// send some tokens to the account
+	coinToSend := sdk.NewInt64Coin(denom, 100)
+	args := append([]string{testNetwork.Validators[0].Address.String(), account.String(), coinToSend.String()}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, bankcli.NewSendTxCmd(), args)
+	requireT.NoError(err)
+
+	// claw back part of the tokens
+	coinToClawback := sdk.NewInt64Coin(denom, 60)
+	args = append([]string{account.String(), coinToClawback.String(), "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxClawback(), args)
+	requireT.NoError(err)
+
+	var balance sdk.Coin
+	buf, err := clitestutil.ExecTestCLICmd(ctx, bankcli.GetBalancesCmd(), []string{account.String(), "--denom", denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &balance))
+	requireT.Equal(sdk.NewInt64Coin(denom, 40).String(), balance.String())
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_updatable_metadata,
+		},
+		URI:     "https://my-token-meta.invalid/1",
+		URIHash: "e000624",
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(777), testNetwork)
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(token.URI, resp.Token.URI)
+	requireT.Equal(token.URIHash, resp.Token.URIHash)
+
+	args := append([]string{
+		denom, "new description",
+		fmt.Sprintf("--%s=%s", cli.URIFlag, "https://my-token-meta.invalid/2"),
+		fmt.Sprintf("--%s=%s", cli.URIHashFlag, "e000625"),
+		"--output", "json",
+	}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateMetadata(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal("new description", resp.Token.Description)
+	requireT.Equal("https://my-token-meta.invalid/2", resp.Token.URI)
+	requireT.Equal("e000625", resp.Token.URIHash)
+}
+
+func TestTransferAndClearAdmin(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdk.NewInt(777)
+
+	// transfer admin
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	args := append([]string{admin.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxTransferAdmin(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(admin.String(), resp.Token.Admin)
+
+	// clear admin
+	token.Symbol = "btc" + uuid.NewString()[:4]
+	token.Subunit = "satoshi" + uuid.NewString()[:4]
+	denom = issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	args = append([]string{denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxClearAdmin(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.Token.Admin)
+}
+
+func TestCreateVestingScheduleAndQueryLocked(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(777), testNetwork)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// lock the tokens long enough for the whole test
+	now := time.Now().Unix()
+	coinToVest := sdk.NewInt64Coin(denom, 100)
+	args := append([]string{
+		account.String(), coinToVest.String(),
+		fmt.Sprint(now), fmt.Sprint(now + 3600), fmt.Sprint(now + 7200),
+		"--output", "json",
+	}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxCreateVestingSchedule(), args)
+	requireT.NoError(err)
+
+	var balance sdk.Coin
+	buf, err := clitestutil.ExecTestCLICmd(ctx, bankcli.GetBalancesCmd(), []string{account.String(), "--denom", denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &balance))
+	requireT.Equal(coinToVest.String(), balance.String())
+
+	var resp types.QueryLockedBalanceResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryLockedBalance(), []string{account.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(coinToVest.String(), resp.Balance.String())
+	requireT.Len(resp.Schedules, 1)
+}
+
+func TestGrantRevokeAndQueryMintAllowance(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(777), testNetwork)
+	minter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// grant the mint allowance
+	allowance := sdk.NewInt64Coin(denom, 100)
+	args := append([]string{minter.String(), allowance.String(), "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxGrantMintAllowance(), args)
+	requireT.NoError(err)
+
+	var allowanceResp types.QueryMintAllowanceResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryMintAllowance(), []string{denom, minter.String(), "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &allowanceResp))
+	requireT.Equal(allowance.String(), allowanceResp.Allowance.String())
+
+	var allowancesResp types.QueryMintAllowancesResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryMintAllowances(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &allowancesResp))
+	requireT.Len(allowancesResp.Allowances, 1)
+	requireT.Equal(minter.String(), allowancesResp.Allowances[0].Minter)
+
+	// revoke the mint allowance
+	args = append([]string{minter.String(), denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxRevokeMintAllowance(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryMintAllowances(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &allowancesResp))
+	requireT.Empty(allowancesResp.Allowances)
+}
+
+func TestSetAndQueryRateExemption(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		BurnRate:    sdk.MustNewDecFromStr("0.1"),
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(777), testNetwork)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// exempt the account
+	args := append([]string{account.String(), denom, "true", "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxSetRateExemption(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryRateExemptionsResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRateExemptions(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Len(resp.Exemptions, 1)
+	requireT.Equal(account.String(), resp.Exemptions[0].Account)
+
+	// remove the exemption
+	args = append([]string{account.String(), denom, "false", "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxSetRateExemption(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryRateExemptions(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.Exemptions)
+}
+
+func TestUpdateCommissionRecipients(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:             "btc" + uuid.NewString()[:4],
+		Subunit:            "satoshi" + uuid.NewString()[:4],
+		Precision:          8,
+		Description:        "description",
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdk.NewInt(777), testNetwork)
+	partner1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	partner2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// set the recipients
+	args := append([]string{denom, partner1.String() + ":3", partner2.String() + ":1", "--output", "json"},
+		txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateCommissionRecipients(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryTokenResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal([]types.CommissionRecipient{
+		{Address: partner1.String(), Weight: 3},
+		{Address: partner2.String(), Weight: 1},
+	}, resp.Token.CommissionRecipients)
+
+	// clear the recipients
+	args = append([]string{denom, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxUpdateCommissionRecipients(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryToken(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.Token.CommissionRecipients)
+}
+
 func issue(requireT *require.Assertions, ctx client.Context, token types.Token, initialAmount sdk.Int, testNetwork *network.Network) string {
 	features := make([]string, 0, len(token.Features))
 	for _, feature := range token.Features {
@@ -281,6 +579,15 @@ func issue(requireT *require.Assertions, ctx client.Context, token types.Token,
 	if !token.SendCommissionRate.IsNil() {
 		args = append(args, fmt.Sprintf("--%s=%s", cli.SendCommissionRateFlag, token.SendCommissionRate.String()))
 	}
+	if token.URI != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.URIFlag, token.URI))
+	}
+	if token.URIHash != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.URIHashFlag, token.URIHash))
+	}
+	if !token.MaxSupply.IsNil() {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.MaxSupplyFlag, token.MaxSupply.String()))
+	}
 
 	args = append(args, txValidator1Args(testNetwork)...)
 	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxIssue(), args)
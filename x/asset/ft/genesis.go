@@ -24,11 +24,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 
 		definition := types.Definition{
-			Denom:              token.Denom,
-			Issuer:             token.Issuer,
-			Features:           token.Features,
-			BurnRate:           token.BurnRate,
-			SendCommissionRate: token.SendCommissionRate,
+			Denom:                token.Denom,
+			Issuer:               token.Issuer,
+			Features:             token.Features,
+			BurnRate:             token.BurnRate,
+			SendCommissionRate:   token.SendCommissionRate,
+			Admin:                token.Admin,
+			URI:                  token.URI,
+			URIHash:              token.URIHash,
+			MaxSupply:            token.MaxSupply,
+			CommissionRecipients: token.CommissionRecipients,
 		}
 
 		k.SetDefinition(ctx, issuer, subunit, definition)
@@ -59,6 +64,33 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		address := sdk.MustAccAddressFromBech32(whitelistedBalance.Address)
 		k.SetWhitelistedBalances(ctx, address, whitelistedBalance.Coins)
 	}
+
+	// Init vesting schedules
+	for _, schedules := range genState.VestingSchedules {
+		if err := schedules.Validate(); err != nil {
+			panic(err)
+		}
+		k.SetVestingSchedules(ctx, schedules)
+	}
+
+	// Init mint allowances
+	for _, allowance := range genState.MintAllowances {
+		if err := allowance.Validate(); err != nil {
+			panic(err)
+		}
+		k.SetMintAllowance(ctx, allowance)
+	}
+
+	// Init rate exemptions
+	for _, exemption := range genState.RateExemptions {
+		if err := exemption.Validate(); err != nil {
+			panic(err)
+		}
+		k.SetRateExempt(ctx, exemption.Denom, sdk.MustAccAddressFromBech32(exemption.Account), true)
+	}
+
+	// Build the holders index from the balances imported by the bank module
+	k.InitHolders(ctx)
 }
 
 // ExportGenesis returns the asset module's exported genesis.
@@ -81,10 +113,34 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	// Export vesting schedules
+	var vestingSchedules []types.AccountVestingSchedules
+	k.IterateAllVestingSchedules(ctx, func(schedules types.AccountVestingSchedules) bool {
+		vestingSchedules = append(vestingSchedules, schedules)
+		return false
+	})
+
+	// Export mint allowances
+	var mintAllowances []types.MintAllowance
+	k.IterateAllMintAllowances(ctx, func(allowance types.MintAllowance) bool {
+		mintAllowances = append(mintAllowances, allowance)
+		return false
+	})
+
+	// Export rate exemptions
+	var rateExemptions []types.RateExemption
+	k.IterateAllRateExemptions(ctx, func(exemption types.RateExemption) bool {
+		rateExemptions = append(rateExemptions, exemption)
+		return false
+	})
+
 	return &types.GenesisState{
 		Params:              k.GetParams(ctx),
 		Tokens:              tokens,
 		FrozenBalances:      frozenBalances,
 		WhitelistedBalances: whitelistedBalances,
+		VestingSchedules:    vestingSchedules,
+		MintAllowances:      mintAllowances,
+		RateExemptions:      rateExemptions,
 	}
 }
@@ -40,6 +40,9 @@ func TestInitAndExportGenesis(t *testing.T) {
 			Precision:          uint32(rand.Int31n(19) + 1),
 			BurnRate:           sdk.MustNewDecFromStr(fmt.Sprintf("0.%d", i)),
 			SendCommissionRate: sdk.MustNewDecFromStr(fmt.Sprintf("0.%d", i+1)),
+			URI:                fmt.Sprintf("https://my-token-meta.invalid/%d", i),
+			URIHash:            fmt.Sprintf("content-hash%d", i),
+			MaxSupply:          sdk.NewInt(int64(i) * 1_000_000),
 			Features: []types.Feature{
 				types.Feature_freezing,
 				types.Feature_whitelisting,
@@ -49,6 +52,20 @@ func TestInitAndExportGenesis(t *testing.T) {
 		if i%2 == 0 {
 			token.GloballyFrozen = true
 		}
+		// Route the commission of some tokens to the commission recipients.
+		if i%2 == 1 {
+			token.CommissionRecipients = []types.CommissionRecipient{
+				{Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), Weight: uint32(i)},
+				{Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), Weight: 1},
+			}
+		}
+		// Transfer admin of some tokens and clear admin of others.
+		switch i % 3 {
+		case 0:
+			token.Admin = issuer.String()
+		case 1:
+			token.Admin = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+		}
 		tokens = append(tokens, token)
 		requireT.NoError(ftKeeper.SetDenomMetadata(ctx, token.Denom, token.Symbol, token.Description, token.Precision))
 	}
@@ -81,11 +98,48 @@ func TestInitAndExportGenesis(t *testing.T) {
 			})
 	}
 
+	// vesting schedules
+	var vestingSchedules []types.AccountVestingSchedules
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		vestingSchedules = append(vestingSchedules,
+			types.AccountVestingSchedules{
+				Account: addr.String(),
+				Denom:   tokens[i].Denom,
+				Schedules: []types.VestingSchedule{
+					types.NewVestingSchedule(sdk.NewInt(rand.Int63n(1000)+1), 100, 200, 1000),
+					types.NewVestingSchedule(sdk.NewInt(rand.Int63n(1000)+1), 500, 500, 2000),
+				},
+			})
+	}
+
+	// mint allowances
+	var mintAllowances []types.MintAllowance
+	for i := 0; i < 5; i++ {
+		mintAllowances = append(mintAllowances, types.MintAllowance{
+			Denom:  tokens[i%2].Denom,
+			Minter: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Amount: sdk.NewInt(rand.Int63n(1000) + 1),
+		})
+	}
+
+	// rate exemptions
+	var rateExemptions []types.RateExemption
+	for i := 0; i < 5; i++ {
+		rateExemptions = append(rateExemptions, types.RateExemption{
+			Denom:   tokens[i%2].Denom,
+			Account: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		})
+	}
+
 	genState := types.GenesisState{
 		Params:              types.DefaultParams(),
 		Tokens:              tokens,
 		FrozenBalances:      frozenBalances,
 		WhitelistedBalances: whitelistedBalances,
+		VestingSchedules:    vestingSchedules,
+		MintAllowances:      mintAllowances,
+		RateExemptions:      rateExemptions,
 	}
 
 	// init the keeper
@@ -123,6 +177,30 @@ func TestInitAndExportGenesis(t *testing.T) {
 		assertT.EqualValues(balance.Coins.String(), coins.String())
 	}
 
+	// vesting schedules
+	for _, schedules := range vestingSchedules {
+		address, err := sdk.AccAddressFromBech32(schedules.Account)
+		requireT.NoError(err)
+		assertT.EqualValues(schedules, ftKeeper.GetVestingSchedules(ctx, address, schedules.Denom))
+	}
+
+	// mint allowances
+	for _, allowance := range mintAllowances {
+		minter, err := sdk.AccAddressFromBech32(allowance.Minter)
+		requireT.NoError(err)
+		assertT.Equal(
+			sdk.NewCoin(allowance.Denom, allowance.Amount).String(),
+			ftKeeper.GetMintAllowance(ctx, allowance.Denom, minter).String(),
+		)
+	}
+
+	// rate exemptions
+	for _, exemption := range rateExemptions {
+		account, err := sdk.AccAddressFromBech32(exemption.Account)
+		requireT.NoError(err)
+		assertT.True(ftKeeper.IsRateExempt(ctx, exemption.Denom, account))
+	}
+
 	// check that export is equal import
 	exportedGenState := ft.ExportGenesis(ctx, ftKeeper)
 
@@ -130,4 +208,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.ElementsMatch(genState.Tokens, exportedGenState.Tokens)
 	assertT.ElementsMatch(genState.FrozenBalances, exportedGenState.FrozenBalances)
 	assertT.ElementsMatch(genState.WhitelistedBalances, exportedGenState.WhitelistedBalances)
+	assertT.ElementsMatch(genState.VestingSchedules, exportedGenState.VestingSchedules)
+	assertT.ElementsMatch(genState.MintAllowances, exportedGenState.MintAllowances)
+	assertT.ElementsMatch(genState.RateExemptions, exportedGenState.RateExemptions)
 }
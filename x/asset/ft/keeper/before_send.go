@@ -2,9 +2,11 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/x/wibctransfer/types"
 )
 
 // BeforeSendCoins checks that a transfer request is allowed or not.
@@ -57,6 +59,13 @@ func (k Keeper) applyFeatures(ctx sdk.Context, inputs []banktypes.Input, outputs
 }
 
 func (k Keeper) applyRules(ctx sdk.Context, inputs, outputs groupedByDenomAccountOperations) error {
+	// refunds of the failed ibc transfers give back the funds which have already passed the rules
+	if wibctransfertypes.IsPurposeRefund(ctx) {
+		return nil
+	}
+
+	isIBCIn := wibctransfertypes.IsPurposeIn(ctx)
+	isIBCOut := wibctransfertypes.IsPurposeOut(ctx)
 	for denom, inOps := range inputs {
 		def, err := k.GetDefinition(ctx, denom)
 		if types.ErrInvalidDenom.Is(err) || types.ErrTokenNotFound.Is(err) {
@@ -65,19 +74,39 @@ func (k Keeper) applyRules(ctx sdk.Context, inputs, outputs groupedByDenomAccoun
 
 		outOps := outputs[denom]
 
-		burnShares := CalculateRateShares(def.BurnRate, def.Issuer, inOps, outOps)
+		// funds received over ibc are released from the escrow account, so only the recipient is checked
+		if isIBCIn {
+			for account, amount := range outOps {
+				if err := k.isCoinReceivable(ctx, sdk.MustAccAddressFromBech32(account), def, amount); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if isIBCOut && !def.IsFeatureEnabled(types.Feature_ibc) {
+			return sdkerrors.Wrapf(types.ErrFeatureDisabled, "ibc transfers are disabled for %s", denom)
+		}
+
+		isExempt := func(account string) bool {
+			return account == def.Admin || k.IsRateExempt(ctx, denom, sdk.MustAccAddressFromBech32(account))
+		}
+
+		burnShares := CalculateRateShares(def.BurnRate, isExempt, inOps, outOps)
 		for account, amount := range burnShares {
 			if err := k.burnIfSpendable(ctx, sdk.MustAccAddressFromBech32(account), def, amount); err != nil {
 				return err
 			}
 		}
 
-		commissionShares := CalculateRateShares(def.SendCommissionRate, def.Issuer, inOps, outOps)
-		issuer := sdk.MustAccAddressFromBech32(def.Issuer)
-		for account, amount := range commissionShares {
-			coins := sdk.NewCoins(sdk.NewCoin(def.Denom, amount))
-			if err := k.bankKeeper.SendCoins(ctx, sdk.MustAccAddressFromBech32(account), issuer, coins); err != nil {
-				return err
+		// commission is paid to the commission recipients or to the admin if there are none, so if the admin
+		// is cleared and there are no commission recipients there is nobody to receive it
+		if def.HasAdmin() || len(def.CommissionRecipients) > 0 {
+			commissionShares := CalculateRateShares(def.SendCommissionRate, isExempt, inOps, outOps)
+			for account, amount := range commissionShares {
+				if err := k.sendCommission(ctx, def, sdk.MustAccAddressFromBech32(account), amount); err != nil {
+					return err
+				}
 			}
 		}
 
@@ -87,6 +116,11 @@ func (k Keeper) applyRules(ctx sdk.Context, inputs, outputs groupedByDenomAccoun
 			}
 		}
 
+		// funds sent over ibc are received by the escrow account which must not be restricted by the whitelist
+		if isIBCOut {
+			continue
+		}
+
 		for account, amount := range outOps {
 			if err := k.isCoinReceivable(ctx, sdk.MustAccAddressFromBech32(account), def, amount); err != nil {
 				return err
@@ -97,10 +131,49 @@ func (k Keeper) applyRules(ctx sdk.Context, inputs, outputs groupedByDenomAccoun
 	return nil
 }
 
-func nonIssuerSum(ops accountOperationMap, issuer string) sdk.Int {
+// sendCommission sends the commission to the commission recipients proportionally to their weights, the rounding
+// remainder goes to the last recipient. If there are no commission recipients the commission is sent to the admin.
+// The recipients are subject to the whitelisting limits the same way as the regular receivers.
+func (k Keeper) sendCommission(ctx sdk.Context, def types.Definition, payer sdk.AccAddress, amount sdk.Int) error {
+	if len(def.CommissionRecipients) == 0 {
+		coins := sdk.NewCoins(sdk.NewCoin(def.Denom, amount))
+		return k.sendCoins(ctx, payer, sdk.MustAccAddressFromBech32(def.Admin), coins)
+	}
+
+	totalWeight := sdk.ZeroInt()
+	for _, recipient := range def.CommissionRecipients {
+		totalWeight = totalWeight.Add(sdk.NewIntFromUint64(uint64(recipient.Weight)))
+	}
+
+	remaining := amount
+	for i, recipient := range def.CommissionRecipients {
+		share := remaining
+		if i < len(def.CommissionRecipients)-1 {
+			share = amount.Mul(sdk.NewIntFromUint64(uint64(recipient.Weight))).Quo(totalWeight)
+		}
+		remaining = remaining.Sub(share)
+		if !share.IsPositive() {
+			continue
+		}
+
+		recipientAddr := sdk.MustAccAddressFromBech32(recipient.Address)
+		if err := k.isCoinReceivable(ctx, recipientAddr, def, share); err != nil {
+			return err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(def.Denom, share))
+		if err := k.sendCoins(ctx, payer, recipientAddr, coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func nonExemptSum(ops accountOperationMap, isExempt func(account string) bool) sdk.Int {
 	sum := sdk.ZeroInt()
 	for account, amount := range ops {
-		if account != issuer {
+		if !isExempt(account) {
 			sum = sum.Add(amount)
 		}
 	}
@@ -108,50 +181,51 @@ func nonIssuerSum(ops accountOperationMap, issuer string) sdk.Int {
 }
 
 // CalculateRateShares calculates how the burn or commission share amount should be split between different parties.
-func CalculateRateShares(rate sdk.Dec, issuer string, inOps, outOps accountOperationMap) map[string]sdk.Int {
-	// Since burning & send commission are not applied when sending to/from token issuer we can't simply apply original burn rate or send commission rate when bank multisend with issuer in inputs or outputs.
-	// To recalculate new adjusted amount we split whole "commission" between all non-issuer senders proportionally to amount they send.
+// Accounts for which isExempt returns true are handled the same way as the token admin.
+func CalculateRateShares(rate sdk.Dec, isExempt func(account string) bool, inOps, outOps accountOperationMap) map[string]sdk.Int {
+	// Since burning & send commission are not applied when sending to/from token admin we can't simply apply original burn rate or send commission rate when bank multisend with admin in inputs or outputs.
+	// To recalculate new adjusted amount we split whole "commission" between all non-admin senders proportionally to amount they send.
 
 	// Examples
 	// burn_rate: 10%
 
 	// inputs:
 	// 75, 75
-	// 25 <-- issuer
+	// 25 <-- admin
 
 	// outputs:
 	// 50
-	// 100 <-- issuer
+	// 100 <-- admin
 	// 25
 
-	// In this case commissioned amount is: min(non_issuer_inputs, non_issuer_outputs) = min(75+75, 50+25) = 75
+	// In this case commissioned amount is: min(non_admin_inputs, non_admin_outputs) = min(75+75, 50+25) = 75
 	// Expected commission: 75 * 10% = 7.5
 	// And now we divide it proportionally between all input sender: 7.5 / 150 * 75 = 3.75
 	// As result each sender is expected to pay 3.75 of commission.
 	// Note that if we used original rate it would be 75 * 10% = 7.5
 	// Here is the final formula we use to calculate adjusted burn/commission amount for multisend txs:
-	// amount * rate * min(non_issuer_inputs_sum, non_issuer_outputs_sum) / non_issuer_inputs_sum
+	// amount * rate * min(non_admin_inputs_sum, non_admin_outputs_sum) / non_admin_inputs_sum
 	if rate.IsNil() || !rate.IsPositive() {
 		return nil
 	}
 
-	inputSumNonIssuer := nonIssuerSum(inOps, issuer)
-	outputSumNonIssuer := nonIssuerSum(outOps, issuer)
+	inputSumNonAdmin := nonExemptSum(inOps, isExempt)
+	outputSumNonAdmin := nonExemptSum(outOps, isExempt)
 
-	minNonIssuer := inputSumNonIssuer
-	if outputSumNonIssuer.LT(minNonIssuer) {
-		minNonIssuer = outputSumNonIssuer
+	minNonAdmin := inputSumNonAdmin
+	if outputSumNonAdmin.LT(minNonAdmin) {
+		minNonAdmin = outputSumNonAdmin
 	}
 
-	if !minNonIssuer.IsPositive() {
+	if !minNonAdmin.IsPositive() {
 		return nil
 	}
 
 	shares := make(accountOperationMap, 0)
 	for account, amount := range inOps {
-		if account != issuer {
+		if !isExempt(account) {
 			// in order to reduce precision errors, we first multiply all sdk.Ints, and then multiply sdk.Decs, and then divide
-			finalShare := rate.MulInt(minNonIssuer.Mul(amount)).QuoInt(inputSumNonIssuer).Ceil().RoundInt()
+			finalShare := rate.MulInt(minNonAdmin.Mul(amount)).QuoInt(inputSumNonAdmin).Ceil().RoundInt()
 			shares[account] = finalShare
 		}
 	}
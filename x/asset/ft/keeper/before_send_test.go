@@ -33,6 +33,10 @@ func TestCalculateRateShares(t *testing.T) {
 		accounts = append(accounts, genAccount())
 	}
 	issuer := genAccount()
+	exempted := genAccount()
+	isExempt := func(account string) bool {
+		return account == issuer || account == exempted
+	}
 	pow10 := func(ex int64) sdk.Int {
 		return sdk.NewIntFromBigInt(big.NewInt(0).Exp(big.NewInt(10), big.NewInt(ex), nil))
 	}
@@ -69,6 +73,32 @@ func TestCalculateRateShares(t *testing.T) {
 			},
 			shares: map[string]sdk.Int{},
 		},
+		{
+			rate: "0.5",
+			senders: map[string]sdk.Int{
+				exempted: sdk.NewInt(10),
+			},
+			receivers: map[string]sdk.Int{
+				accounts[5]: sdk.NewInt(5),
+				accounts[6]: sdk.NewInt(5),
+			},
+			shares: map[string]sdk.Int{},
+		},
+		{
+			rate: "0.1",
+			senders: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(400),
+				accounts[1]: sdk.NewInt(600),
+			},
+			receivers: map[string]sdk.Int{
+				exempted:    sdk.NewInt(500),
+				accounts[5]: sdk.NewInt(500),
+			},
+			shares: map[string]sdk.Int{
+				accounts[0]: sdk.NewInt(20),
+				accounts[1]: sdk.NewInt(30),
+			},
+		},
 		{
 			rate: "0.1",
 			senders: map[string]sdk.Int{
@@ -237,7 +267,7 @@ func TestCalculateRateShares(t *testing.T) {
 		name := fmt.Sprintf("%+v", tc)
 		t.Run(name, func(t *testing.T) {
 			assertT := assert.New(t)
-			shares := keeper.CalculateRateShares(sdk.MustNewDecFromStr(tc.rate), issuer, tc.senders, tc.receivers)
+			shares := keeper.CalculateRateShares(sdk.MustNewDecFromStr(tc.rate), isExempt, tc.senders, tc.receivers)
 			for account, share := range shares {
 				assertT.EqualValues(tc.shares[account].String(), share.String())
 			}
@@ -21,6 +21,12 @@ type QueryKeeper interface {
 	GetFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetWhitelistedBalances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	GetWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetLockedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetVestingSchedules(ctx sdk.Context, addr sdk.AccAddress, denom string) types.AccountVestingSchedules
+	GetMintAllowances(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]types.MintAllowance, *query.PageResponse, error)
+	GetMintAllowance(ctx sdk.Context, denom string, minter sdk.AccAddress) sdk.Coin
+	GetRateExemptions(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]types.RateExemption, *query.PageResponse, error)
+	GetHolders(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]types.TokenHolder, *query.PageResponse, error)
 }
 
 // QueryService serves grpc query requests for assets module.
@@ -134,3 +140,86 @@ func (qs QueryService) WhitelistedBalance(goCtx context.Context, req *types.Quer
 		Balance: balance,
 	}, nil
 }
+
+// LockedBalance returns the balance of a denom locked by the vesting schedules on a given account.
+func (qs QueryService) LockedBalance(goCtx context.Context, req *types.QueryLockedBalanceRequest) (*types.QueryLockedBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	schedules := make([]types.VestingSchedule, 0)
+	for _, schedule := range qs.keeper.GetVestingSchedules(ctx, account, req.GetDenom()).Schedules {
+		if schedule.LockedAmount(blockTime).IsPositive() {
+			schedules = append(schedules, schedule)
+		}
+	}
+
+	return &types.QueryLockedBalanceResponse{
+		Balance:   qs.keeper.GetLockedBalance(ctx, account, req.GetDenom()),
+		Schedules: schedules,
+	}, nil
+}
+
+// MintAllowances lists the mint allowances granted for a denom.
+func (qs QueryService) MintAllowances(goCtx context.Context, req *types.QueryMintAllowancesRequest) (*types.QueryMintAllowancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, _, err := types.DeconstructDenom(req.Denom); err != nil {
+		return nil, err
+	}
+	allowances, pageRes, err := qs.keeper.GetMintAllowances(ctx, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMintAllowancesResponse{
+		Allowances: allowances,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MintAllowance returns the mint allowance of a denom granted to a given minter.
+func (qs QueryService) MintAllowance(goCtx context.Context, req *types.QueryMintAllowanceRequest) (*types.QueryMintAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	return &types.QueryMintAllowanceResponse{
+		Allowance: qs.keeper.GetMintAllowance(ctx, req.GetDenom(), minter),
+	}, nil
+}
+
+// RateExemptions lists the accounts exempted from the burn rate and the send commission of a denom.
+func (qs QueryService) RateExemptions(goCtx context.Context, req *types.QueryRateExemptionsRequest) (*types.QueryRateExemptionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, _, err := types.DeconstructDenom(req.Denom); err != nil {
+		return nil, err
+	}
+	exemptions, pageRes, err := qs.keeper.GetRateExemptions(ctx, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateExemptionsResponse{
+		Exemptions: exemptions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Holders lists the accounts holding a denom.
+func (qs QueryService) Holders(goCtx context.Context, req *types.QueryHoldersRequest) (*types.QueryHoldersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	holders, pageRes, err := qs.keeper.GetHolders(ctx, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHoldersResponse{
+		Holders:    holders,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+var holderValue = []byte{0x01}
+
+// AfterSendCoins updates the holders index after the transfer executed by the bank module.
+func (k Keeper) AfterSendCoins(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, coins sdk.Coins) {
+	for _, coin := range coins {
+		k.updateHolders(ctx, coin.Denom, fromAddress, toAddress)
+	}
+}
+
+// AfterInputOutputCoins updates the holders index after the multi-send executed by the bank module.
+func (k Keeper) AfterInputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) {
+	for _, in := range inputs {
+		for _, coin := range in.Coins {
+			k.updateHolders(ctx, coin.Denom, sdk.MustAccAddressFromBech32(in.Address))
+		}
+	}
+	for _, out := range outputs {
+		for _, coin := range out.Coins {
+			k.updateHolders(ctx, coin.Denom, sdk.MustAccAddressFromBech32(out.Address))
+		}
+	}
+}
+
+// AfterBalanceChange updates the holders index after the balance of the account is changed by the bank module
+// without the transfer, e.g. on mint or burn.
+func (k Keeper) AfterBalanceChange(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
+	for _, coin := range coins {
+		k.updateHolders(ctx, coin.Denom, address)
+	}
+}
+
+// GetHolders returns the accounts holding the denom.
+func (k Keeper) GetHolders(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.TokenHolder, *query.PageResponse, error) {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateHoldersKey(denom))
+	holders := make([]types.TokenHolder, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		addr := sdk.AccAddress(key)
+		holders = append(holders, types.TokenHolder{
+			Address:     addr.String(),
+			Balance:     k.bankKeeper.GetBalance(ctx, addr, denom).Amount,
+			Frozen:      k.GetFrozenBalance(ctx, addr, denom).Amount,
+			Whitelisted: k.GetWhitelistedBalance(ctx, addr, denom).Amount,
+			Spendable:   k.spendableBalance(ctx, addr, def).Amount,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return holders, pageRes, nil
+}
+
+// IsHolder returns true if the account is registered in the holders index of the denom.
+func (k Keeper) IsHolder(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CreateHolderKey(denom, addr))
+}
+
+// IterateAllHolders iterates over all the entries of the holders index and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllHolders(ctx sdk.Context, cb func(denom string, addr sdk.AccAddress) bool) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.HoldersKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		denomLen := int(key[0])
+		if cb(string(key[1:denomLen+1]), key[denomLen+1:]) {
+			break
+		}
+	}
+}
+
+// InitHolders builds the holders index from the balances stored by the bank module.
+// It iterates over all the balances of all the accounts, so it's executed only on genesis and in the migration.
+func (k Keeper) InitHolders(ctx sdk.Context) {
+	denoms := make(map[string]struct{})
+	k.IterateAllDefinitions(ctx, func(def types.Definition) bool {
+		denoms[def.Denom] = struct{}{}
+		return false
+	})
+
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if _, ok := denoms[coin.Denom]; ok && coin.IsPositive() {
+			ctx.KVStore(k.storeKey).Set(types.CreateHolderKey(coin.Denom, addr), holderValue)
+		}
+		return false
+	})
+}
+
+// sendCoins sends the coins using the bank keeper bypassing the fungible token rules and updates the holders index.
+func (k Keeper) sendCoins(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoins(ctx, fromAddress, toAddress, coins); err != nil {
+		return err
+	}
+	k.AfterSendCoins(ctx, fromAddress, toAddress, coins)
+	return nil
+}
+
+// updateHolders adds the accounts to or removes them from the holders index of the denom depending on
+// their current balances.
+func (k Keeper) updateHolders(ctx sdk.Context, denom string, addrs ...sdk.AccAddress) {
+	// the denoms not matching the format of the fungible tokens are skipped without touching the store
+	if _, _, err := types.DeconstructDenom(denom); err != nil {
+		return
+	}
+	if _, err := k.GetDefinition(ctx, denom); err != nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range addrs {
+		key := types.CreateHolderKey(denom, addr)
+		if k.bankKeeper.GetBalance(ctx, addr, denom).IsZero() {
+			store.Delete(key)
+			continue
+		}
+		store.Set(key, holderValue)
+	}
+}
+
+func (k Keeper) spendableBalance(ctx sdk.Context, addr sdk.AccAddress, def types.Definition) sdk.Coin {
+	balance := k.bankKeeper.GetBalance(ctx, addr, def.Denom)
+	if def.IsFeatureEnabled(types.Feature_freezing) && !def.IsAdmin(addr) {
+		if k.isGloballyFrozen(ctx, def.Denom) {
+			return sdk.NewCoin(def.Denom, sdk.ZeroInt())
+		}
+		balance = k.availableBalance(ctx, addr, def.Denom)
+	}
+
+	lockedBalance := k.GetLockedBalance(ctx, addr, def.Denom)
+	if lockedBalance.IsGTE(balance) {
+		return sdk.NewCoin(def.Denom, sdk.ZeroInt())
+	}
+	return balance.Sub(lockedBalance)
+}
@@ -15,6 +15,12 @@ const (
 	WhitelistingInvariantName = "whitelisting"
 	// BankMetadataExistsInvariantName is bank metadata exist name.
 	BankMetadataExistsInvariantName = "bank-metadata-exist"
+	// VestingSchedulesInvariantName is vesting schedules invariant name.
+	VestingSchedulesInvariantName = "vesting-schedules"
+	// MintingInvariantName is max supply and mint allowances invariant name.
+	MintingInvariantName = "minting"
+	// HoldersInvariantName is holders index invariant name.
+	HoldersInvariantName = "holders"
 )
 
 // RegisterInvariants registers the bank module invariants.
@@ -22,6 +28,9 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, FreezingInvariantName, FreezingInvariant(k))
 	ir.RegisterRoute(types.ModuleName, WhitelistingInvariantName, WhitelistingInvariant(k))
 	ir.RegisterRoute(types.ModuleName, BankMetadataExistsInvariantName, BankMetadataExistInvariant(k))
+	ir.RegisterRoute(types.ModuleName, VestingSchedulesInvariantName, VestingSchedulesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, MintingInvariantName, MintingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, HoldersInvariantName, HoldersInvariant(k))
 }
 
 // FreezingInvariant checks that all accounts in the application have non-negative frozen balances.
@@ -99,6 +108,119 @@ func BankMetadataExistInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// VestingSchedulesInvariant checks that all the stored vesting schedules are valid and belong to the existing tokens.
+func VestingSchedulesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateAllVestingSchedules(ctx, func(schedules types.AccountVestingSchedules) bool {
+			if err := schedules.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\taddress %s has invalid %s vesting schedules: %s\n", schedules.Account, schedules.Denom, err)
+				return false
+			}
+
+			if _, err := k.GetDefinition(ctx, schedules.Denom); err != nil {
+				count++
+				msg += fmt.Sprintf("\t definition for the %s denom not found\n", schedules.Denom)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, VestingSchedulesInvariantName,
+			fmt.Sprintf("amount of invalid vesting schedules found: %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// MintingInvariant checks that the bank supply of the tokens doesn't exceed their max supply
+// and all the stored mint allowances are valid and belong to the existing tokens.
+func MintingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateAllDefinitions(ctx, func(definition types.Definition) bool {
+			if !definition.IsSupplyLimited() {
+				return false
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, definition.Denom)
+			if supply.Amount.GT(definition.MaxSupply) {
+				count++
+				msg += fmt.Sprintf("\tsupply %s exceeds the max supply %s\n", supply, definition.MaxSupply)
+			}
+			return false
+		})
+
+		k.IterateAllMintAllowances(ctx, func(allowance types.MintAllowance) bool {
+			if err := allowance.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\tinvalid %s mint allowance of %s: %s\n", allowance.Denom, allowance.Minter, err)
+				return false
+			}
+
+			if _, err := k.GetDefinition(ctx, allowance.Denom); err != nil {
+				count++
+				msg += fmt.Sprintf("\t definition for the %s denom not found\n", allowance.Denom)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, MintingInvariantName,
+			fmt.Sprintf("amount of minting violations found: %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// HoldersInvariant checks that the holders index contains exactly the accounts having positive balances of the tokens.
+func HoldersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		denoms := make(map[string]struct{})
+		k.IterateAllDefinitions(ctx, func(definition types.Definition) bool {
+			denoms[definition.Denom] = struct{}{}
+			return false
+		})
+
+		k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+			if _, ok := denoms[coin.Denom]; !ok || !coin.IsPositive() {
+				return false
+			}
+			if !k.IsHolder(ctx, coin.Denom, addr) {
+				count++
+				msg += fmt.Sprintf("	address %s holding %s is missing in the holders index\n", addr, coin)
+			}
+			return false
+		})
+
+		k.IterateAllHolders(ctx, func(denom string, addr sdk.AccAddress) bool {
+			if k.bankKeeper.GetBalance(ctx, addr, denom).IsZero() {
+				count++
+				msg += fmt.Sprintf("	address %s is registered as the holder of %s with zero balance\n", addr, denom)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, HoldersInvariantName,
+			fmt.Sprintf("amount of holders index violations found: %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
 func applyFeatureBalanceInvariant(
 	ctx sdk.Context,
 	k Keeper,
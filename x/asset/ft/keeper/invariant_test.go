@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -202,3 +203,173 @@ func TestBankMetadataExistInvariant(t *testing.T) {
 	_, isBroken = keeper.BankMetadataExistInvariant(ftKeeper)(ctx)
 	requireT.True(isBroken)
 }
+
+func TestVestingSchedulesInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.CreateVestingSchedule(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(100)), 0, 0, 1000))
+
+	// check that initial state is valid
+	_, isBroken := keeper.VestingSchedulesInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// store the schedule of not existing token
+	ftKeeper.SetVestingSchedules(ctx, types.AccountVestingSchedules{
+		Account:   recipient.String(),
+		Denom:     types.BuildDenom("nonexistent", issuer),
+		Schedules: []types.VestingSchedule{types.NewVestingSchedule(sdk.NewInt(100), 0, 0, 1000)},
+	})
+
+	// check that state is broken now
+	_, isBroken = keeper.VestingSchedulesInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
+func TestMintingInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+		MaxSupply: sdk.NewInt(1000),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.GrantMintAllowance(ctx, issuer, minter, sdk.NewCoin(denom, sdk.NewInt(100))))
+
+	// check that initial state is valid
+	_, isBroken := keeper.MintingInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// store the mint allowance of not existing token
+	ftKeeper.SetMintAllowance(ctx, types.MintAllowance{
+		Denom:  types.BuildDenom("nonexistent", issuer),
+		Minter: minter.String(),
+		Amount: sdk.NewInt(100),
+	})
+
+	// check that state is broken now
+	_, isBroken = keeper.MintingInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+
+	// break the max supply
+	testApp = simapp.New()
+	ctx = testApp.NewContext(false, tmproto.Header{})
+	ftKeeper = testApp.AssetFTKeeper
+
+	denom, err = ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	definition, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+
+	definition.MaxSupply = sdk.NewInt(999)
+	ftKeeper.SetDefinition(ctx, settings.Issuer, settings.Subunit, definition)
+
+	_, isBroken = keeper.MintingInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
+func TestHoldersInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	// check that initial state is valid
+	_, isBroken := keeper.HoldersInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// send the coins bypassing the wrapped bank keeper, so the index isn't updated
+	requireT.NoError(bankKeeper.BaseKeeper.SendCoins(ctx, recipient, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	// check that state is broken now
+	_, isBroken = keeper.HoldersInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+
+	// rebuild the index
+	ftKeeper.InitHolders(ctx)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	_, isBroken = keeper.HoldersInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+}
+
+func TestHoldersInvariant_BurnFromModuleAccount(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// move the coins to the module account the same way as the gov deposit does
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	moduleAddr := testApp.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	requireT.NoError(bankKeeper.SendCoinsFromAccountToModule(ctx, issuer, govtypes.ModuleName, coins))
+	requireT.True(ftKeeper.IsHolder(ctx, denom, moduleAddr))
+
+	// burn the coins of the module account
+	requireT.NoError(bankKeeper.BurnCoins(ctx, govtypes.ModuleName, coins))
+	requireT.False(ftKeeper.IsHolder(ctx, denom, moduleAddr))
+
+	_, isBroken := keeper.HoldersInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+}
@@ -9,14 +9,28 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/CoreumFoundation/coreum/x/asset"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
+// trackedModuleAccounts are the module accounts whose balances are checked by the invariants of their modules.
+var trackedModuleAccounts = []string{
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+	distrtypes.ModuleName,
+	govtypes.ModuleName,
+}
+
 // ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
@@ -29,6 +43,8 @@ type Keeper struct {
 	paramSubspace ParamSubspace
 	storeKey      sdk.StoreKey
 	bankKeeper    types.BankKeeper
+	accountKeeper types.AccountKeeper
+	channelKeeper types.ChannelKeeper
 }
 
 // NewKeeper creates a new instance of the Keeper.
@@ -37,12 +53,16 @@ func NewKeeper(
 	paramSubspace ParamSubspace,
 	storeKey sdk.StoreKey,
 	bankKeeper types.BankKeeper,
+	accountKeeper types.AccountKeeper,
+	channelKeeper types.ChannelKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:           cdc,
 		paramSubspace: paramSubspace,
 		storeKey:      storeKey,
 		bankKeeper:    bankKeeper,
+		accountKeeper: accountKeeper,
+		channelKeeper: channelKeeper,
 	}
 }
 
@@ -152,6 +172,14 @@ func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, er
 		return "", sdkerrors.Wrapf(err, "provided symbol: %s", settings.Symbol)
 	}
 
+	if err := types.ValidateMaxSupply(settings.MaxSupply, settings.InitialAmount); err != nil {
+		return "", err
+	}
+	maxSupply := settings.MaxSupply
+	if maxSupply.IsNil() {
+		maxSupply = sdk.ZeroInt()
+	}
+
 	denom := types.BuildDenom(settings.Subunit, settings.Issuer)
 	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
 		return "", sdkerrors.Wrapf(
@@ -179,6 +207,10 @@ func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, er
 		Features:           settings.Features,
 		BurnRate:           settings.BurnRate,
 		SendCommissionRate: settings.SendCommissionRate,
+		Admin:              settings.Issuer.String(),
+		URI:                settings.URI,
+		URIHash:            settings.URIHash,
+		MaxSupply:          maxSupply,
 	}
 
 	if err := k.SetDenomMetadata(ctx, denom, settings.Symbol, settings.Description, settings.Precision); err != nil {
@@ -201,6 +233,9 @@ func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, er
 		Features:           settings.Features,
 		BurnRate:           settings.BurnRate,
 		SendCommissionRate: settings.SendCommissionRate,
+		URI:                settings.URI,
+		URIHash:            settings.URIHash,
+		MaxSupply:          maxSupply,
 	}); err != nil {
 		return "", sdkerrors.Wrap(err, "can't emit EventIssued event")
 	}
@@ -258,18 +293,100 @@ func (k Keeper) SetDenomMetadata(ctx sdk.Context, denom, symbol, description str
 	return nil
 }
 
+// UpdateMetadata updates the description, URI and URI hash of the fungible token.
+func (k Keeper) UpdateMetadata(ctx sdk.Context, sender sdk.AccAddress, denom, description, uri, uriHash string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_updatable_metadata); err != nil {
+		return err
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTokenNotFound, "metadata for %s denom not found", denom)
+	}
+	metadata.Description = description
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	def.URI = uri
+	def.URIHash = uriHash
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMetadataUpdated{
+		Denom:       denom,
+		Description: description,
+		URI:         uri,
+		URIHash:     uriHash,
+	})
+}
+
+// UpdateCommissionRecipients replaces the accounts receiving the send commission of the fungible token.
+func (k Keeper) UpdateCommissionRecipients(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	recipients []types.CommissionRecipient,
+) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the admin of %s", sender.String(), denom)
+	}
+
+	if err := types.ValidateCommissionRecipients(recipients); err != nil {
+		return err
+	}
+
+	for _, recipient := range recipients {
+		if k.isTrackedModuleAccount(ctx, sdk.MustAccAddressFromBech32(recipient.Address)) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive the commission", recipient.Address)
+		}
+	}
+
+	def.CommissionRecipients = recipients
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventCommissionRecipientsUpdated{
+		Denom:      denom,
+		Recipients: recipients,
+	})
+}
+
 // Mint mints new fungible token.
+// Apart from the admin, the token might be minted by the minters having the mint allowance, which is decreased
+// by the minted amount.
 func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error {
 	def, err := k.GetDefinition(ctx, coin.Denom)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
+	if def.IsAdmin(sender) {
+		if err = def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
+			return err
+		}
+		return k.mintIfReceivable(ctx, def, coin.Amount, sender)
+	}
+
+	allowance, err := k.checkMintAllowance(ctx, def, sender, coin.Amount)
+	if err != nil {
+		return err
+	}
+	if err := k.mintIfReceivable(ctx, def, coin.Amount, sender); err != nil {
 		return err
 	}
 
-	return k.mintIfReceivable(ctx, def, coin.Amount, sender)
+	return k.useMintAllowance(ctx, sender, allowance, coin.Amount)
 }
 
 // Burn burns fungible token.
@@ -298,8 +415,8 @@ func (k Keeper) Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Co
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if def.IsIssuer(addr) {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be frozen")
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be frozen")
 	}
 
 	if err = def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
@@ -435,8 +552,8 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if def.IsIssuer(addr) {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be whitelisted")
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be whitelisted")
 	}
 
 	if err = def.CheckFeatureAllowed(sender, types.Feature_whitelisting); err != nil {
@@ -455,6 +572,102 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 	})
 }
 
+// Clawback returns the specified amount of fungible token from the specified account to the admin.
+// Global freeze doesn't apply to clawback, but the frozen part of the balance can't be clawed back.
+func (k Keeper) Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "clawback amount should be positive")
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin's balance can't be clawed back")
+	}
+
+	// the balances of the module accounts back the state of their modules, e.g. the tokens held by the ibc escrow
+	// back the vouchers on the counterparty chain
+	if k.bankKeeper.BlockedAddr(addr) || k.isModuleAccount(ctx, addr) || k.isIBCEscrowAccount(ctx, addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "balance of the module account %s can't be clawed back", addr)
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_clawback); err != nil {
+		return err
+	}
+
+	availableBalance := k.availableBalance(ctx, addr, coin.Denom)
+	if !availableBalance.IsGTE(coin) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is not available, available %s", coin, availableBalance)
+	}
+
+	// the unlocked tokens are clawed back first, the vesting schedules are reduced by the locked amount taken,
+	// so they don't lock the tokens received later
+	if lockedAmount := coin.Amount.Sub(k.unlockedAmount(ctx, addr, availableBalance)); lockedAmount.IsPositive() {
+		schedules := k.GetVestingSchedules(ctx, addr, coin.Denom)
+		k.SetVestingSchedules(ctx, schedules.ReduceLocked(ctx.BlockTime().Unix(), lockedAmount))
+	}
+
+	if err := k.sendCoins(ctx, addr, sender, sdk.NewCoins(coin)); err != nil {
+		return sdkerrors.Wrapf(err, "can't send coins from account %s to admin %s", addr.String(), sender.String())
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAmountClawedBack{
+		Account: addr.String(),
+		Denom:   coin.Denom,
+		Amount:  coin.Amount,
+	})
+}
+
+// TransferAdmin changes the admin of a fungible token.
+func (k Keeper) TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the admin of %s", sender.String(), denom)
+	}
+
+	previousAdmin := def.Admin
+	def.Admin = addr.String()
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAdminTransferred{
+		Denom:         denom,
+		PreviousAdmin: previousAdmin,
+		CurrentAdmin:  def.Admin,
+	})
+}
+
+// ClearAdmin removes the admin of a fungible token, so none of the privileged operations can be executed anymore.
+func (k Keeper) ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the admin of %s", sender.String(), denom)
+	}
+
+	previousAdmin := def.Admin
+	def.Admin = ""
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAdminCleared{
+		Denom:         denom,
+		PreviousAdmin: previousAdmin,
+	})
+}
+
 // GetAccountsWhitelistedBalances returns the whitelisted balance of all the account.
 func (k Keeper) GetAccountsWhitelistedBalances(ctx sdk.Context, pagination *query.PageRequest) ([]types.Balance, *query.PageResponse, error) {
 	return collectBalances(k.cdc, prefix.NewStore(ctx.KVStore(k.storeKey), types.WhitelistedBalancesKeyPrefix), pagination)
@@ -485,6 +698,42 @@ func (k Keeper) SetWhitelistedBalances(ctx sdk.Context, addr sdk.AccAddress, coi
 	}
 }
 
+func (k Keeper) updateDefinition(ctx sdk.Context, def types.Definition) error {
+	subunit, issuer, err := types.DeconstructDenom(def.Denom)
+	if err != nil {
+		return err
+	}
+	k.SetDefinition(ctx, issuer, subunit, def)
+	return nil
+}
+
+// isTrackedModuleAccount returns true if the account is the module account whose balance is checked by the
+// invariants of its module or the ibc escrow account backing the vouchers on the counterparty chain.
+func (k Keeper) isTrackedModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	for _, moduleName := range trackedModuleAccounts {
+		if addr.Equals(authtypes.NewModuleAddress(moduleName)) {
+			return true
+		}
+	}
+	return k.isIBCEscrowAccount(ctx, addr)
+}
+
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
+
+// isIBCEscrowAccount returns true if the address is the escrow account of any ICS-20 channel.
+func (k Keeper) isIBCEscrowAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	var isEscrow bool
+	k.channelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		isEscrow = channel.PortId == ibctransfertypes.PortID &&
+			addr.Equals(ibctransfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId))
+		return isEscrow
+	})
+	return isEscrow
+}
+
 func (k Keeper) mintIfReceivable(ctx sdk.Context, def types.Definition, amount sdk.Int, recipient sdk.AccAddress) error {
 	if !amount.IsPositive() {
 		return nil
@@ -493,6 +742,14 @@ func (k Keeper) mintIfReceivable(ctx sdk.Context, def types.Definition, amount s
 		return sdkerrors.Wrapf(err, "coins are not receivable")
 	}
 
+	if def.IsSupplyLimited() {
+		supply := k.bankKeeper.GetSupply(ctx, def.Denom)
+		if supply.Amount.Add(amount).GT(def.MaxSupply) {
+			return sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "can't mint %s, current supply %s, max supply %s",
+				sdk.NewCoin(def.Denom, amount), supply, sdk.NewCoin(def.Denom, def.MaxSupply))
+		}
+	}
+
 	coinsToMint := sdk.NewCoins(sdk.NewCoin(def.Denom, amount))
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coinsToMint); err != nil {
 		return sdkerrors.Wrapf(err, "can't mint %s for the module %s", coinsToMint.String(), types.ModuleName)
@@ -501,6 +758,7 @@ func (k Keeper) mintIfReceivable(ctx sdk.Context, def types.Definition, amount s
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coinsToMint); err != nil {
 		return sdkerrors.Wrapf(err, "can't send minted coins from module %s to account %s", types.ModuleName, recipient.String())
 	}
+	k.updateHolders(ctx, def.Denom, recipient)
 
 	return nil
 }
@@ -521,20 +779,35 @@ func (k Keeper) burn(ctx sdk.Context, account sdk.AccAddress, coinsToBurn sdk.Co
 	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn); err != nil {
 		return sdkerrors.Wrapf(err, "can't burn %s for the module %s", coinsToBurn.String(), types.ModuleName)
 	}
+	for _, coin := range coinsToBurn {
+		k.updateHolders(ctx, coin.Denom, account)
+	}
 
 	return nil
 }
 
 func (k Keeper) isCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, def types.Definition, amount sdk.Int) error {
-	if !def.IsFeatureEnabled(types.Feature_freezing) || def.IsIssuer(addr) {
+	isFreezingApplied := def.IsFeatureEnabled(types.Feature_freezing) && !def.IsAdmin(addr)
+	lockedBalance := k.GetLockedBalance(ctx, addr, def.Denom)
+	if !isFreezingApplied && lockedBalance.IsZero() {
 		return nil
 	}
 
-	if k.isGloballyFrozen(ctx, def.Denom) {
+	if isFreezingApplied && k.isGloballyFrozen(ctx, def.Denom) {
 		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "%s is globally frozen", def.Denom)
 	}
 
-	availableBalance := k.availableBalance(ctx, addr, def.Denom)
+	availableBalance := k.bankKeeper.GetBalance(ctx, addr, def.Denom)
+	if isFreezingApplied {
+		availableBalance = k.availableBalance(ctx, addr, def.Denom)
+	}
+	// the tokens locked by the vesting schedules are not available in the same way as the frozen ones
+	if lockedBalance.IsGTE(availableBalance) {
+		availableBalance = sdk.NewCoin(def.Denom, sdk.ZeroInt())
+	} else {
+		availableBalance = availableBalance.Sub(lockedBalance)
+	}
+
 	if !availableBalance.Amount.GTE(amount) {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is not available, available %s",
 			sdk.NewCoin(def.Denom, amount), availableBalance)
@@ -543,7 +816,7 @@ func (k Keeper) isCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, def types.
 }
 
 func (k Keeper) isCoinReceivable(ctx sdk.Context, addr sdk.AccAddress, def types.Definition, amount sdk.Int) error {
-	if !def.IsFeatureEnabled(types.Feature_whitelisting) || def.IsIssuer(addr) {
+	if !def.IsFeatureEnabled(types.Feature_whitelisting) || def.IsAdmin(addr) {
 		return nil
 	}
 
@@ -577,6 +850,15 @@ func (k Keeper) availableBalance(ctx sdk.Context, addr sdk.AccAddress, denom str
 	return balance.Sub(frozenBalance)
 }
 
+// unlockedAmount returns the part of the available balance which is not locked by the vesting schedules.
+func (k Keeper) unlockedAmount(ctx sdk.Context, addr sdk.AccAddress, availableBalance sdk.Coin) sdk.Int {
+	lockedBalance := k.GetLockedBalance(ctx, addr, availableBalance.Denom)
+	if lockedBalance.IsGTE(availableBalance) {
+		return sdk.ZeroInt()
+	}
+	return availableBalance.Amount.Sub(lockedBalance.Amount)
+}
+
 func (k Keeper) getDefinitions(ctx sdk.Context, pagination *query.PageRequest) ([]types.Definition, *query.PageResponse, error) {
 	return k.getDefinitionsFromStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenKeyPrefix), pagination)
 }
@@ -609,16 +891,21 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 	}
 
 	return types.Token{
-		Denom:              definition.Denom,
-		Issuer:             definition.Issuer,
-		Symbol:             metadata.Symbol,
-		Precision:          uint32(precision),
-		Subunit:            subunit,
-		Description:        metadata.Description,
-		Features:           definition.Features,
-		BurnRate:           definition.BurnRate,
-		SendCommissionRate: definition.SendCommissionRate,
-		GloballyFrozen:     k.isGloballyFrozen(ctx, definition.Denom),
+		Denom:                definition.Denom,
+		Issuer:               definition.Issuer,
+		Symbol:               metadata.Symbol,
+		Precision:            uint32(precision),
+		Subunit:              subunit,
+		Description:          metadata.Description,
+		Features:             definition.Features,
+		BurnRate:             definition.BurnRate,
+		SendCommissionRate:   definition.SendCommissionRate,
+		GloballyFrozen:       k.isGloballyFrozen(ctx, definition.Denom),
+		Admin:                definition.Admin,
+		URI:                  definition.URI,
+		URIHash:              definition.URIHash,
+		MaxSupply:            definition.MaxSupply,
+		CommissionRecipients: definition.CommissionRecipients,
 	}, nil
 }
 
@@ -4,13 +4,18 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,8 +24,10 @@ import (
 	"github.com/CoreumFoundation/coreum/pkg/config/constant"
 	"github.com/CoreumFoundation/coreum/testutil/event"
 	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	wbankkeeper "github.com/CoreumFoundation/coreum/x/wbank/keeper"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/x/wibctransfer/types"
 )
 
 func TestKeeper_Issue(t *testing.T) {
@@ -78,6 +85,8 @@ func TestKeeper_Issue(t *testing.T) {
 		Features:           []types.Feature{types.Feature_freezing},
 		BurnRate:           sdk.NewDec(0),
 		SendCommissionRate: sdk.NewDec(0),
+		Admin:              settings.Issuer.String(),
+		MaxSupply:          sdk.ZeroInt(),
 	}, gotToken)
 
 	// check the metadata
@@ -399,6 +408,167 @@ func TestKeeper_Mint(t *testing.T) {
 	requireT.EqualValues(sdk.NewInt(877), totalSupply.Supply.AmountOf(mintableDenom))
 }
 
+func TestKeeper_MaxSupply(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        addr,
+		Symbol:        "capped",
+		Subunit:       "capped",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1001),
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_burning,
+		},
+		MaxSupply: sdk.NewInt(1000),
+	}
+
+	// try to issue the token with initial amount exceeding the max supply
+	_, err := ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to issue the token with negative max supply
+	settings.MaxSupply = sdk.NewInt(-1)
+	_, err = ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	settings.InitialAmount = sdk.NewInt(700)
+	settings.MaxSupply = sdk.NewInt(1000)
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(1000).String(), token.MaxSupply.String())
+
+	// try to mint more than the max supply
+	err = ftKeeper.Mint(ctx, addr, sdk.NewCoin(denom, sdk.NewInt(301)))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// mint up to the max supply
+	requireT.NoError(ftKeeper.Mint(ctx, addr, sdk.NewCoin(denom, sdk.NewInt(300))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 1000).String(), bankKeeper.GetSupply(ctx, denom).String())
+
+	err = ftKeeper.Mint(ctx, addr, sdk.NewCoin(denom, sdk.NewInt(1)))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// burnt tokens might be minted again
+	requireT.NoError(ftKeeper.Burn(ctx, addr, sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(ftKeeper.Mint(ctx, addr, sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 1000).String(), bankKeeper.GetSupply(ctx, denom).String())
+
+	// the token without max supply might be minted without limits
+	settings.Symbol = "uncapped"
+	settings.Subunit = "uncapped"
+	settings.MaxSupply = sdk.Int{}
+	uncappedDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.Mint(ctx, addr, sdk.NewCoin(uncappedDenom, sdk.NewInt(1_000_000))))
+}
+
+func TestKeeper_MintAllowance(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "NotMintable",
+		Subunit:       "notmintable",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(777),
+	}
+
+	unmintableDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// try to grant the mint allowance of unmintable token
+	err = ftKeeper.GrantMintAllowance(ctx, issuer, minter, sdk.NewCoin(unmintableDenom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	settings = types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "mintable",
+		Subunit:       "mintable",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(777),
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+		MaxSupply: sdk.NewInt(1000),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// try to grant the mint allowance by non-admin
+	err = ftKeeper.GrantMintAllowance(ctx, minter, minter, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to grant the mint allowance to admin
+	err = ftKeeper.GrantMintAllowance(ctx, issuer, issuer, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to mint without the mint allowance
+	err = ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// grant the mint allowance and mint part of it
+	requireT.NoError(ftKeeper.GrantMintAllowance(ctx, issuer, minter, sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(60))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 60).String(), bankKeeper.GetBalance(ctx, minter, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 40).String(), ftKeeper.GetMintAllowance(ctx, denom, minter).String())
+
+	// try to mint more than the allowance
+	err = ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(41)))
+	requireT.ErrorIs(err, types.ErrMintAllowanceExceeded)
+
+	// the allowance is replaced on the next grant, but the max supply still applies
+	requireT.NoError(ftKeeper.GrantMintAllowance(ctx, issuer, minter, sdk.NewCoin(denom, sdk.NewInt(500))))
+	allowances, _, err := ftKeeper.GetMintAllowances(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.MintAllowance{{
+		Denom:  denom,
+		Minter: minter.String(),
+		Amount: sdk.NewInt(500),
+	}}, allowances)
+
+	err = ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(164)))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+	requireT.NoError(ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(163))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 337).String(), ftKeeper.GetMintAllowance(ctx, denom, minter).String())
+
+	// revoke the allowance
+	requireT.NoError(ftKeeper.RevokeMintAllowance(ctx, issuer, minter, denom))
+	requireT.True(ftKeeper.GetMintAllowance(ctx, denom, minter).IsZero())
+	err = ftKeeper.RevokeMintAllowance(ctx, issuer, minter, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// fully used allowance is removed
+	requireT.NoError(ftKeeper.Burn(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(ftKeeper.GrantMintAllowance(ctx, issuer, minter, sdk.NewCoin(denom, sdk.NewInt(50))))
+	requireT.NoError(ftKeeper.Mint(ctx, minter, sdk.NewCoin(denom, sdk.NewInt(50))))
+	allowances, _, err = ftKeeper.GetMintAllowances(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Empty(allowances)
+}
+
 func TestKeeper_Burn(t *testing.T) {
 	requireT := require.New(t)
 
@@ -882,289 +1052,428 @@ func TestKeeper_BurnRateAndSendCommissionRate_BankSend(t *testing.T) {
 }
 
 //nolint:funlen // this is complex test scenario and breaking it down is not helpful
-func TestKeeper_FreezeUnfreeze(t *testing.T) {
+func TestKeeper_RateExemption(t *testing.T) {
 	requireT := require.New(t)
-	assertT := assert.New(t)
 
 	testApp := simapp.New()
 	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
 
-	ftKeeper := testApp.AssetFTKeeper
+	assetKeeper := testApp.AssetFTKeeper
 	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
 
+	// issue token
 	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-
 	settings := types.IssueSettings{
-		Issuer:        issuer,
-		Symbol:        "DEF",
-		Subunit:       "def",
-		Precision:     1,
-		Description:   "DEF Desc",
-		InitialAmount: sdk.NewInt(666),
-		Features:      []types.Feature{types.Feature_freezing},
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		Description:        "DEF Desc",
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{},
+		BurnRate:           sdk.MustNewDecFromStr("0.5"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.25"),
 	}
 
-	denom, err := ftKeeper.Issue(ctx, settings)
+	denom, err := assetKeeper.Issue(ctx, settings)
 	requireT.NoError(err)
 
-	unfreezableSettings := types.IssueSettings{
-		Issuer:        issuer,
-		Symbol:        "ABC",
-		Subunit:       "abc",
-		Precision:     1,
-		Description:   "ABC Desc",
-		InitialAmount: sdk.NewInt(666),
-		Features:      []types.Feature{},
-	}
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	exchange := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
-	unfreezableDenom, err := ftKeeper.Issue(ctx, unfreezableSettings)
-	requireT.NoError(err)
-	_, err = ftKeeper.GetToken(ctx, unfreezableDenom)
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(400))))
 	requireT.NoError(err)
-
-	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(
-		sdk.NewCoin(denom, sdk.NewInt(100)),
-		sdk.NewCoin(unfreezableDenom, sdk.NewInt(100)),
-	))
+	err = bankKeeper.SendCoins(ctx, issuer, exchange, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200))))
 	requireT.NoError(err)
 
-	// try to freeze non-existent denom
-	nonExistentDenom := types.BuildDenom("nonexist", issuer)
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(nonExistentDenom, sdk.NewInt(10)))
-	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
-
-	// try to freeze unfreezable Token
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(unfreezableDenom, sdk.NewInt(10)))
-	assertT.ErrorIs(err, types.ErrFeatureDisabled)
-
-	// try to freeze from non issuer address
-	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.Freeze(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(10)))
-	assertT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	// try to set the exemption by non-admin
+	err = assetKeeper.SetRateExemption(ctx, recipient, exchange, denom, true)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 
-	// try to freeze 0 balance
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(0)))
-	requireT.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	// try to exempt the admin
+	err = assetKeeper.SetRateExemption(ctx, issuer, issuer, denom, true)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 
-	// try to unfreeze 0 balance
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(0)))
-	requireT.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	// try to set the exemption for the unknown denom
+	err = assetKeeper.SetRateExemption(ctx, issuer, exchange, types.BuildDenom("unknown", issuer), true)
+	requireT.ErrorIs(err, types.ErrTokenNotFound)
 
-	// try to freeze more than balance
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(110)))
+	// exempt the exchange
+	requireT.False(assetKeeper.IsRateExempt(ctx, denom, exchange))
+	err = assetKeeper.SetRateExemption(ctx, issuer, exchange, denom, true)
 	requireT.NoError(err)
-	frozenBalance := ftKeeper.GetFrozenBalance(ctx, recipient, denom)
-	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(110)), frozenBalance)
-
-	// try to unfreeze more than frozen balance
-	err = ftKeeper.Unfreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(130)))
-	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
-	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
-	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(110)), frozenBalance)
+	requireT.True(assetKeeper.IsRateExempt(ctx, denom, exchange))
 
-	// set frozen balance back to zero
-	err = ftKeeper.Unfreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(110)))
+	exemptions, _, err := assetKeeper.GetRateExemptions(ctx, denom, nil)
 	requireT.NoError(err)
-	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
-	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(0)).String(), frozenBalance.String())
+	requireT.Equal([]types.RateExemption{{Denom: denom, Account: exchange.String()}}, exemptions)
 
-	// freeze, query frozen
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(40)))
+	// send to the exchange (fees must not apply)
+	err = bankKeeper.SendCoins(ctx, recipient, exchange, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
 	requireT.NoError(err)
-	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(40)).String(), frozenBalance.String())
-
-	// test query all frozen
-	allBalances, pageRes, err := ftKeeper.GetAccountsFrozenBalances(ctx, &query.PageRequest{})
-	assertT.NoError(err)
-	assertT.Len(allBalances, 1)
-	assertT.EqualValues(1, pageRes.GetTotal())
-	assertT.EqualValues(recipient.String(), allBalances[0].Address)
-	requireT.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(40))).String(), allBalances[0].Coins.String())
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    400,
+		&recipient: 300,
+		&exchange:  300,
+	})
 
-	// increase frozen and query
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(40)))
+	// send from the exchange (fees must not apply)
+	err = bankKeeper.SendCoins(ctx, exchange, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
 	requireT.NoError(err)
-	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(80)), frozenBalance)
-
-	// try to send more than available
-	coinsToSend := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(80)))
-	// send
-	err = bankKeeper.SendCoins(ctx, recipient, issuer, coinsToSend)
-	assertT.True(sdkerrors.IsOf(err, sdkerrors.ErrInsufficientFunds))
-	// multi-send
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
-		[]banktypes.Output{{Address: issuer.String(), Coins: coinsToSend}})
-	assertT.True(sdkerrors.IsOf(err, sdkerrors.ErrInsufficientFunds))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    400,
+		&recipient: 400,
+		&exchange:  200,
+	})
 
-	// try to send unfrozen balance
-	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	coinsToSend = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))
-	// send
-	err = bankKeeper.SendCoins(ctx, recipient, recipient2, coinsToSend)
-	requireT.NoError(err)
-	balance := bankKeeper.GetBalance(ctx, recipient, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(90)), balance)
-	balance = bankKeeper.GetBalance(ctx, recipient2, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(10)), balance)
-	// multi-send
+	// multi-send with the exchange in inputs (fees apply to the non-exempted part only)
 	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
-		[]banktypes.Output{{Address: recipient2.String(), Coins: coinsToSend}})
+		[]banktypes.Input{
+			{Address: exchange.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))},
+			{Address: recipient.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))},
+		},
+		[]banktypes.Output{
+			{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200)))},
+		},
+	)
 	requireT.NoError(err)
-	balance = bankKeeper.GetBalance(ctx, recipient, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(80)), balance)
-	balance = bankKeeper.GetBalance(ctx, recipient2, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(20)), balance)
-
-	// try to unfreeze from non issuer address
-	err = ftKeeper.Unfreeze(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(80)))
-	assertT.True(sdkerrors.IsOf(err, sdkerrors.ErrUnauthorized))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:     425,
+		&recipient:  225,
+		&recipient2: 200,
+		&exchange:   100,
+	})
 
-	// unfreeze, query frozen, and try to send
-	err = ftKeeper.Unfreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(80)))
+	// remove the exemption
+	err = assetKeeper.SetRateExemption(ctx, issuer, exchange, denom, false)
 	requireT.NoError(err)
-	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(0)), frozenBalance)
-	coinsToSend = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(40)))
-	// send
-	err = bankKeeper.SendCoins(ctx, recipient, recipient2, coinsToSend)
+	requireT.False(assetKeeper.IsRateExempt(ctx, denom, exchange))
+
+	exemptions, _, err = assetKeeper.GetRateExemptions(ctx, denom, nil)
 	requireT.NoError(err)
-	balance = bankKeeper.GetBalance(ctx, recipient, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(40)), balance)
-	balance = bankKeeper.GetBalance(ctx, recipient2, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(60)), balance)
-	// multi-send
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
-		[]banktypes.Output{{Address: recipient2.String(), Coins: coinsToSend}})
+	requireT.Empty(exemptions)
+
+	// send to the exchange (fees must apply)
+	err = bankKeeper.SendCoins(ctx, recipient, exchange, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
 	requireT.NoError(err)
-	balance = bankKeeper.GetBalance(ctx, recipient, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(0)), balance)
-	balance = bankKeeper.GetBalance(ctx, recipient2, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(100)), balance)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:     450,
+		&recipient:  50,
+		&recipient2: 200,
+		&exchange:   200,
+	})
 }
 
-//nolint:funlen // this is complex tests scenario and breaking it down is not beneficial
-func TestKeeper_GlobalFreezeUnfreeze(t *testing.T) {
+//nolint:funlen // this is complex test scenario and breaking it down is not helpful
+func TestKeeper_CommissionRecipients(t *testing.T) {
 	requireT := require.New(t)
-	assertT := assert.New(t)
 
 	testApp := simapp.New()
 	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
 
-	ftKeeper := testApp.AssetFTKeeper
+	assetKeeper := testApp.AssetFTKeeper
 	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
 
+	// issue token
 	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-
-	freezableSettings := types.IssueSettings{
-		Issuer:        issuer,
-		Symbol:        "FREEZE",
-		Subunit:       "freeze",
-		Precision:     6,
-		Description:   "FREEZE Desc",
-		InitialAmount: sdk.NewInt(777),
-		Features:      []types.Feature{types.Feature_freezing},
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		Description:        "DEF Desc",
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
 	}
 
-	freezableDenom, err := ftKeeper.Issue(ctx, freezableSettings)
+	denom, err := assetKeeper.Issue(ctx, settings)
 	requireT.NoError(err)
 
-	unfreezableSettings := types.IssueSettings{
-		Issuer:        issuer,
-		Symbol:        "NOFREEZE",
-		Subunit:       "nofreeze",
-		Precision:     6,
-		Description:   "NOFREEZE Desc",
-		InitialAmount: sdk.NewInt(777),
-		Features:      []types.Feature{},
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	partner1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	partner2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipients := []types.CommissionRecipient{
+		{Address: partner1.String(), Weight: 2},
+		{Address: partner2.String(), Weight: 1},
 	}
 
-	unfreezableDenom, err := ftKeeper.Issue(ctx, unfreezableSettings)
-	requireT.NoError(err)
-	_, err = ftKeeper.GetToken(ctx, unfreezableDenom)
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))))
 	requireT.NoError(err)
 
-	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(
-		sdk.NewCoin(freezableDenom, sdk.NewInt(100)),
-		sdk.NewCoin(unfreezableDenom, sdk.NewInt(100)),
-	))
-	requireT.NoError(err)
+	// try to update the recipients by non-admin
+	err = assetKeeper.UpdateCommissionRecipients(ctx, recipient, denom, recipients)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 
-	// try to global-freeze non-existent
-	nonExistentDenom := types.BuildDenom("nonexist", issuer)
-	err = ftKeeper.GloballyFreeze(ctx, issuer, nonExistentDenom)
-	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
+	// try to set the invalid recipients
+	err = assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, []types.CommissionRecipient{
+		{Address: partner1.String(), Weight: 0},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
 
-	// try to global-freeze unfreezable Token
-	err = ftKeeper.GloballyFreeze(ctx, issuer, unfreezableDenom)
-	assertT.ErrorIs(err, types.ErrFeatureDisabled)
+	// try to set the module account whose balance is checked by the invariants
+	err = assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, []types.CommissionRecipient{
+		{Address: partner1.String(), Weight: 1},
+		{Address: authtypes.NewModuleAddress(distrtypes.ModuleName).String(), Weight: 1},
+	})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 
-	// try to global-freeze from non issuer address
-	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.GloballyFreeze(ctx, randomAddr, freezableDenom)
-	assertT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	// try to set the escrow account of the ICS-20 channel
+	testApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, "channel-0", channeltypes.Channel{
+		State:    channeltypes.OPEN,
+		Ordering: channeltypes.UNORDERED,
+	})
+	err = assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, []types.CommissionRecipient{
+		{Address: ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0").String(), Weight: 1},
+	})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 
-	// freeze twice to check global-freeze idempotence
-	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
+	// update the recipients
+	err = assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, recipients)
 	requireT.NoError(err)
-	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
-	requireT.NoError(err)
-	frozenToken, err := ftKeeper.GetToken(ctx, freezableDenom)
+
+	token, err := assetKeeper.GetToken(ctx, denom)
 	requireT.NoError(err)
-	assertT.True(frozenToken.GloballyFrozen)
+	requireT.Equal(recipients, token.CommissionRecipients)
 
-	// try to global-unfreeze from non issuer address
-	err = ftKeeper.GloballyUnfreeze(ctx, randomAddr, freezableDenom)
-	assertT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	// send with the commission split between the recipients, the remainder goes to the last one
+	err = bankKeeper.SendCoins(ctx, recipient, issuer, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(err)
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(err)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:     600,
+		&recipient:  290,
+		&recipient2: 100,
+		&partner1:   6,
+		&partner2:   4,
+	})
 
-	// unfreeze twice to check global-unfreeze idempotence
-	err = ftKeeper.GloballyUnfreeze(ctx, issuer, freezableDenom)
+	// the commission is still charged when the admin is cleared
+	err = assetKeeper.ClearAdmin(ctx, issuer, denom)
 	requireT.NoError(err)
-	err = ftKeeper.GloballyUnfreeze(ctx, issuer, freezableDenom)
+	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(30))))
 	requireT.NoError(err)
-	unfrozenToken, err := ftKeeper.GetToken(ctx, freezableDenom)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:     600,
+		&recipient:  257,
+		&recipient2: 130,
+		&partner1:   8,
+		&partner2:   5,
+	})
+}
+
+func TestKeeper_CommissionRecipientsWhitelisting(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	// issue token
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_whitelisting,
+		},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom, err := assetKeeper.Issue(ctx, settings)
 	requireT.NoError(err)
-	assertT.False(unfrozenToken.GloballyFrozen)
 
-	// freeze, try to send & verify balance
-	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	partner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(assetKeeper.SetWhitelistedBalance(ctx, issuer, sender, sdk.NewCoin(denom, sdk.NewInt(500))))
+	requireT.NoError(assetKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(500))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, sender, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500)))))
+
+	requireT.NoError(assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, []types.CommissionRecipient{
+		{Address: partner.String(), Weight: 1},
+	}))
+
+	// the partner is not whitelisted so the commission can't be received
+	err = bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+
+	// whitelist the partner and send again
+	requireT.NoError(assetKeeper.SetWhitelistedBalance(ctx, issuer, partner, sdk.NewCoin(denom, sdk.NewInt(10))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    500,
+		&sender:    390,
+		&recipient: 100,
+		&partner:   10,
+	})
+
+	// the whitelisted limit of the partner is reached
+	err = bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10))))
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+}
+
+func TestKeeper_CommissionRecipientsModuleAccount(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	// issue token
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		Description:        "DEF Desc",
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom, err := assetKeeper.Issue(ctx, settings)
 	requireT.NoError(err)
-	coinsToSend := sdk.NewCoins(sdk.NewCoin(freezableDenom, sdk.NewInt(10)))
-	// send
-	err = bankKeeper.SendCoins(ctx, recipient, randomAddr, coinsToSend)
-	assertT.ErrorIs(err, types.ErrGloballyFrozen)
-	// multi-send
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
-		[]banktypes.Output{{Address: randomAddr.String(), Coins: coinsToSend}})
-	assertT.ErrorIs(err, types.ErrGloballyFrozen)
 
-	// unfreeze, try to send & verify balance
-	err = ftKeeper.GloballyUnfreeze(ctx, issuer, freezableDenom)
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, sender, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500)))))
+
+	// the module account blocked by the bank module might receive the commission
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	requireT.True(bankKeeper.BlockedAddr(feeCollector))
+	requireT.NoError(assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, []types.CommissionRecipient{
+		{Address: feeCollector.String(), Weight: 1},
+	}))
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:       500,
+		&sender:       390,
+		&recipient:    100,
+		&feeCollector: 10,
+	})
+}
+
+//nolint:funlen // this is complex test scenario and breaking it down is not helpful
+func TestKeeper_Holders(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_burning,
+			types.Feature_freezing,
+			types.Feature_whitelisting,
+			types.Feature_clawback,
+		},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom, err := assetKeeper.Issue(ctx, settings)
 	requireT.NoError(err)
-	coinsToSend = sdk.NewCoins(sdk.NewCoin(freezableDenom, sdk.NewInt(6)))
-	// send
-	err = bankKeeper.SendCoins(ctx, recipient, randomAddr, coinsToSend)
+
+	holderAddresses := func() []string {
+		holders, _, err := assetKeeper.GetHolders(ctx, denom, nil)
+		requireT.NoError(err)
+		addresses := make([]string, 0, len(holders))
+		for _, holder := range holders {
+			addresses = append(addresses, holder.Address)
+		}
+		return addresses
+	}
+
+	requireT.ElementsMatch([]string{issuer.String()}, holderAddresses())
+
+	partner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(assetKeeper.UpdateCommissionRecipients(ctx, issuer, denom, []types.CommissionRecipient{
+		{Address: partner.String(), Weight: 1},
+	}))
+
+	// send to the accounts, the commission recipient becomes the holder as well
+	recipient1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(assetKeeper.SetWhitelistedBalance(ctx, issuer, partner, sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(assetKeeper.SetWhitelistedBalance(ctx, issuer, recipient1, sdk.NewCoin(denom, sdk.NewInt(500))))
+	requireT.NoError(assetKeeper.SetWhitelistedBalance(ctx, issuer, recipient2, sdk.NewCoin(denom, sdk.NewInt(500))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient1, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(300)))))
+	requireT.NoError(bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))}},
+		[]banktypes.Output{{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))}},
+	))
+	requireT.ElementsMatch(
+		[]string{issuer.String(), recipient1.String(), recipient2.String(), partner.String()},
+		holderAddresses(),
+	)
+
+	// check the amounts
+	requireT.NoError(assetKeeper.Freeze(ctx, issuer, recipient1, sdk.NewCoin(denom, sdk.NewInt(50))))
+	holders, _, err := assetKeeper.GetHolders(ctx, denom, nil)
 	requireT.NoError(err)
-	balance := bankKeeper.GetBalance(ctx, randomAddr, freezableDenom)
-	requireT.Equal(sdk.NewCoin(freezableDenom, sdk.NewInt(6)), balance)
-	// multi-send
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
-		[]banktypes.Output{{Address: randomAddr.String(), Coins: coinsToSend}})
+	for _, holder := range holders {
+		if holder.Address != recipient1.String() {
+			continue
+		}
+		requireT.Equal(types.TokenHolder{
+			Address:     recipient1.String(),
+			Balance:     sdk.NewInt(190),
+			Frozen:      sdk.NewInt(50),
+			Whitelisted: sdk.NewInt(500),
+			Spendable:   sdk.NewInt(140),
+		}, holder)
+	}
+
+	// the accounts without the balance are removed
+	requireT.NoError(assetKeeper.Clawback(ctx, issuer, recipient2, sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, partner, issuer, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))))
+	requireT.NoError(assetKeeper.Burn(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(810))))
+	requireT.ElementsMatch([]string{recipient1.String()}, holderAddresses())
+
+	// pagination
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient1, issuer, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+	holders, pageRes, err := assetKeeper.GetHolders(ctx, denom, &query.PageRequest{Limit: 1, CountTotal: true})
 	requireT.NoError(err)
-	balance = bankKeeper.GetBalance(ctx, randomAddr, freezableDenom)
-	requireT.Equal(sdk.NewCoin(freezableDenom, sdk.NewInt(12)), balance)
+	requireT.Len(holders, 1)
+	requireT.EqualValues(2, pageRes.Total)
+
+	// unknown denom
+	_, _, err = assetKeeper.GetHolders(ctx, types.BuildDenom("unknown", issuer), nil)
+	requireT.ErrorIs(err, types.ErrTokenNotFound)
+
+	_, isBroken := keeper.HoldersInvariant(assetKeeper)(ctx)
+	requireT.False(isBroken)
 }
 
 //nolint:funlen // this is complex test scenario and breaking it down is not helpful
-func TestKeeper_Whitelist(t *testing.T) {
+func TestKeeper_FreezeUnfreeze(t *testing.T) {
 	requireT := require.New(t)
 	assertT := assert.New(t)
 
@@ -1183,13 +1492,13 @@ func TestKeeper_Whitelist(t *testing.T) {
 		Precision:     1,
 		Description:   "DEF Desc",
 		InitialAmount: sdk.NewInt(666),
-		Features:      []types.Feature{types.Feature_whitelisting},
+		Features:      []types.Feature{types.Feature_freezing},
 	}
 
 	denom, err := ftKeeper.Issue(ctx, settings)
 	requireT.NoError(err)
 
-	unwhitelistableSettings := types.IssueSettings{
+	unfreezableSettings := types.IssueSettings{
 		Issuer:        issuer,
 		Symbol:        "ABC",
 		Subunit:       "abc",
@@ -1199,94 +1508,897 @@ func TestKeeper_Whitelist(t *testing.T) {
 		Features:      []types.Feature{},
 	}
 
-	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-
-	unwhitelistableDenom, err := ftKeeper.Issue(ctx, unwhitelistableSettings)
+	unfreezableDenom, err := ftKeeper.Issue(ctx, unfreezableSettings)
 	requireT.NoError(err)
-	_, err = ftKeeper.GetToken(ctx, unwhitelistableDenom)
+	_, err = ftKeeper.GetToken(ctx, unfreezableDenom)
 	requireT.NoError(err)
 
-	// whitelisting fails on unwhitelistable token
-	err = ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(unwhitelistableDenom, sdk.NewInt(1)))
-	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(100)),
+		sdk.NewCoin(unfreezableDenom, sdk.NewInt(100)),
+	))
+	requireT.NoError(err)
 
-	// try to whitelist non-existent denom
+	// try to freeze non-existent denom
 	nonExistentDenom := types.BuildDenom("nonexist", issuer)
-	err = ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(nonExistentDenom, sdk.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(nonExistentDenom, sdk.NewInt(10)))
 	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
 
-	// try to whitelist from non issuer address
+	// try to freeze unfreezable Token
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(unfreezableDenom, sdk.NewInt(10)))
+	assertT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to freeze from non issuer address
 	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.SetWhitelistedBalance(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(10)))
 	assertT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 
-	// try to whitelist the issuer (issuer can't be whitelisted)
-	err = ftKeeper.SetWhitelistedBalance(ctx, issuer, issuer, sdk.NewCoin(denom, sdk.NewInt(1)))
-	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	// try to freeze 0 balance
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(0)))
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidCoins)
 
-	// set whitelisted balance to 0
-	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(0))))
-	whitelistedBalance := ftKeeper.GetWhitelistedBalance(ctx, recipient, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(0)).String(), whitelistedBalance.String())
+	// try to unfreeze 0 balance
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(0)))
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidCoins)
 
-	coinsToSend := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))
-	// send
-	err = bankKeeper.SendCoins(ctx, issuer, recipient, coinsToSend)
-	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
-	// multi-send
+	// try to freeze more than balance
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(110)))
+	requireT.NoError(err)
+	frozenBalance := ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(110)), frozenBalance)
+
+	// try to unfreeze more than frozen balance
+	err = ftKeeper.Unfreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(130)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(110)), frozenBalance)
+
+	// set frozen balance back to zero
+	err = ftKeeper.Unfreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(110)))
+	requireT.NoError(err)
+	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	assertT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(0)).String(), frozenBalance.String())
+
+	// freeze, query frozen
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(40)))
+	requireT.NoError(err)
+	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(40)).String(), frozenBalance.String())
+
+	// test query all frozen
+	allBalances, pageRes, err := ftKeeper.GetAccountsFrozenBalances(ctx, &query.PageRequest{})
+	assertT.NoError(err)
+	assertT.Len(allBalances, 1)
+	assertT.EqualValues(1, pageRes.GetTotal())
+	assertT.EqualValues(recipient.String(), allBalances[0].Address)
+	requireT.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(40))).String(), allBalances[0].Coins.String())
+
+	// increase frozen and query
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(40)))
+	requireT.NoError(err)
+	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(80)), frozenBalance)
+
+	// try to send more than available
+	coinsToSend := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(80)))
+	// send
+	err = bankKeeper.SendCoins(ctx, recipient, issuer, coinsToSend)
+	assertT.True(sdkerrors.IsOf(err, sdkerrors.ErrInsufficientFunds))
+	// multi-send
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
+		[]banktypes.Output{{Address: issuer.String(), Coins: coinsToSend}})
+	assertT.True(sdkerrors.IsOf(err, sdkerrors.ErrInsufficientFunds))
+
+	// try to send unfrozen balance
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coinsToSend = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))
+	// send
+	err = bankKeeper.SendCoins(ctx, recipient, recipient2, coinsToSend)
+	requireT.NoError(err)
+	balance := bankKeeper.GetBalance(ctx, recipient, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(90)), balance)
+	balance = bankKeeper.GetBalance(ctx, recipient2, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(10)), balance)
+	// multi-send
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
+		[]banktypes.Output{{Address: recipient2.String(), Coins: coinsToSend}})
+	requireT.NoError(err)
+	balance = bankKeeper.GetBalance(ctx, recipient, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(80)), balance)
+	balance = bankKeeper.GetBalance(ctx, recipient2, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(20)), balance)
+
+	// try to unfreeze from non issuer address
+	err = ftKeeper.Unfreeze(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(80)))
+	assertT.True(sdkerrors.IsOf(err, sdkerrors.ErrUnauthorized))
+
+	// unfreeze, query frozen, and try to send
+	err = ftKeeper.Unfreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(80)))
+	requireT.NoError(err)
+	frozenBalance = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(0)), frozenBalance)
+	coinsToSend = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(40)))
+	// send
+	err = bankKeeper.SendCoins(ctx, recipient, recipient2, coinsToSend)
+	requireT.NoError(err)
+	balance = bankKeeper.GetBalance(ctx, recipient, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(40)), balance)
+	balance = bankKeeper.GetBalance(ctx, recipient2, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(60)), balance)
+	// multi-send
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
+		[]banktypes.Output{{Address: recipient2.String(), Coins: coinsToSend}})
+	requireT.NoError(err)
+	balance = bankKeeper.GetBalance(ctx, recipient, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(0)), balance)
+	balance = bankKeeper.GetBalance(ctx, recipient2, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(100)), balance)
+}
+
+//nolint:funlen // this is complex tests scenario and breaking it down is not beneficial
+func TestKeeper_GlobalFreezeUnfreeze(t *testing.T) {
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	freezableSettings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "FREEZE",
+		Subunit:       "freeze",
+		Precision:     6,
+		Description:   "FREEZE Desc",
+		InitialAmount: sdk.NewInt(777),
+		Features:      []types.Feature{types.Feature_freezing},
+	}
+
+	freezableDenom, err := ftKeeper.Issue(ctx, freezableSettings)
+	requireT.NoError(err)
+
+	unfreezableSettings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "NOFREEZE",
+		Subunit:       "nofreeze",
+		Precision:     6,
+		Description:   "NOFREEZE Desc",
+		InitialAmount: sdk.NewInt(777),
+		Features:      []types.Feature{},
+	}
+
+	unfreezableDenom, err := ftKeeper.Issue(ctx, unfreezableSettings)
+	requireT.NoError(err)
+	_, err = ftKeeper.GetToken(ctx, unfreezableDenom)
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(
+		sdk.NewCoin(freezableDenom, sdk.NewInt(100)),
+		sdk.NewCoin(unfreezableDenom, sdk.NewInt(100)),
+	))
+	requireT.NoError(err)
+
+	// try to global-freeze non-existent
+	nonExistentDenom := types.BuildDenom("nonexist", issuer)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, nonExistentDenom)
+	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
+
+	// try to global-freeze unfreezable Token
+	err = ftKeeper.GloballyFreeze(ctx, issuer, unfreezableDenom)
+	assertT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to global-freeze from non issuer address
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = ftKeeper.GloballyFreeze(ctx, randomAddr, freezableDenom)
+	assertT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// freeze twice to check global-freeze idempotence
+	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
+	requireT.NoError(err)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
+	requireT.NoError(err)
+	frozenToken, err := ftKeeper.GetToken(ctx, freezableDenom)
+	requireT.NoError(err)
+	assertT.True(frozenToken.GloballyFrozen)
+
+	// try to global-unfreeze from non issuer address
+	err = ftKeeper.GloballyUnfreeze(ctx, randomAddr, freezableDenom)
+	assertT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// unfreeze twice to check global-unfreeze idempotence
+	err = ftKeeper.GloballyUnfreeze(ctx, issuer, freezableDenom)
+	requireT.NoError(err)
+	err = ftKeeper.GloballyUnfreeze(ctx, issuer, freezableDenom)
+	requireT.NoError(err)
+	unfrozenToken, err := ftKeeper.GetToken(ctx, freezableDenom)
+	requireT.NoError(err)
+	assertT.False(unfrozenToken.GloballyFrozen)
+
+	// freeze, try to send & verify balance
+	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
+	requireT.NoError(err)
+	coinsToSend := sdk.NewCoins(sdk.NewCoin(freezableDenom, sdk.NewInt(10)))
+	// send
+	err = bankKeeper.SendCoins(ctx, recipient, randomAddr, coinsToSend)
+	assertT.ErrorIs(err, types.ErrGloballyFrozen)
+	// multi-send
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
+		[]banktypes.Output{{Address: randomAddr.String(), Coins: coinsToSend}})
+	assertT.ErrorIs(err, types.ErrGloballyFrozen)
+
+	// unfreeze, try to send & verify balance
+	err = ftKeeper.GloballyUnfreeze(ctx, issuer, freezableDenom)
+	requireT.NoError(err)
+	coinsToSend = sdk.NewCoins(sdk.NewCoin(freezableDenom, sdk.NewInt(6)))
+	// send
+	err = bankKeeper.SendCoins(ctx, recipient, randomAddr, coinsToSend)
+	requireT.NoError(err)
+	balance := bankKeeper.GetBalance(ctx, randomAddr, freezableDenom)
+	requireT.Equal(sdk.NewCoin(freezableDenom, sdk.NewInt(6)), balance)
+	// multi-send
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: recipient.String(), Coins: coinsToSend}},
+		[]banktypes.Output{{Address: randomAddr.String(), Coins: coinsToSend}})
+	requireT.NoError(err)
+	balance = bankKeeper.GetBalance(ctx, randomAddr, freezableDenom)
+	requireT.Equal(sdk.NewCoin(freezableDenom, sdk.NewInt(12)), balance)
+}
+
+//nolint:funlen // this is complex test scenario and breaking it down is not helpful
+func TestKeeper_Whitelist(t *testing.T) {
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(666),
+		Features:      []types.Feature{types.Feature_whitelisting},
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	unwhitelistableSettings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		Description:   "ABC Desc",
+		InitialAmount: sdk.NewInt(666),
+		Features:      []types.Feature{},
+	}
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	unwhitelistableDenom, err := ftKeeper.Issue(ctx, unwhitelistableSettings)
+	requireT.NoError(err)
+	_, err = ftKeeper.GetToken(ctx, unwhitelistableDenom)
+	requireT.NoError(err)
+
+	// whitelisting fails on unwhitelistable token
+	err = ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(unwhitelistableDenom, sdk.NewInt(1)))
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to whitelist non-existent denom
+	nonExistentDenom := types.BuildDenom("nonexist", issuer)
+	err = ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(nonExistentDenom, sdk.NewInt(10)))
+	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
+
+	// try to whitelist from non issuer address
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = ftKeeper.SetWhitelistedBalance(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(10)))
+	assertT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to whitelist the issuer (issuer can't be whitelisted)
+	err = ftKeeper.SetWhitelistedBalance(ctx, issuer, issuer, sdk.NewCoin(denom, sdk.NewInt(1)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// set whitelisted balance to 0
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(0))))
+	whitelistedBalance := ftKeeper.GetWhitelistedBalance(ctx, recipient, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(0)).String(), whitelistedBalance.String())
+
+	coinsToSend := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))
+	// send
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, coinsToSend)
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+	// multi-send
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: issuer.String(), Coins: coinsToSend}},
+		[]banktypes.Output{{Address: recipient.String(), Coins: coinsToSend}})
+	requireT.True(types.ErrWhitelistedLimitExceeded.Is(err))
+
+	// set whitelisted balance to 100
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(100))))
+	whitelistedBalance = ftKeeper.GetWhitelistedBalance(ctx, recipient, denom)
+	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(100)).String(), whitelistedBalance.String())
+
+	// test query all whitelisted balances
+	allBalances, pageRes, err := ftKeeper.GetAccountsWhitelistedBalances(ctx, &query.PageRequest{})
+	assertT.NoError(err)
+	assertT.Len(allBalances, 1)
+	assertT.EqualValues(1, pageRes.GetTotal())
+	assertT.EqualValues(recipient.String(), allBalances[0].Address)
+	requireT.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))).String(), allBalances[0].Coins.String())
+
+	coinsToSend = sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(50)),
+		sdk.NewCoin(unwhitelistableDenom, sdk.NewInt(50)),
+	)
+	// send
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, coinsToSend)
+	requireT.NoError(err)
+	// multi-send
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: issuer.String(), Coins: coinsToSend}},
+		[]banktypes.Output{{Address: recipient.String(), Coins: coinsToSend}})
+	requireT.NoError(err)
+
+	// try to send more
+	coinsToSend = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1)))
+	// send
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, coinsToSend)
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+	// multi-send
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: issuer.String(), Coins: coinsToSend}},
+		[]banktypes.Output{{Address: recipient.String(), Coins: coinsToSend}})
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+
+	// try to whitelist from non issuer address
+	err = ftKeeper.SetWhitelistedBalance(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(80)))
+	assertT.True(sdkerrors.IsOf(err, sdkerrors.ErrUnauthorized))
+
+	// reduce whitelisting limit below the current balance
+	err = ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(80)))
+	assertT.NoError(err)
+}
+
+//nolint:funlen // this is complex test scenario and breaking it down is not helpful
+func TestKeeper_FreezeWhitelistMultiSend(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issuer2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings1 := types.IssueSettings{
+		Issuer:        issuer1,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_freezing},
+	}
+
+	settings2 := types.IssueSettings{
+		Issuer:        issuer2,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(2000),
+		Features:      []types.Feature{types.Feature_whitelisting},
+	}
+
+	bondDenom := testApp.StakingKeeper.BondDenom(ctx)
+	// fund with the native coin
+	err := testApp.FundAccount(ctx, issuer1, sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1000))))
+	requireT.NoError(err)
+
+	denom1, err := ftKeeper.Issue(ctx, settings1)
+	requireT.NoError(err)
+
+	denom2, err := ftKeeper.Issue(ctx, settings2)
+	requireT.NoError(err)
+
+	recipient1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// freeze denom1 partially on the recipient1
+	err = ftKeeper.Freeze(ctx, issuer1, recipient1, sdk.NewCoin(denom1, sdk.NewInt(10)))
+	requireT.NoError(err)
+
+	// whitelist denom2 partially on the recipient2
+	err = ftKeeper.SetWhitelistedBalance(ctx, issuer2, recipient2, sdk.NewCoin(denom2, sdk.NewInt(10)))
+	requireT.NoError(err)
+
+	// multi-send valid amount
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{
+			{Address: issuer1.String(), Coins: sdk.NewCoins(
+				sdk.NewCoin(denom1, sdk.NewInt(15)),
+				sdk.NewCoin(bondDenom, sdk.NewInt(20)),
+			)},
+			{Address: issuer2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(10)))},
+		},
+		[]banktypes.Output{
+			// the recipient1 has frozen balance so that amount can be received
+			{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
+			// the recipient2 has whitelisted balance so that is the max amount recipient2 can receive
+			{Address: recipient2.String(), Coins: sdk.NewCoins(
+				sdk.NewCoin(denom2, sdk.NewInt(10)),
+				sdk.NewCoin(bondDenom, sdk.NewInt(20)),
+			)},
+		})
+	requireT.NoError(err)
+
+	balance := bankKeeper.GetBalance(ctx, recipient1, denom1)
+	requireT.Equal(sdk.NewCoin(denom1, sdk.NewInt(15)).String(), balance.String())
+	balance = bankKeeper.GetBalance(ctx, recipient2, denom2)
+	requireT.Equal(sdk.NewCoin(denom2, sdk.NewInt(10)).String(), balance.String())
+	balance = bankKeeper.GetBalance(ctx, recipient2, bondDenom)
+	requireT.Equal(sdk.NewCoin(bondDenom, sdk.NewInt(20)).String(), balance.String())
+
+	// multi-send invalid frozen amount
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{
+			// we can't return 15 coins since 10 are frozen
+			{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
+			{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(10)))},
+		},
+		[]banktypes.Output{
+			{Address: issuer1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
+			{Address: issuer2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(10)))},
+		})
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// multi-send invalid whitelisted amount
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{
+			{Address: issuer1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
+			{Address: issuer2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(15)))},
+		},
+		[]banktypes.Output{
+			{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
+			// the recipient2 has whitelisted 10 so can't receive 15
+			{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(15)))},
+		})
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+}
+
+// TestKeeper_AllInOne tests send and multi send with tokens that have all features enabled
+// and applied.
+func TestKeeper_AllInOne(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_freezing,
+			types.Feature_burning,
+			types.Feature_minting,
+			types.Feature_whitelisting,
+		},
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.05"),
+	}
+
+	bondDenom := testApp.StakingKeeper.BondDenom(ctx)
+	// fund with the native coin
+	err := testApp.FundAccount(ctx, issuer, sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1000))))
+	requireT.NoError(err)
+
+	denom1, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	recipient1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// freeze denom1 partially on the recipient1
+	err = ftKeeper.Freeze(ctx, issuer, recipient1, sdk.NewCoin(denom1, sdk.NewInt(10)))
+	requireT.NoError(err)
+
+	// whitelist recipients
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient1, sdk.NewCoin(denom1, sdk.NewInt(10))))
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient2, sdk.NewCoin(denom1, sdk.NewInt(10))))
+
+	// multi-send valid amount
 	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{{Address: issuer.String(), Coins: coinsToSend}},
-		[]banktypes.Output{{Address: recipient.String(), Coins: coinsToSend}})
-	requireT.True(types.ErrWhitelistedLimitExceeded.Is(err))
+		[]banktypes.Input{
+			{Address: issuer.String(), Coins: sdk.NewCoins(
+				sdk.NewCoin(denom1, sdk.NewInt(20)),
+				sdk.NewCoin(bondDenom, sdk.NewInt(40)),
+			)},
+		},
+		[]banktypes.Output{
+			// the recipient1 has frozen balance so that amount can be received
+			{Address: recipient1.String(), Coins: sdk.NewCoins(
+				sdk.NewCoin(denom1, sdk.NewInt(10)),
+				sdk.NewCoin(bondDenom, sdk.NewInt(20)),
+			)},
+			// the recipient2 has whitelisted balance so that is the max amount recipient2 can receive
+			{Address: recipient2.String(), Coins: sdk.NewCoins(
+				sdk.NewCoin(denom1, sdk.NewInt(10)),
+				sdk.NewCoin(bondDenom, sdk.NewInt(20)),
+			)},
+		})
+	requireT.NoError(err)
+}
+
+func TestKeeper_Clawback(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "CLAWBACK",
+		Subunit:       "clawback",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_clawback, types.Feature_freezing},
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	unclawbackableSettings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "NOCLAWBACK",
+		Subunit:       "noclawback",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{},
+	}
+
+	unclawbackableDenom, err := ftKeeper.Issue(ctx, unclawbackableSettings)
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(100)),
+		sdk.NewCoin(unclawbackableDenom, sdk.NewInt(100)),
+	))
+	requireT.NoError(err)
+
+	// try to clawback the token with disabled feature
+	err = ftKeeper.Clawback(ctx, issuer, account, sdk.NewCoin(unclawbackableDenom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to clawback by non-admin
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = ftKeeper.Clawback(ctx, randomAddr, account, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to clawback from admin
+	err = ftKeeper.Clawback(ctx, issuer, issuer, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to clawback zero amount
+	err = ftKeeper.Clawback(ctx, issuer, account, sdk.NewCoin(denom, sdk.ZeroInt()))
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	// clawback works even if the token is globally frozen
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+	err = ftKeeper.Clawback(ctx, issuer, account, sdk.NewCoin(denom, sdk.NewInt(30)))
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.GloballyUnfreeze(ctx, issuer, denom))
+
+	requireT.Equal(sdk.NewInt64Coin(denom, 70).String(), bankKeeper.GetBalance(ctx, account, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 930).String(), bankKeeper.GetBalance(ctx, issuer, denom).String())
+
+	// frozen amount can't be clawed back
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, account, sdk.NewCoin(denom, sdk.NewInt(50))))
+	err = ftKeeper.Clawback(ctx, issuer, account, sdk.NewCoin(denom, sdk.NewInt(21)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	err = ftKeeper.Clawback(ctx, issuer, account, sdk.NewCoin(denom, sdk.NewInt(20)))
+	requireT.NoError(err)
+
+	requireT.Equal(sdk.NewInt64Coin(denom, 50).String(), bankKeeper.GetBalance(ctx, account, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 50).String(), ftKeeper.GetFrozenBalance(ctx, account, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 950).String(), bankKeeper.GetBalance(ctx, issuer, denom).String())
+}
+
+func TestKeeper_ClawbackModuleAccounts(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "CLAWBACK",
+		Subunit:       "clawback",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_clawback},
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// the escrow account of the ICS-20 channel
+	testApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, "channel-0", channeltypes.Channel{
+		State:    channeltypes.OPEN,
+		Ordering: channeltypes.UNORDERED,
+	})
+	escrowAddr := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+
+	// the module account not registered in the blocked addresses
+	moduleAcc := authtypes.NewEmptyModuleAccount("custom")
+	testApp.AccountKeeper.SetAccount(ctx, testApp.AccountKeeper.NewAccount(ctx, moduleAcc))
+
+	for _, addr := range []sdk.AccAddress{
+		escrowAddr,
+		moduleAcc.GetAddress(),
+		testApp.AccountKeeper.GetModuleAddress(distrtypes.ModuleName),
+	} {
+		requireT.NoError(bankKeeper.SendCoins(ctx, issuer, addr, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+		err = ftKeeper.Clawback(ctx, issuer, addr, sdk.NewInt64Coin(denom, 10))
+		requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+		requireT.Equal(sdk.NewInt64Coin(denom, 10).String(), bankKeeper.GetBalance(ctx, addr, denom).String())
+	}
+
+	// the escrow address of the channel which does not exist is a regular account
+	account := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-1")
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, account, sdk.NewInt64Coin(denom, 10)))
+}
+
+func TestKeeper_UpdateMetadata(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "UPDATABLE",
+		Subunit:       "updatable",
+		Precision:     6,
+		Description:   "UPDATABLE Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_updatable_metadata},
+		URI:           "https://my-token-meta.invalid/1",
+		URIHash:       "e000624",
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(settings.URI, token.URI)
+	requireT.Equal(settings.URIHash, token.URIHash)
+
+	immutableSettings := settings
+	immutableSettings.Symbol = "IMMUTABLE"
+	immutableSettings.Subunit = "immutable"
+	immutableSettings.Features = []types.Feature{}
+
+	immutableDenom, err := ftKeeper.Issue(ctx, immutableSettings)
+	requireT.NoError(err)
+
+	// try to update metadata of the token with disabled feature
+	err = ftKeeper.UpdateMetadata(ctx, issuer, immutableDenom, "new desc", "https://my-token-meta.invalid/2", "e000625")
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to update metadata by non-admin
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = ftKeeper.UpdateMetadata(ctx, randomAddr, denom, "new desc", "https://my-token-meta.invalid/2", "e000625")
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// update metadata
+	err = ftKeeper.UpdateMetadata(ctx, issuer, denom, "new desc", "https://my-token-meta.invalid/2", "e000625")
+	requireT.NoError(err)
+
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("new desc", token.Description)
+	requireT.Equal("https://my-token-meta.invalid/2", token.URI)
+	requireT.Equal("e000625", token.URIHash)
+	requireT.Equal(settings.Symbol, token.Symbol)
+	requireT.Equal(settings.Precision, token.Precision)
+
+	metadata, found := bankKeeper.GetDenomMetaData(ctx, denom)
+	requireT.True(found)
+	requireT.Equal("new desc", metadata.Description)
+}
+
+func TestKeeper_TransferAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{types.Feature_minting, types.Feature_freezing},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), token.Admin)
+
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// try to transfer admin by non-admin
+	err = ftKeeper.TransferAdmin(ctx, randomAddr, admin, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// transfer admin
+	err = ftKeeper.TransferAdmin(ctx, issuer, admin, denom)
+	requireT.NoError(err)
+
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), token.Issuer)
+	requireT.Equal(admin.String(), token.Admin)
+
+	// the issuer can't transfer admin anymore
+	err = ftKeeper.TransferAdmin(ctx, issuer, randomAddr, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the issuer can't mint anymore
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the admin can mint
+	err = ftKeeper.Mint(ctx, admin, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.NoError(err)
+
+	// the admin's balance can't be frozen, but the issuer's one can
+	err = ftKeeper.Freeze(ctx, admin, admin, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.Freeze(ctx, admin, issuer, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.NoError(err)
+	err = ftKeeper.Unfreeze(ctx, admin, issuer, sdk.NewCoin(denom, sdk.NewInt(10)))
+	requireT.NoError(err)
+
+	// send commission is charged from the issuer and paid to the admin
+	err = bankKeeper.SendCoins(ctx, issuer, randomAddr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))))
+	requireT.NoError(err)
+
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:     450,
+		&admin:      150,
+		&randomAddr: 500,
+	})
+
+	// send commission is not charged when the admin sends
+	err = bankKeeper.SendCoins(ctx, admin, randomAddr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.NoError(err)
+
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:     450,
+		&admin:      50,
+		&randomAddr: 600,
+	})
+}
+
+func TestKeeper_ClearAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.Feature{types.Feature_minting, types.Feature_freezing},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
 
-	// set whitelisted balance to 100
-	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(100))))
-	whitelistedBalance = ftKeeper.GetWhitelistedBalance(ctx, recipient, denom)
-	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(100)).String(), whitelistedBalance.String())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
-	// test query all whitelisted balances
-	allBalances, pageRes, err := ftKeeper.GetAccountsWhitelistedBalances(ctx, &query.PageRequest{})
-	assertT.NoError(err)
-	assertT.Len(allBalances, 1)
-	assertT.EqualValues(1, pageRes.GetTotal())
-	assertT.EqualValues(recipient.String(), allBalances[0].Address)
-	requireT.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))).String(), allBalances[0].Coins.String())
+	// try to clear admin by non-admin
+	err = ftKeeper.ClearAdmin(ctx, randomAddr, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 
-	coinsToSend = sdk.NewCoins(
-		sdk.NewCoin(denom, sdk.NewInt(50)),
-		sdk.NewCoin(unwhitelistableDenom, sdk.NewInt(50)),
-	)
-	// send
-	err = bankKeeper.SendCoins(ctx, issuer, recipient, coinsToSend)
+	// clear admin
+	err = ftKeeper.ClearAdmin(ctx, issuer, denom)
 	requireT.NoError(err)
-	// multi-send
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{{Address: issuer.String(), Coins: coinsToSend}},
-		[]banktypes.Output{{Address: recipient.String(), Coins: coinsToSend}})
+
+	token, err := ftKeeper.GetToken(ctx, denom)
 	requireT.NoError(err)
+	requireT.Equal(issuer.String(), token.Issuer)
+	requireT.Empty(token.Admin)
 
-	// try to send more
-	coinsToSend = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1)))
-	// send
-	err = bankKeeper.SendCoins(ctx, issuer, recipient, coinsToSend)
-	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
-	// multi-send
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{{Address: issuer.String(), Coins: coinsToSend}},
-		[]banktypes.Output{{Address: recipient.String(), Coins: coinsToSend}})
-	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+	// nobody is able to execute the admin operations
+	err = ftKeeper.ClearAdmin(ctx, issuer, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.TransferAdmin(ctx, issuer, randomAddr, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewCoin(denom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, denom)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 
-	// try to whitelist from non issuer address
-	err = ftKeeper.SetWhitelistedBalance(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdk.NewInt(80)))
-	assertT.True(sdkerrors.IsOf(err, sdkerrors.ErrUnauthorized))
+	// send commission is not charged
+	err = bankKeeper.SendCoins(ctx, issuer, randomAddr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))))
+	requireT.NoError(err)
 
-	// reduce whitelisting limit below the current balance
-	err = ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdk.NewInt(80)))
-	assertT.NoError(err)
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:     500,
+		&randomAddr: 500,
+	})
 }
 
-//nolint:funlen // this is complex test scenario and breaking it down is not helpful
-func TestKeeper_FreezeWhitelistMultiSend(t *testing.T) {
+func TestKeeper_IBCTransfer(t *testing.T) {
 	requireT := require.New(t)
 
 	testApp := simapp.New()
@@ -1294,113 +2406,197 @@ func TestKeeper_FreezeWhitelistMultiSend(t *testing.T) {
 
 	ftKeeper := testApp.AssetFTKeeper
 	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
 
-	issuer1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	issuer2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-
-	settings1 := types.IssueSettings{
-		Issuer:        issuer1,
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
 		Symbol:        "DEF",
 		Subunit:       "def",
-		Precision:     1,
+		Precision:     6,
 		Description:   "DEF Desc",
 		InitialAmount: sdk.NewInt(1000),
-		Features:      []types.Feature{types.Feature_freezing},
+		Features: []types.Feature{
+			types.Feature_freezing,
+			types.Feature_whitelisting,
+			types.Feature_ibc,
+		},
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
 	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
 
-	settings2 := types.IssueSettings{
-		Issuer:        issuer2,
-		Symbol:        "DEF",
-		Subunit:       "def",
-		Precision:     1,
-		Description:   "DEF Desc",
-		InitialAmount: sdk.NewInt(2000),
-		Features:      []types.Feature{types.Feature_whitelisting},
+	nonIBCSettings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		Description:   "ABC Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{},
 	}
-
-	bondDenom := testApp.StakingKeeper.BondDenom(ctx)
-	// fund with the native coin
-	err := testApp.FundAccount(ctx, issuer1, sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1000))))
+	nonIBCDenom, err := ftKeeper.Issue(ctx, nonIBCSettings)
 	requireT.NoError(err)
 
-	denom1, err := ftKeeper.Issue(ctx, settings1)
-	requireT.NoError(err)
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	outCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeOut)
+	inCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeIn)
+	timeoutCtx := wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeTimeout)
 
-	denom2, err := ftKeeper.Issue(ctx, settings2)
+	// token without ibc feature can't leave the chain, even if sent by the issuer
+	err = bankKeeper.SendCoins(outCtx, issuer, escrow, sdk.NewCoins(sdk.NewInt64Coin(nonIBCDenom, 10)))
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, holder, sdk.NewInt64Coin(denom, 500)))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+
+	// frozen balance can't be sent over ibc
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, holder, sdk.NewInt64Coin(denom, 400)))
+	// cache context is used to discard the burnt amount and commission as the failed transaction does
+	failedOutCtx, _ := outCtx.CacheContext()
+	err = bankKeeper.SendCoins(failedOutCtx, holder, escrow, sdk.NewCoins(sdk.NewInt64Coin(denom, 200)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, holder, sdk.NewInt64Coin(denom, 400)))
+
+	// burn rate and send commission are applied, escrow account doesn't need to be whitelisted
+	requireT.NoError(bankKeeper.SendCoins(outCtx, holder, escrow, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer: 510,
+		&holder: 380,
+		&escrow: 100,
+	})
+
+	// received coins must respect the whitelist and are not charged with burn rate and send commission
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(inCtx, escrow, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50)))
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+	requireT.NoError(bankKeeper.SendCoins(inCtx, escrow, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer: 510,
+		&holder: 430,
+		&escrow: 50,
+	})
+
+	// globally frozen token can't be sent over ibc
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+	err = bankKeeper.SendCoins(outCtx, holder, escrow, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	requireT.ErrorIs(err, types.ErrGloballyFrozen)
+
+	// refunds are not restricted by any rules
+	requireT.NoError(bankKeeper.SendCoins(timeoutCtx, escrow, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer: 510,
+		&holder: 480,
+	})
+}
+
+func TestKeeper_VestingSchedule(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Unix(1000, 0))
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "VEST",
+		Subunit:       "vest",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.Feature{types.Feature_freezing},
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
 	requireT.NoError(err)
 
-	recipient1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
-	// freeze denom1 partially on the recipient1
-	err = ftKeeper.Freeze(ctx, issuer1, recipient1, sdk.NewCoin(denom1, sdk.NewInt(10)))
+	// try to create vesting schedule by non-admin
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = ftKeeper.CreateVestingSchedule(ctx, randomAddr, account, sdk.NewInt64Coin(denom, 100), 1000, 1100, 2000)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to create vesting schedule for admin
+	err = ftKeeper.CreateVestingSchedule(ctx, issuer, issuer, sdk.NewInt64Coin(denom, 100), 1000, 1100, 2000)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to create vesting schedule ending in the past
+	err = ftKeeper.CreateVestingSchedule(ctx, issuer, account, sdk.NewInt64Coin(denom, 100), 0, 500, 1000)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to create vesting schedule with cliff before start
+	err = ftKeeper.CreateVestingSchedule(ctx, issuer, account, sdk.NewInt64Coin(denom, 100), 1000, 900, 2000)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// release 100 linearly between 1000 and 2000 with cliff at 1200
+	err = ftKeeper.CreateVestingSchedule(ctx, issuer, account, sdk.NewInt64Coin(denom, 100), 1000, 1200, 2000)
 	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin(denom, 100).String(), bankKeeper.GetBalance(ctx, account, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 900).String(), bankKeeper.GetBalance(ctx, issuer, denom).String())
 
-	// whitelist denom2 partially on the recipient2
-	err = ftKeeper.SetWhitelistedBalance(ctx, issuer2, recipient2, sdk.NewCoin(denom2, sdk.NewInt(10)))
+	// before the cliff everything is locked
+	ctx = ctx.WithBlockTime(time.Unix(1199, 0))
+	requireT.Equal(sdk.NewInt64Coin(denom, 100).String(), ftKeeper.GetLockedBalance(ctx, account, denom).String())
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// at the cliff the amount accrued since the start is released
+	ctx = ctx.WithBlockTime(time.Unix(1200, 0))
+	requireT.Equal(sdk.NewInt64Coin(denom, 80).String(), ftKeeper.GetLockedBalance(ctx, account, denom).String())
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 21)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 20)))
 	requireT.NoError(err)
 
-	// multi-send valid amount
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{
-			{Address: issuer1.String(), Coins: sdk.NewCoins(
-				sdk.NewCoin(denom1, sdk.NewInt(15)),
-				sdk.NewCoin(bondDenom, sdk.NewInt(20)),
-			)},
-			{Address: issuer2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(10)))},
-		},
-		[]banktypes.Output{
-			// the recipient1 has frozen balance so that amount can be received
-			{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
-			// the recipient2 has whitelisted balance so that is the max amount recipient2 can receive
-			{Address: recipient2.String(), Coins: sdk.NewCoins(
-				sdk.NewCoin(denom2, sdk.NewInt(10)),
-				sdk.NewCoin(bondDenom, sdk.NewInt(20)),
-			)},
-		})
+	// the frozen amount is excluded in addition to the locked one
+	ctx = ctx.WithBlockTime(time.Unix(1500, 0))
+	requireT.Equal(sdk.NewInt64Coin(denom, 50).String(), ftKeeper.GetLockedBalance(ctx, account, denom).String())
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, account, sdk.NewInt64Coin(denom, 20)))
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 11)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
 	requireT.NoError(err)
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, account, sdk.NewInt64Coin(denom, 20)))
 
-	balance := bankKeeper.GetBalance(ctx, recipient1, denom1)
-	requireT.Equal(sdk.NewCoin(denom1, sdk.NewInt(15)).String(), balance.String())
-	balance = bankKeeper.GetBalance(ctx, recipient2, denom2)
-	requireT.Equal(sdk.NewCoin(denom2, sdk.NewInt(10)).String(), balance.String())
-	balance = bankKeeper.GetBalance(ctx, recipient2, bondDenom)
-	requireT.Equal(sdk.NewCoin(bondDenom, sdk.NewInt(20)).String(), balance.String())
+	// the second schedule adds up to the first one
+	err = ftKeeper.CreateVestingSchedule(ctx, issuer, account, sdk.NewInt64Coin(denom, 40), 1500, 1500, 3500)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin(denom, 90).String(), ftKeeper.GetLockedBalance(ctx, account, denom).String())
+	requireT.Len(ftKeeper.GetVestingSchedules(ctx, account, denom).Schedules, 2)
 
-	// multi-send invalid frozen amount
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{
-			// we can't return 15 coins since 10 are frozen
-			{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
-			{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(10)))},
-		},
-		[]banktypes.Output{
-			{Address: issuer1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
-			{Address: issuer2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(10)))},
-		})
+	// after the end of the first schedule only the second one locks the funds
+	ctx = ctx.WithBlockTime(time.Unix(2500, 0))
+	requireT.Equal(sdk.NewInt64Coin(denom, 20).String(), ftKeeper.GetLockedBalance(ctx, account, denom).String())
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 91)))
 	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 90)))
+	requireT.NoError(err)
 
-	// multi-send invalid whitelisted amount
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{
-			{Address: issuer1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
-			{Address: issuer2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(15)))},
-		},
-		[]banktypes.Output{
-			{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom1, sdk.NewInt(15)))},
-			// the recipient2 has whitelisted 10 so can't receive 15
-			{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom2, sdk.NewInt(15)))},
-		})
-	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+	// fully released schedules are removed when the new one is created
+	ctx = ctx.WithBlockTime(time.Unix(3500, 0))
+	requireT.Equal(sdk.NewInt64Coin(denom, 0).String(), ftKeeper.GetLockedBalance(ctx, account, denom).String())
+	err = ftKeeper.CreateVestingSchedule(ctx, issuer, account, sdk.NewInt64Coin(denom, 10), 3500, 3500, 4000)
+	requireT.NoError(err)
+	requireT.Len(ftKeeper.GetVestingSchedules(ctx, account, denom).Schedules, 1)
+
+	// the admin is allowed to spend all its funds
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 850)))
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin(denom, 0).String(), bankKeeper.GetBalance(ctx, issuer, denom).String())
 }
 
-// TestKeeper_AllInOne tests send and multi send with tokens that have all features enabled
-// and applied.
-func TestKeeper_AllInOne(t *testing.T) {
+func TestKeeper_ClawbackVesting(t *testing.T) {
 	requireT := require.New(t)
 
 	testApp := simapp.New()
-	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Unix(1000, 0))
 
 	ftKeeper := testApp.AssetFTKeeper
 	bankKeeper := testApp.BankKeeper
@@ -1409,61 +2605,46 @@ func TestKeeper_AllInOne(t *testing.T) {
 
 	settings := types.IssueSettings{
 		Issuer:        issuer,
-		Symbol:        "DEF",
-		Subunit:       "def",
-		Precision:     1,
-		Description:   "DEF Desc",
+		Symbol:        "VEST",
+		Subunit:       "vest",
+		Precision:     6,
 		InitialAmount: sdk.NewInt(1000),
-		Features: []types.Feature{
-			types.Feature_freezing,
-			types.Feature_burning,
-			types.Feature_minting,
-			types.Feature_whitelisting,
-		},
-		BurnRate:           sdk.MustNewDecFromStr("0.1"),
-		SendCommissionRate: sdk.MustNewDecFromStr("0.05"),
+		Features:      []types.Feature{types.Feature_clawback},
 	}
 
-	bondDenom := testApp.StakingKeeper.BondDenom(ctx)
-	// fund with the native coin
-	err := testApp.FundAccount(ctx, issuer, sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1000))))
+	denom, err := ftKeeper.Issue(ctx, settings)
 	requireT.NoError(err)
 
-	denom1, err := ftKeeper.Issue(ctx, settings)
-	requireT.NoError(err)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
-	recipient1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	// lock 100 until 2000 and send 20 unlocked
+	requireT.NoError(ftKeeper.CreateVestingSchedule(ctx, issuer, account, sdk.NewInt64Coin(denom, 100), 1000, 2000, 2000))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 20))))
 
-	// freeze denom1 partially on the recipient1
-	err = ftKeeper.Freeze(ctx, issuer, recipient1, sdk.NewCoin(denom1, sdk.NewInt(10)))
-	requireT.NoError(err)
+	// the unlocked tokens are clawed back first so the schedule is not changed
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, account, sdk.NewInt64Coin(denom, 10)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 100).String(), ftKeeper.GetLockedBalance(ctx, account, denom).String())
 
-	// whitelist recipients
-	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient1, sdk.NewCoin(denom1, sdk.NewInt(10))))
-	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient2, sdk.NewCoin(denom1, sdk.NewInt(10))))
+	// the locked tokens reduce the schedule
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, account, sdk.NewInt64Coin(denom, 70)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 40).String(), bankKeeper.GetBalance(ctx, account, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 40).String(), ftKeeper.GetLockedBalance(ctx, account, denom).String())
 
-	// multi-send valid amount
-	err = bankKeeper.InputOutputCoins(ctx,
-		[]banktypes.Input{
-			{Address: issuer.String(), Coins: sdk.NewCoins(
-				sdk.NewCoin(denom1, sdk.NewInt(20)),
-				sdk.NewCoin(bondDenom, sdk.NewInt(40)),
-			)},
-		},
-		[]banktypes.Output{
-			// the recipient1 has frozen balance so that amount can be received
-			{Address: recipient1.String(), Coins: sdk.NewCoins(
-				sdk.NewCoin(denom1, sdk.NewInt(10)),
-				sdk.NewCoin(bondDenom, sdk.NewInt(20)),
-			)},
-			// the recipient2 has whitelisted balance so that is the max amount recipient2 can receive
-			{Address: recipient2.String(), Coins: sdk.NewCoins(
-				sdk.NewCoin(denom1, sdk.NewInt(10)),
-				sdk.NewCoin(bondDenom, sdk.NewInt(20)),
-			)},
-		})
-	requireT.NoError(err)
+	// the received tokens are not locked by the reduced schedule
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 30))))
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 31)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 30))))
+
+	// all the locked tokens are clawed back, so the schedule is removed and the received tokens are spendable
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, account, sdk.NewInt64Coin(denom, 40)))
+	requireT.Empty(ftKeeper.GetVestingSchedules(ctx, account, denom).Schedules)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+
+	_, isBroken := keeper.VestingSchedulesInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
 }
 
 func TestKeeper_GetIssuerTokens(t *testing.T) {
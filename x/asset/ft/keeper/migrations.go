@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It sets the admin of all the existing tokens to their issuer.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var definitions []types.Definition
+	m.keeper.IterateAllDefinitions(ctx, func(def types.Definition) bool {
+		definitions = append(definitions, def)
+		return false
+	})
+
+	for _, def := range definitions {
+		if def.Admin != "" {
+			continue
+		}
+		def.Admin = def.Issuer
+		if err := m.keeper.updateDefinition(ctx, def); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It builds the holders index from the balances stored by the bank module. All the balances of the chain are scanned
+// in the single block executing the upgrade, so the time of the upgrade grows with the number of balances.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.InitHolders(ctx)
+	return nil
+}
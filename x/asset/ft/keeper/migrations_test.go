@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestMigrator_Migrate1to2(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the definition stored before the admin was introduced
+	legacyDenom := types.BuildDenom("legacy", issuer)
+	ftKeeper.SetDefinition(ctx, issuer, "legacy", types.Definition{
+		Denom:  legacyDenom,
+		Issuer: issuer.String(),
+	})
+
+	// the definition with the admin already set
+	transferredDenom := types.BuildDenom("transferred", issuer)
+	ftKeeper.SetDefinition(ctx, issuer, "transferred", types.Definition{
+		Denom:  transferredDenom,
+		Issuer: issuer.String(),
+		Admin:  admin.String(),
+	})
+
+	requireT.NoError(keeper.NewMigrator(ftKeeper).Migrate1to2(ctx))
+
+	def, err := ftKeeper.GetDefinition(ctx, legacyDenom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), def.Admin)
+
+	def, err = ftKeeper.GetDefinition(ctx, transferredDenom)
+	requireT.NoError(err)
+	requireT.Equal(admin.String(), def.Admin)
+}
+
+func TestMigrator_Migrate2to3(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	// the balance stored before the holders index was introduced
+	requireT.NoError(bankKeeper.BaseKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	requireT.False(ftKeeper.IsHolder(ctx, denom, recipient))
+
+	requireT.NoError(keeper.NewMigrator(ftKeeper).Migrate2to3(ctx))
+
+	requireT.True(ftKeeper.IsHolder(ctx, denom, issuer))
+	requireT.True(ftKeeper.IsHolder(ctx, denom, recipient))
+}
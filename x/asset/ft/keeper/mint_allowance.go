@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// GrantMintAllowance sets the amount of the fungible token the minter is allowed to mint.
+func (k Keeper) GrantMintAllowance(ctx sdk.Context, sender, minter sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "mint allowance amount should be positive")
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	if def.IsAdmin(minter) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "mint allowance can't be granted to the admin")
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
+		return err
+	}
+
+	previousAllowance := k.GetMintAllowance(ctx, coin.Denom, minter)
+	k.SetMintAllowance(ctx, types.MintAllowance{
+		Denom:  coin.Denom,
+		Minter: minter.String(),
+		Amount: coin.Amount,
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMintAllowanceChanged{
+		Minter:         minter.String(),
+		Denom:          coin.Denom,
+		PreviousAmount: previousAllowance.Amount,
+		CurrentAmount:  coin.Amount,
+	})
+}
+
+// RevokeMintAllowance removes the mint allowance of the minter.
+func (k Keeper) RevokeMintAllowance(ctx sdk.Context, sender, minter sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
+		return err
+	}
+
+	previousAllowance := k.GetMintAllowance(ctx, denom, minter)
+	if previousAllowance.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no mint allowance of %s for %s", denom, minter.String())
+	}
+
+	k.SetMintAllowance(ctx, types.MintAllowance{
+		Denom:  denom,
+		Minter: minter.String(),
+		Amount: sdk.ZeroInt(),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMintAllowanceChanged{
+		Minter:         minter.String(),
+		Denom:          denom,
+		PreviousAmount: previousAllowance.Amount,
+		CurrentAmount:  sdk.ZeroInt(),
+	})
+}
+
+// GetMintAllowance returns the mint allowance of the denom granted to the minter.
+func (k Keeper) GetMintAllowance(ctx sdk.Context, denom string, minter sdk.AccAddress) sdk.Coin {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateMintAllowanceKey(denom, minter))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var allowance types.MintAllowance
+	k.cdc.MustUnmarshal(bz, &allowance)
+	return sdk.NewCoin(denom, allowance.Amount)
+}
+
+// GetMintAllowances returns the mint allowances granted for the denom.
+func (k Keeper) GetMintAllowances(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.MintAllowance, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateMintAllowancesKey(denom))
+	allowances := make([]types.MintAllowance, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var allowance types.MintAllowance
+		if err := k.cdc.Unmarshal(value, &allowance); err != nil {
+			return err
+		}
+		allowances = append(allowances, allowance)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return allowances, pageRes, nil
+}
+
+// SetMintAllowance stores the mint allowance. If the amount is zero, the allowance is removed.
+func (k Keeper) SetMintAllowance(ctx sdk.Context, allowance types.MintAllowance) {
+	key := types.CreateMintAllowanceKey(allowance.Denom, sdk.MustAccAddressFromBech32(allowance.Minter))
+	if allowance.Amount.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&allowance))
+}
+
+// IterateAllMintAllowances iterates over all mint allowances and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllMintAllowances(ctx sdk.Context, cb func(types.MintAllowance) bool) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintAllowancesKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.MintAllowance
+		k.cdc.MustUnmarshal(iterator.Value(), &allowance)
+
+		if cb(allowance) {
+			break
+		}
+	}
+}
+
+func (k Keeper) checkMintAllowance(
+	ctx sdk.Context,
+	def types.Definition,
+	minter sdk.AccAddress,
+	amount sdk.Int,
+) (sdk.Coin, error) {
+	if !def.IsFeatureEnabled(types.Feature_minting) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrFeatureDisabled, "feature %s is disabled", types.Feature_minting.String())
+	}
+
+	allowance := k.GetMintAllowance(ctx, def.Denom, minter)
+	if allowance.IsZero() {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "address %s is unauthorized to mint %s", minter.String(), def.Denom,
+		)
+	}
+	if allowance.Amount.LT(amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrMintAllowanceExceeded, "can't mint %s, mint allowance %s",
+			sdk.NewCoin(def.Denom, amount), allowance)
+	}
+
+	return allowance, nil
+}
+
+func (k Keeper) useMintAllowance(ctx sdk.Context, minter sdk.AccAddress, allowance sdk.Coin, amount sdk.Int) error {
+	newAllowance := allowance.Amount.Sub(amount)
+	k.SetMintAllowance(ctx, types.MintAllowance{
+		Denom:  allowance.Denom,
+		Minter: minter.String(),
+		Amount: newAllowance,
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMintAllowanceChanged{
+		Minter:         minter.String(),
+		Denom:          allowance.Denom,
+		PreviousAmount: allowance.Amount,
+		CurrentAmount:  newAllowance,
+	})
+}
@@ -21,6 +21,15 @@ type MsgKeeper interface {
 	GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	UpdateMetadata(ctx sdk.Context, sender sdk.AccAddress, denom, description, uri, uriHash string) error
+	TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error
+	CreateVestingSchedule(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin, startTime, cliffTime, endTime int64) error
+	GrantMintAllowance(ctx sdk.Context, sender, minter sdk.AccAddress, coin sdk.Coin) error
+	RevokeMintAllowance(ctx sdk.Context, sender, minter sdk.AccAddress, denom string) error
+	SetRateExemption(ctx sdk.Context, sender, account sdk.AccAddress, denom string, exempt bool) error
+	UpdateCommissionRecipients(ctx sdk.Context, sender sdk.AccAddress, denom string, recipients []types.CommissionRecipient) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -51,6 +60,9 @@ func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.Empt
 		Features:           req.Features,
 		BurnRate:           req.BurnRate,
 		SendCommissionRate: req.SendCommissionRate,
+		URI:                req.URI,
+		URIHash:            req.URIHash,
+		MaxSupply:          req.MaxSupply,
 	})
 	if err != nil {
 		return nil, err
@@ -183,3 +195,170 @@ func (ms MsgServer) SetWhitelistedLimit(goCtx context.Context, req *types.MsgSet
 
 	return &types.EmptyResponse{}, nil
 }
+
+// Clawback returns fungible tokens from an account to the admin.
+func (ms MsgServer) Clawback(goCtx context.Context, req *types.MsgClawback) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.Clawback(ctx, sender, account, req.Coin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateMetadata updates the metadata of the fungible token.
+func (ms MsgServer) UpdateMetadata(goCtx context.Context, req *types.MsgUpdateMetadata) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.UpdateMetadata(ctx, sender, req.Denom, req.Description, req.URI, req.URIHash); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// TransferAdmin transfers the admin role of the fungible token to another account.
+func (ms MsgServer) TransferAdmin(goCtx context.Context, req *types.MsgTransferAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.TransferAdmin(ctx, sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClearAdmin removes the admin of the fungible token.
+func (ms MsgServer) ClearAdmin(goCtx context.Context, req *types.MsgClearAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.ClearAdmin(ctx, sender, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// CreateVestingSchedule sends fungible tokens from the admin to an account and locks them according to the schedule.
+func (ms MsgServer) CreateVestingSchedule(goCtx context.Context, req *types.MsgCreateVestingSchedule) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.CreateVestingSchedule(ctx, sender, account, req.Coin, req.StartTime, req.CliffTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// GrantMintAllowance sets the amount of fungible tokens the minter is allowed to mint.
+func (ms MsgServer) GrantMintAllowance(goCtx context.Context, req *types.MsgGrantMintAllowance) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	if err := ms.keeper.GrantMintAllowance(ctx, sender, minter, req.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RevokeMintAllowance removes the mint allowance of the minter.
+func (ms MsgServer) RevokeMintAllowance(goCtx context.Context, req *types.MsgRevokeMintAllowance) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	if err := ms.keeper.RevokeMintAllowance(ctx, sender, minter, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetRateExemption adds the account to or removes it from the list of accounts exempted from the burn rate and the send commission.
+func (ms MsgServer) SetRateExemption(goCtx context.Context, req *types.MsgSetRateExemption) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.SetRateExemption(ctx, sender, account, req.Denom, req.Exempt); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateCommissionRecipients replaces the accounts receiving the send commission.
+func (ms MsgServer) UpdateCommissionRecipients(goCtx context.Context, req *types.MsgUpdateCommissionRecipients) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.UpdateCommissionRecipients(ctx, sender, req.Denom, req.Recipients); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
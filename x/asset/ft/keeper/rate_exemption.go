@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// SetRateExemption adds the account to or removes it from the list of accounts which don't pay
+// the burn rate and the send commission of the fungible token.
+func (k Keeper) SetRateExemption(ctx sdk.Context, sender, account sdk.AccAddress, denom string, exempt bool) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the admin of %s", sender.String(), denom)
+	}
+
+	if def.IsAdmin(account) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "admin is always exempted from the rates")
+	}
+
+	k.SetRateExempt(ctx, denom, account, exempt)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRateExemptionChanged{
+		Account: account.String(),
+		Denom:   denom,
+		Exempt:  exempt,
+	})
+}
+
+// IsRateExempt returns true if the account is exempted from the burn rate and the send commission of the denom.
+func (k Keeper) IsRateExempt(ctx sdk.Context, denom string, account sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CreateRateExemptionKey(denom, account))
+}
+
+// GetRateExemptions returns the accounts exempted from the burn rate and the send commission of the denom.
+func (k Keeper) GetRateExemptions(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.RateExemption, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateRateExemptionsKey(denom))
+	exemptions := make([]types.RateExemption, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var exemption types.RateExemption
+		if err := k.cdc.Unmarshal(value, &exemption); err != nil {
+			return err
+		}
+		exemptions = append(exemptions, exemption)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return exemptions, pageRes, nil
+}
+
+// SetRateExempt stores the rate exemption of the account if exempt is true, otherwise it is removed.
+func (k Keeper) SetRateExempt(ctx sdk.Context, denom string, account sdk.AccAddress, exempt bool) {
+	key := types.CreateRateExemptionKey(denom, account)
+	if !exempt {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&types.RateExemption{
+		Denom:   denom,
+		Account: account.String(),
+	}))
+}
+
+// IterateAllRateExemptions iterates over all rate exemptions and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllRateExemptions(ctx sdk.Context, cb func(types.RateExemption) bool) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateExemptionsKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var exemption types.RateExemption
+		k.cdc.MustUnmarshal(iterator.Value(), &exemption)
+
+		if cb(exemption) {
+			break
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// CreateVestingSchedule sends the fungible tokens from the admin to the account and locks them according to the schedule.
+func (k Keeper) CreateVestingSchedule(
+	ctx sdk.Context,
+	sender, addr sdk.AccAddress,
+	coin sdk.Coin,
+	startTime, cliffTime, endTime int64,
+) error {
+	schedule := types.NewVestingSchedule(coin.Amount, startTime, cliffTime, endTime)
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the admin of %s", sender.String(), coin.Denom)
+	}
+
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "vesting schedule can't be created for the admin")
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	if endTime <= blockTime {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "vesting schedule must end in the future")
+	}
+
+	coins := sdk.NewCoins(coin)
+	if err := k.BeforeSendCoins(ctx, sender, addr, coins); err != nil {
+		return err
+	}
+	if err := k.sendCoins(ctx, sender, addr, coins); err != nil {
+		return sdkerrors.Wrapf(err, "can't send coins from admin %s to account %s", sender.String(), addr.String())
+	}
+
+	accountSchedules := k.GetVestingSchedules(ctx, addr, coin.Denom)
+	// fully released schedules don't lock anything anymore, so they are removed to keep the list short
+	activeSchedules := make([]types.VestingSchedule, 0, len(accountSchedules.Schedules)+1)
+	for _, s := range accountSchedules.Schedules {
+		if s.LockedAmount(blockTime).IsPositive() {
+			activeSchedules = append(activeSchedules, s)
+		}
+	}
+	accountSchedules.Schedules = append(activeSchedules, schedule)
+	k.SetVestingSchedules(ctx, accountSchedules)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventVestingScheduleCreated{
+		Account:   addr.String(),
+		Denom:     coin.Denom,
+		Amount:    coin.Amount,
+		StartTime: startTime,
+		CliffTime: cliffTime,
+		EndTime:   endTime,
+	})
+}
+
+// GetVestingSchedules returns the vesting schedules of the denom on the account.
+func (k Keeper) GetVestingSchedules(ctx sdk.Context, addr sdk.AccAddress, denom string) types.AccountVestingSchedules {
+	schedules := types.AccountVestingSchedules{
+		Account: addr.String(),
+		Denom:   denom,
+	}
+	if bz := ctx.KVStore(k.storeKey).Get(types.CreateVestingSchedulesKey(addr, denom)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &schedules)
+	}
+
+	return schedules
+}
+
+// SetVestingSchedules stores the vesting schedules of the denom on the account.
+// If there are no schedules, the entry is removed.
+func (k Keeper) SetVestingSchedules(ctx sdk.Context, schedules types.AccountVestingSchedules) {
+	key := types.CreateVestingSchedulesKey(sdk.MustAccAddressFromBech32(schedules.Account), schedules.Denom)
+	if len(schedules.Schedules) == 0 {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&schedules))
+}
+
+// IterateAllVestingSchedules iterates over the vesting schedules of all the accounts and applies the provided callback.
+// If true is returned from the callback, iteration is stopped.
+func (k Keeper) IterateAllVestingSchedules(ctx sdk.Context, cb func(types.AccountVestingSchedules) bool) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingSchedulesKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedules types.AccountVestingSchedules
+		k.cdc.MustUnmarshal(iterator.Value(), &schedules)
+		if cb(schedules) {
+			break
+		}
+	}
+}
+
+// GetLockedBalance returns the amount of the denom locked by the vesting schedules of the account at the current block time.
+func (k Keeper) GetLockedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, k.GetVestingSchedules(ctx, addr, denom).LockedAmount(ctx.BlockTime().Unix()))
+}
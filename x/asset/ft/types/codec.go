@@ -17,6 +17,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgGloballyFreeze{},
 		&MsgGloballyUnfreeze{},
 		&MsgSetWhitelistedLimit{},
+		&MsgClawback{},
+		&MsgUpdateMetadata{},
+		&MsgTransferAdmin{},
+		&MsgClearAdmin{},
+		&MsgCreateVestingSchedule{},
+		&MsgGrantMintAllowance{},
+		&MsgRevokeMintAllowance{},
+		&MsgSetRateExemption{},
+		&MsgUpdateCommissionRecipients{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -19,4 +19,8 @@ var (
 	ErrGloballyFrozen = sdkerrors.Register(ModuleName, 6, "token is globally frozen")
 	// ErrWhitelistedLimitExceeded is returned when new balance after receiving coins exceeds the whitelisted limit.
 	ErrWhitelistedLimitExceeded = sdkerrors.Register(ModuleName, 7, "whitelisted limit exceeded")
+	// ErrMaxSupplyExceeded is returned when the total supply after minting exceeds the max supply of the token.
+	ErrMaxSupplyExceeded = sdkerrors.Register(ModuleName, 8, "max supply exceeded")
+	// ErrMintAllowanceExceeded is returned when the minter tries to mint more than its mint allowance.
+	ErrMintAllowanceExceeded = sdkerrors.Register(ModuleName, 9, "mint allowance exceeded")
 )
@@ -37,6 +37,12 @@ type EventIssued struct {
 	Features           []Feature                              `protobuf:"varint,8,rep,packed,name=features,proto3,enum=coreum.asset.ft.v1.Feature" json:"features,omitempty"`
 	BurnRate           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_rate"`
 	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
+	// uri is the URI of the token metadata.
+	URI string `protobuf:"bytes,11,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the hash of the metadata referenced by the URI.
+	URIHash string `protobuf:"bytes,12,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// max_supply is the maximum total supply of the token, zero means that the supply is not limited.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
 }
 
 func (m *EventIssued) Reset()         { *m = EventIssued{} }
@@ -121,6 +127,20 @@ func (m *EventIssued) GetFeatures() []Feature {
 	return nil
 }
 
+func (m *EventIssued) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
+func (m *EventIssued) GetURIHash() string {
+	if m != nil {
+		return m.URIHash
+	}
+	return ""
+}
+
 type EventFrozenAmountChanged struct {
 	Account        string                                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	Denom          string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -229,381 +249,2837 @@ func (m *EventWhitelistedAmountChanged) GetDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventIssued)(nil), "coreum.asset.ft.v1.EventIssued")
-	proto.RegisterType((*EventFrozenAmountChanged)(nil), "coreum.asset.ft.v1.EventFrozenAmountChanged")
-	proto.RegisterType((*EventWhitelistedAmountChanged)(nil), "coreum.asset.ft.v1.EventWhitelistedAmountChanged")
+// EventAmountClawedBack is emitted on MsgClawback.
+type EventAmountClawedBack struct {
+	// account is the account the tokens were clawed back from.
+	Account string                                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func init() { proto.RegisterFile("coreum/asset/ft/v1/event.proto", fileDescriptor_bdf87682d70b967f) }
+func (m *EventAmountClawedBack) Reset()         { *m = EventAmountClawedBack{} }
+func (m *EventAmountClawedBack) String() string { return proto.CompactTextString(m) }
+func (*EventAmountClawedBack) ProtoMessage()    {}
+func (*EventAmountClawedBack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{3}
+}
+func (m *EventAmountClawedBack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAmountClawedBack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAmountClawedBack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAmountClawedBack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAmountClawedBack.Merge(m, src)
+}
+func (m *EventAmountClawedBack) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAmountClawedBack) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAmountClawedBack.DiscardUnknown(m)
+}
 
-var fileDescriptor_bdf87682d70b967f = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x94, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0x73, 0x4d, 0x7f, 0xc5, 0x55, 0x83, 0x64, 0x45, 0xe8, 0x54, 0xe0, 0x1a, 0x65, 0x40,
-	0x59, 0x38, 0x2b, 0x74, 0x60, 0xa6, 0x81, 0x48, 0x15, 0x62, 0x39, 0xa9, 0xaa, 0xc4, 0x12, 0x7c,
-	0xbe, 0x97, 0xc4, 0x6a, 0xce, 0x8e, 0xfc, 0x23, 0xa2, 0xfc, 0x15, 0xfc, 0x59, 0x1d, 0x3b, 0x22,
-	0x86, 0x0a, 0x25, 0x2b, 0x7f, 0x01, 0x0b, 0xc8, 0xf6, 0xa5, 0x89, 0xd4, 0x89, 0xac, 0x4c, 0x77,
-	0xef, 0x7d, 0x7d, 0x9f, 0x67, 0xbf, 0xef, 0x3b, 0xa3, 0x84, 0x49, 0x05, 0xb6, 0x24, 0x54, 0x6b,
-	0x30, 0x64, 0x64, 0xc8, 0xbc, 0x47, 0x60, 0x0e, 0xc2, 0xa4, 0x33, 0x25, 0x8d, 0xc4, 0x38, 0xe8,
-	0xa9, 0xd7, 0xd3, 0x91, 0x49, 0xe7, 0xbd, 0x93, 0xd6, 0x58, 0x8e, 0xa5, 0x97, 0x89, 0x7b, 0x0b,
-	0x2b, 0x4f, 0x12, 0x26, 0x75, 0x29, 0x35, 0xc9, 0xa9, 0x06, 0x32, 0xef, 0xe5, 0x60, 0x68, 0x8f,
-	0x30, 0xc9, 0xc5, 0x5a, 0x7f, 0x54, 0xc9, 0xc8, 0x6b, 0xa8, 0xf4, 0xce, 0xaf, 0x3a, 0x3a, 0x7a,
-	0xef, 0x2a, 0x5f, 0x68, 0x6d, 0xa1, 0xc0, 0x2d, 0xb4, 0x57, 0x80, 0x90, 0x65, 0x1c, 0xb5, 0xa3,
-	0x6e, 0x23, 0x0b, 0x01, 0x7e, 0x8a, 0xf6, 0xb9, 0xd3, 0x55, 0xbc, 0xe3, 0xd3, 0x55, 0xe4, 0xf2,
-	0xfa, 0xa6, 0xcc, 0xe5, 0x34, 0xae, 0x87, 0x7c, 0x88, 0x70, 0x8c, 0x0e, 0xb4, 0xcd, 0xad, 0xe0,
-	0x26, 0xde, 0xf5, 0xc2, 0x2a, 0xc4, 0xcf, 0x51, 0x63, 0xa6, 0x80, 0x71, 0xcd, 0xa5, 0x88, 0xf7,
-	0xda, 0x51, 0xf7, 0x38, 0x5b, 0x27, 0xf0, 0x25, 0x6a, 0x72, 0xc1, 0x0d, 0xa7, 0xd3, 0x21, 0x2d,
-	0xa5, 0x15, 0x26, 0xde, 0x77, 0x9f, 0x9f, 0xa7, 0xb7, 0xf7, 0xa7, 0xb5, 0x1f, 0xf7, 0xa7, 0x2f,
-	0xc7, 0xdc, 0x4c, 0x6c, 0x9e, 0x32, 0x59, 0x92, 0xea, 0xe0, 0xe1, 0xf1, 0x4a, 0x17, 0xd7, 0xc4,
-	0xdc, 0xcc, 0x40, 0xa7, 0x17, 0xc2, 0x64, 0xc7, 0x15, 0xe5, 0xad, 0x87, 0xe0, 0x36, 0x3a, 0x2a,
-	0x40, 0x33, 0xc5, 0x67, 0xc6, 0x95, 0x3d, 0xf0, 0x5b, 0xda, 0x4c, 0xe1, 0x37, 0xe8, 0x70, 0x04,
-	0xd4, 0x58, 0x05, 0x3a, 0x3e, 0x6c, 0xd7, 0xbb, 0xcd, 0xd7, 0xcf, 0xd2, 0xc7, 0x1e, 0xa4, 0x83,
-	0xb0, 0x26, 0x7b, 0x58, 0x8c, 0x3f, 0xa0, 0x46, 0x6e, 0x95, 0x18, 0x2a, 0x6a, 0x20, 0x6e, 0xfc,
-	0xf3, 0x66, 0xdf, 0x01, 0xcb, 0x0e, 0x1d, 0x20, 0xa3, 0x06, 0xf0, 0x67, 0xd4, 0xd2, 0x20, 0x8a,
-	0x21, 0x93, 0x65, 0xc9, 0xb5, 0xeb, 0x48, 0xe0, 0xa2, 0xad, 0xb8, 0xd8, 0xb1, 0xfa, 0x0f, 0x28,
-	0x57, 0xa1, 0xf3, 0x3b, 0x42, 0xb1, 0xb7, 0x7b, 0xa0, 0xe4, 0x57, 0x10, 0xa1, 0x3f, 0xfd, 0x09,
-	0x15, 0x63, 0x28, 0x9c, 0x6b, 0x94, 0x31, 0xdf, 0xf6, 0xe0, 0xfe, 0x2a, 0x5c, 0x4f, 0xc5, 0xce,
-	0xe6, 0x54, 0x5c, 0xa1, 0x27, 0x33, 0x05, 0x73, 0x2e, 0xad, 0x5e, 0xd9, 0x55, 0xdf, 0xca, 0xae,
-	0xe6, 0x0a, 0x53, 0xf9, 0x75, 0x89, 0x9a, 0xcc, 0x2a, 0x05, 0xc2, 0xac, 0xb8, 0xbb, 0xdb, 0x8d,
-	0x41, 0x45, 0x09, 0xd8, 0xce, 0x9f, 0x08, 0xbd, 0xf0, 0x87, 0xbf, 0x9a, 0x70, 0x03, 0x53, 0xae,
-	0x0d, 0x14, 0xff, 0x55, 0x07, 0xce, 0x3f, 0xde, 0x2e, 0x92, 0xe8, 0x6e, 0x91, 0x44, 0x3f, 0x17,
-	0x49, 0xf4, 0x6d, 0x99, 0xd4, 0xee, 0x96, 0x49, 0xed, 0xfb, 0x32, 0xa9, 0x7d, 0x3a, 0xdb, 0x00,
-	0xf6, 0xfd, 0xe0, 0x0f, 0xa4, 0x15, 0x05, 0x75, 0x7f, 0x07, 0xa9, 0xee, 0x90, 0x2f, 0xeb, 0x5b,
-	0xc4, 0x57, 0xc8, 0xf7, 0xfd, 0x1d, 0x72, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x7d, 0x12,
-	0xd1, 0xcf, 0x04, 0x00, 0x00,
+var xxx_messageInfo_EventAmountClawedBack proto.InternalMessageInfo
+
+func (m *EventAmountClawedBack) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func (m *EventIssued) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAmountClawedBack) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventIssued) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMetadataUpdated is emitted on MsgUpdateMetadata.
+type EventMetadataUpdated struct {
+	Denom       string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// uri is the URI of the token metadata.
+	URI string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the hash of the metadata referenced by the URI.
+	URIHash string `protobuf:"bytes,4,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 }
 
-func (m *EventIssued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SendCommissionRate.Size()
-		i -= size
-		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.BurnRate.Size()
-		i -= size
-		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.Features) > 0 {
-		dAtA2 := make([]byte, len(m.Features)*10)
-		var j1 int
-		for _, num := range m.Features {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintEvent(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	{
-		size := m.InitialAmount.Size()
-		i -= size
-		if _, err := m.InitialAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventMetadataUpdated) Reset()         { *m = EventMetadataUpdated{} }
+func (m *EventMetadataUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMetadataUpdated) ProtoMessage()    {}
+func (*EventMetadataUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{4}
+}
+func (m *EventMetadataUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMetadataUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMetadataUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.Precision != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Precision))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Subunit) > 0 {
-		i -= len(m.Subunit)
-		copy(dAtA[i:], m.Subunit)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Subunit)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventMetadataUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMetadataUpdated.Merge(m, src)
+}
+func (m *EventMetadataUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMetadataUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMetadataUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMetadataUpdated proto.InternalMessageInfo
+
+func (m *EventMetadataUpdated) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventMetadataUpdated) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMetadataUpdated) GetURI() string {
+	if m != nil {
+		return m.URI
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventFrozenAmountChanged) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMetadataUpdated) GetURIHash() string {
+	if m != nil {
+		return m.URIHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventFrozenAmountChanged) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAdminTransferred is emitted on MsgTransferAdmin.
+type EventAdminTransferred struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// previous_admin is the admin of the token before the transfer.
+	PreviousAdmin string `protobuf:"bytes,2,opt,name=previous_admin,json=previousAdmin,proto3" json:"previous_admin,omitempty"`
+	// current_admin is the admin of the token after the transfer.
+	CurrentAdmin string `protobuf:"bytes,3,opt,name=current_admin,json=currentAdmin,proto3" json:"current_admin,omitempty"`
 }
 
-func (m *EventFrozenAmountChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CurrentAmount.Size()
-		i -= size
-		if _, err := m.CurrentAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.PreviousAmount.Size()
-		i -= size
-		if _, err := m.PreviousAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventAdminTransferred) Reset()         { *m = EventAdminTransferred{} }
+func (m *EventAdminTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventAdminTransferred) ProtoMessage()    {}
+func (*EventAdminTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{5}
+}
+func (m *EventAdminTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventAdminTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminTransferred.Merge(m, src)
+}
+func (m *EventAdminTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminTransferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminTransferred proto.InternalMessageInfo
+
+func (m *EventAdminTransferred) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventAdminTransferred) GetPreviousAdmin() string {
+	if m != nil {
+		return m.PreviousAdmin
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventWhitelistedAmountChanged) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAdminTransferred) GetCurrentAdmin() string {
+	if m != nil {
+		return m.CurrentAdmin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventWhitelistedAmountChanged) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAdminCleared is emitted on MsgClearAdmin.
+type EventAdminCleared struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// previous_admin is the admin of the token before it was cleared.
+	PreviousAdmin string `protobuf:"bytes,2,opt,name=previous_admin,json=previousAdmin,proto3" json:"previous_admin,omitempty"`
 }
 
-func (m *EventWhitelistedAmountChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *EventAdminCleared) Reset()         { *m = EventAdminCleared{} }
+func (m *EventAdminCleared) String() string { return proto.CompactTextString(m) }
+func (*EventAdminCleared) ProtoMessage()    {}
+func (*EventAdminCleared) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{6}
+}
+func (m *EventAdminCleared) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminCleared) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminCleared.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminCleared) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminCleared.Merge(m, src)
+}
+func (m *EventAdminCleared) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminCleared) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminCleared.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminCleared proto.InternalMessageInfo
+
+func (m *EventAdminCleared) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventAdminCleared) GetPreviousAdmin() string {
+	if m != nil {
+		return m.PreviousAdmin
+	}
+	return ""
+}
+
+// EventVestingScheduleCreated is emitted on MsgCreateVestingSchedule.
+type EventVestingScheduleCreated struct {
+	// account is the account receiving the locked tokens.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total amount locked by the schedule.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// start_time is the unix time in seconds when the linear release starts.
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cliff_time is the unix time in seconds before which nothing is released.
+	CliffTime int64 `protobuf:"varint,5,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+	// end_time is the unix time in seconds when the whole amount is released.
+	EndTime int64 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *EventVestingScheduleCreated) Reset()         { *m = EventVestingScheduleCreated{} }
+func (m *EventVestingScheduleCreated) String() string { return proto.CompactTextString(m) }
+func (*EventVestingScheduleCreated) ProtoMessage()    {}
+func (*EventVestingScheduleCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{7}
+}
+func (m *EventVestingScheduleCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVestingScheduleCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVestingScheduleCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVestingScheduleCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVestingScheduleCreated.Merge(m, src)
+}
+func (m *EventVestingScheduleCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVestingScheduleCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVestingScheduleCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVestingScheduleCreated proto.InternalMessageInfo
+
+func (m *EventVestingScheduleCreated) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventVestingScheduleCreated) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventVestingScheduleCreated) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *EventVestingScheduleCreated) GetCliffTime() int64 {
+	if m != nil {
+		return m.CliffTime
+	}
+	return 0
+}
+
+func (m *EventVestingScheduleCreated) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// EventMintAllowanceChanged is emitted when the mint allowance is granted, revoked or used.
+type EventMintAllowanceChanged struct {
+	Minter string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// previous_amount is the amount the minter was allowed to mint before the change.
+	PreviousAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=previous_amount,json=previousAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"previous_amount"`
+	// current_amount is the amount the minter is allowed to mint after the change.
+	CurrentAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=current_amount,json=currentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_amount"`
+}
+
+func (m *EventMintAllowanceChanged) Reset()         { *m = EventMintAllowanceChanged{} }
+func (m *EventMintAllowanceChanged) String() string { return proto.CompactTextString(m) }
+func (*EventMintAllowanceChanged) ProtoMessage()    {}
+func (*EventMintAllowanceChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{8}
+}
+func (m *EventMintAllowanceChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMintAllowanceChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMintAllowanceChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMintAllowanceChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMintAllowanceChanged.Merge(m, src)
+}
+func (m *EventMintAllowanceChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMintAllowanceChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMintAllowanceChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMintAllowanceChanged proto.InternalMessageInfo
+
+func (m *EventMintAllowanceChanged) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *EventMintAllowanceChanged) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventRateExemptionChanged is emitted on MsgSetRateExemption.
+type EventRateExemptionChanged struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exempt defines whether the account doesn't pay the burn rate and the send commission.
+	Exempt bool `protobuf:"varint,3,opt,name=exempt,proto3" json:"exempt,omitempty"`
+}
+
+func (m *EventRateExemptionChanged) Reset()         { *m = EventRateExemptionChanged{} }
+func (m *EventRateExemptionChanged) String() string { return proto.CompactTextString(m) }
+func (*EventRateExemptionChanged) ProtoMessage()    {}
+func (*EventRateExemptionChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{9}
+}
+func (m *EventRateExemptionChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRateExemptionChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRateExemptionChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRateExemptionChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRateExemptionChanged.Merge(m, src)
+}
+func (m *EventRateExemptionChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRateExemptionChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRateExemptionChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRateExemptionChanged proto.InternalMessageInfo
+
+func (m *EventRateExemptionChanged) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventRateExemptionChanged) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventRateExemptionChanged) GetExempt() bool {
+	if m != nil {
+		return m.Exempt
+	}
+	return false
+}
+
+// EventCommissionRecipientsUpdated is emitted on MsgUpdateCommissionRecipients.
+type EventCommissionRecipientsUpdated struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// recipients are the accounts receiving the send commission after the update.
+	Recipients []CommissionRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *EventCommissionRecipientsUpdated) Reset()         { *m = EventCommissionRecipientsUpdated{} }
+func (m *EventCommissionRecipientsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventCommissionRecipientsUpdated) ProtoMessage()    {}
+func (*EventCommissionRecipientsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{10}
+}
+func (m *EventCommissionRecipientsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommissionRecipientsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommissionRecipientsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommissionRecipientsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommissionRecipientsUpdated.Merge(m, src)
+}
+func (m *EventCommissionRecipientsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommissionRecipientsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommissionRecipientsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommissionRecipientsUpdated proto.InternalMessageInfo
+
+func (m *EventCommissionRecipientsUpdated) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventCommissionRecipientsUpdated) GetRecipients() []CommissionRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventIssued)(nil), "coreum.asset.ft.v1.EventIssued")
+	proto.RegisterType((*EventFrozenAmountChanged)(nil), "coreum.asset.ft.v1.EventFrozenAmountChanged")
+	proto.RegisterType((*EventWhitelistedAmountChanged)(nil), "coreum.asset.ft.v1.EventWhitelistedAmountChanged")
+	proto.RegisterType((*EventAmountClawedBack)(nil), "coreum.asset.ft.v1.EventAmountClawedBack")
+	proto.RegisterType((*EventMetadataUpdated)(nil), "coreum.asset.ft.v1.EventMetadataUpdated")
+	proto.RegisterType((*EventAdminTransferred)(nil), "coreum.asset.ft.v1.EventAdminTransferred")
+	proto.RegisterType((*EventAdminCleared)(nil), "coreum.asset.ft.v1.EventAdminCleared")
+	proto.RegisterType((*EventVestingScheduleCreated)(nil), "coreum.asset.ft.v1.EventVestingScheduleCreated")
+	proto.RegisterType((*EventMintAllowanceChanged)(nil), "coreum.asset.ft.v1.EventMintAllowanceChanged")
+	proto.RegisterType((*EventRateExemptionChanged)(nil), "coreum.asset.ft.v1.EventRateExemptionChanged")
+	proto.RegisterType((*EventCommissionRecipientsUpdated)(nil), "coreum.asset.ft.v1.EventCommissionRecipientsUpdated")
+}
+
+func init() { proto.RegisterFile("coreum/asset/ft/v1/event.proto", fileDescriptor_bdf87682d70b967f) }
+
+var fileDescriptor_bdf87682d70b967f = []byte{
+	// 874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0xae, 0x3f, 0x9e, 0x6b, 0x23, 0x56, 0x21, 0xda, 0xb4, 0xd4, 0xb1, 0x16, 0x51,
+	0x72, 0x61, 0x57, 0x69, 0x0f, 0x9c, 0x1b, 0x93, 0x88, 0x08, 0x45, 0x42, 0xdb, 0x9a, 0x4a, 0x5c,
+	0xcc, 0x78, 0xf6, 0xd9, 0x1e, 0x65, 0x77, 0x66, 0x35, 0x33, 0xeb, 0x26, 0xfc, 0x03, 0x1c, 0x81,
+	0xff, 0xaa, 0xc7, 0x1e, 0x11, 0x87, 0x08, 0x39, 0x77, 0xee, 0x70, 0x00, 0x34, 0xb3, 0xeb, 0x0f,
+	0x91, 0x06, 0x54, 0xf7, 0x80, 0x04, 0x27, 0xfb, 0xbd, 0xdf, 0xec, 0xef, 0x7d, 0xbf, 0x19, 0xe8,
+	0x52, 0x21, 0x31, 0x4f, 0x43, 0xa2, 0x14, 0xea, 0x70, 0xac, 0xc3, 0xd9, 0x61, 0x88, 0x33, 0xe4,
+	0x3a, 0xc8, 0xa4, 0xd0, 0xc2, 0x75, 0x0b, 0x3c, 0xb0, 0x78, 0x30, 0xd6, 0xc1, 0xec, 0xf0, 0xde,
+	0xce, 0x44, 0x4c, 0x84, 0x85, 0x43, 0xf3, 0xaf, 0x38, 0x79, 0xaf, 0x4b, 0x85, 0x4a, 0x85, 0x0a,
+	0x47, 0x44, 0x61, 0x38, 0x3b, 0x1c, 0xa1, 0x26, 0x87, 0x21, 0x15, 0x8c, 0xaf, 0xf0, 0x1b, 0x96,
+	0xb4, 0x38, 0xc7, 0x12, 0xf7, 0x7f, 0xaf, 0x42, 0xeb, 0xd8, 0x58, 0x3e, 0x55, 0x2a, 0xc7, 0xd8,
+	0xdd, 0x81, 0x3b, 0x31, 0x72, 0x91, 0x7a, 0x4e, 0xcf, 0x39, 0x68, 0x46, 0x85, 0xe0, 0xee, 0x42,
+	0x8d, 0x19, 0x5c, 0x7a, 0xdb, 0x56, 0x5d, 0x4a, 0x46, 0xaf, 0x2e, 0xd3, 0x91, 0x48, 0xbc, 0x4a,
+	0xa1, 0x2f, 0x24, 0xd7, 0x83, 0xba, 0xca, 0x47, 0x39, 0x67, 0xda, 0xab, 0x5a, 0x60, 0x21, 0xba,
+	0xef, 0x43, 0x33, 0x93, 0x48, 0x99, 0x62, 0x82, 0x7b, 0x77, 0x7a, 0xce, 0x41, 0x3b, 0x5a, 0x29,
+	0xdc, 0x01, 0x74, 0x18, 0x67, 0x9a, 0x91, 0x64, 0x48, 0x52, 0x91, 0x73, 0xed, 0xd5, 0xcc, 0xe7,
+	0x47, 0xc1, 0xcb, 0xab, 0xfd, 0xad, 0x9f, 0xae, 0xf6, 0x1f, 0x4e, 0x98, 0x9e, 0xe6, 0xa3, 0x80,
+	0x8a, 0x34, 0x2c, 0x03, 0x2f, 0x7e, 0x3e, 0x56, 0xf1, 0x79, 0xa8, 0x2f, 0x33, 0x54, 0xc1, 0x29,
+	0xd7, 0x51, 0xbb, 0x64, 0x79, 0x62, 0x49, 0xdc, 0x1e, 0xb4, 0x62, 0x54, 0x54, 0xb2, 0x4c, 0x1b,
+	0xb3, 0x75, 0xeb, 0xd2, 0xba, 0xca, 0xfd, 0x04, 0x1a, 0x63, 0x24, 0x3a, 0x97, 0xa8, 0xbc, 0x46,
+	0xaf, 0x72, 0xd0, 0x79, 0x74, 0x3f, 0xb8, 0x59, 0x83, 0xe0, 0xa4, 0x38, 0x13, 0x2d, 0x0f, 0xbb,
+	0x9f, 0x43, 0x73, 0x94, 0x4b, 0x3e, 0x94, 0x44, 0xa3, 0xd7, 0x7c, 0x63, 0x67, 0x3f, 0x45, 0x1a,
+	0x35, 0x0c, 0x41, 0x44, 0x34, 0xba, 0x5f, 0xc3, 0x8e, 0x42, 0x1e, 0x0f, 0xa9, 0x48, 0x53, 0xa6,
+	0x4c, 0x46, 0x0a, 0x5e, 0xd8, 0x88, 0xd7, 0x35, 0x5c, 0xfd, 0x25, 0x95, 0xb5, 0xb0, 0x07, 0x95,
+	0x5c, 0x32, 0xaf, 0x65, 0x09, 0xeb, 0xf3, 0xab, 0xfd, 0xca, 0x20, 0x3a, 0x8d, 0x8c, 0xce, 0x7d,
+	0x08, 0x8d, 0x5c, 0xb2, 0xe1, 0x94, 0xa8, 0xa9, 0x77, 0xd7, 0xe2, 0xad, 0xf9, 0xd5, 0x7e, 0x7d,
+	0x10, 0x9d, 0x7e, 0x46, 0xd4, 0x34, 0xaa, 0xe7, 0x92, 0x99, 0x3f, 0xee, 0x19, 0x40, 0x4a, 0x2e,
+	0x86, 0x2a, 0xcf, 0xb2, 0xe4, 0xd2, 0x6b, 0x6f, 0x54, 0x9f, 0x66, 0x4a, 0x2e, 0x9e, 0x5a, 0x02,
+	0xff, 0x37, 0x07, 0x3c, 0xdb, 0x80, 0x27, 0x52, 0x7c, 0x83, 0xbc, 0xa8, 0x58, 0x7f, 0x4a, 0xf8,
+	0x04, 0x63, 0xd3, 0x47, 0x84, 0x52, 0xdb, 0x08, 0x45, 0x3f, 0x2e, 0xc4, 0x55, 0x9f, 0x6e, 0xaf,
+	0xf7, 0xe9, 0x73, 0x78, 0x27, 0x93, 0x38, 0x63, 0x22, 0x57, 0x8b, 0x06, 0xaa, 0x6c, 0xe4, 0x60,
+	0x67, 0x41, 0x53, 0x76, 0xd0, 0x00, 0x3a, 0x34, 0x97, 0x12, 0xb9, 0x5e, 0xf0, 0x56, 0x37, 0x6b,
+	0xcc, 0x92, 0xa5, 0xa0, 0xf5, 0xff, 0x70, 0xe0, 0x81, 0x0d, 0xfe, 0xf9, 0x94, 0x69, 0x4c, 0x98,
+	0xd2, 0x18, 0xff, 0xbf, 0x32, 0xf0, 0x9d, 0x03, 0xef, 0x1d, 0xcf, 0x96, 0x72, 0x3f, 0x21, 0x2f,
+	0x30, 0x3e, 0x22, 0xf4, 0xfc, 0x8d, 0x23, 0x3f, 0x81, 0xda, 0x5b, 0x05, 0x5c, 0x7e, 0xed, 0xff,
+	0xe0, 0xc0, 0x8e, 0xf5, 0xe8, 0x0c, 0x35, 0x89, 0x89, 0x26, 0x83, 0x2c, 0x26, 0xfa, 0xd6, 0xd5,
+	0xf8, 0x97, 0xdd, 0xb2, 0x7d, 0x73, 0xb7, 0x94, 0x33, 0x57, 0xf9, 0x87, 0x99, 0xab, 0xde, 0x3e,
+	0x73, 0xfe, 0xe5, 0x22, 0x49, 0x71, 0xca, 0xf8, 0x33, 0x49, 0xb8, 0x1a, 0xa3, 0x94, 0xb7, 0xfa,
+	0xf4, 0x21, 0x74, 0x56, 0x4d, 0x60, 0x3e, 0x29, 0xdd, 0x6a, 0x2f, 0x6b, 0x6a, 0x94, 0xee, 0x07,
+	0xd0, 0x5e, 0x96, 0xd4, 0x9e, 0x2a, 0x96, 0xf8, 0xdd, 0x45, 0x85, 0x8c, 0xce, 0xff, 0x02, 0xde,
+	0x5d, 0x99, 0xee, 0x27, 0x48, 0xde, 0xd6, 0xac, 0xff, 0x8b, 0x03, 0xf7, 0x2d, 0xe5, 0x97, 0xa8,
+	0x34, 0xe3, 0x93, 0xa7, 0x74, 0x8a, 0x71, 0x9e, 0x60, 0x5f, 0xa2, 0xcd, 0xf3, 0xbf, 0x54, 0x78,
+	0xf7, 0x01, 0x80, 0xd2, 0x44, 0xea, 0xa1, 0x66, 0x29, 0xda, 0x72, 0x54, 0xa2, 0xa6, 0xd5, 0x3c,
+	0x63, 0x29, 0x1a, 0x98, 0x26, 0x6c, 0x3c, 0x2e, 0xe0, 0x3b, 0x05, 0x6c, 0x35, 0x16, 0xde, 0x83,
+	0x86, 0x59, 0xdd, 0x16, 0xac, 0x59, 0xb0, 0x8e, 0x3c, 0x36, 0x90, 0xff, 0xab, 0x03, 0x7b, 0x45,
+	0x47, 0x31, 0xae, 0x9f, 0x24, 0x89, 0x78, 0x41, 0x38, 0xc5, 0xc5, 0x84, 0xef, 0x42, 0x2d, 0x65,
+	0x5c, 0xa3, 0x2c, 0xa3, 0x2d, 0xa5, 0xff, 0xc8, 0x7c, 0xd3, 0x32, 0x74, 0x73, 0xfb, 0x1c, 0x5f,
+	0x60, 0x6a, 0x47, 0x62, 0xd3, 0xe5, 0xb6, 0x0b, 0x35, 0xb4, 0x1c, 0x36, 0xe6, 0x46, 0x54, 0x4a,
+	0xfe, 0xb7, 0x0e, 0xf4, 0xac, 0x95, 0xb5, 0xdb, 0x0e, 0x29, 0xcb, 0x18, 0x72, 0xad, 0xfe, 0x7e,
+	0x7c, 0xcf, 0x00, 0xe4, 0xf2, 0xa8, 0xb7, 0xdd, 0xab, 0x1c, 0xb4, 0x1e, 0x7d, 0xf4, 0xba, 0xab,
+	0xff, 0x35, 0xd4, 0x47, 0x55, 0x93, 0x9b, 0x68, 0x8d, 0xe0, 0xe8, 0xec, 0xe5, 0xbc, 0xeb, 0xbc,
+	0x9a, 0x77, 0x9d, 0x9f, 0xe7, 0x5d, 0xe7, 0xfb, 0xeb, 0xee, 0xd6, 0xab, 0xeb, 0xee, 0xd6, 0x8f,
+	0xd7, 0xdd, 0xad, 0xaf, 0x1e, 0xaf, 0xe5, 0xaf, 0x6f, 0xe9, 0x4f, 0x44, 0xce, 0x63, 0x62, 0xf2,
+	0x11, 0x96, 0x8f, 0xb4, 0x8b, 0xd5, 0x33, 0xcd, 0x26, 0x74, 0x54, 0xb3, 0x8f, 0xb4, 0xc7, 0x7f,
+	0x0e, 0x00, 0x01, 0x5b, 0xf3, 0x50, 0x30, 0x0a, 0x00, 0x00,
+}
+
+func (m *EventIssued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIssued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIssued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	{
-		size := m.CurrentAmount.Size()
+		size := m.MaxSupply.Size()
 		i -= size
-		if _, err := m.CurrentAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x6a
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
-		size := m.PreviousAmount.Size()
+		size := m.SendCommissionRate.Size()
 		i -= size
-		if _, err := m.PreviousAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	dAtA[i] = 0x52
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Features) > 0 {
+		dAtA2 := make([]byte, len(m.Features)*10)
+		var j1 int
+		for _, num := range m.Features {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvent(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.InitialAmount.Size()
+		i -= size
+		if _, err := m.InitialAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Precision != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Subunit) > 0 {
+		i -= len(m.Subunit)
+		copy(dAtA[i:], m.Subunit)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Subunit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventFrozenAmountChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventIssued) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventFrozenAmountChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFrozenAmountChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentAmount.Size()
+		i -= size
+		if _, err := m.CurrentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PreviousAmount.Size()
+		i -= size
+		if _, err := m.PreviousAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWhitelistedAmountChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWhitelistedAmountChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWhitelistedAmountChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentAmount.Size()
+		i -= size
+		if _, err := m.CurrentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PreviousAmount.Size()
+		i -= size
+		if _, err := m.PreviousAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAmountClawedBack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAmountClawedBack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAmountClawedBack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Subunit)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMetadataUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Precision != 0 {
-		n += 1 + sovEvent(uint64(m.Precision))
+	return dAtA[:n], nil
+}
+
+func (m *EventMetadataUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMetadataUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.InitialAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovEvent(uint64(e))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentAdmin) > 0 {
+		i -= len(m.CurrentAdmin)
+		copy(dAtA[i:], m.CurrentAdmin)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CurrentAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousAdmin) > 0 {
+		i -= len(m.PreviousAdmin)
+		copy(dAtA[i:], m.PreviousAdmin)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PreviousAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminCleared) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminCleared) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousAdmin) > 0 {
+		i -= len(m.PreviousAdmin)
+		copy(dAtA[i:], m.PreviousAdmin)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PreviousAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVestingScheduleCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVestingScheduleCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVestingScheduleCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CliffTime != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMintAllowanceChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMintAllowanceChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMintAllowanceChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentAmount.Size()
+		i -= size
+		if _, err := m.CurrentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PreviousAmount.Size()
+		i -= size
+		if _, err := m.PreviousAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRateExemptionChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRateExemptionChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRateExemptionChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exempt {
+		i--
+		if m.Exempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommissionRecipientsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommissionRecipientsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommissionRecipientsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventIssued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Subunit)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovEvent(uint64(m.Precision))
+	}
+	l = m.InitialAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	l = m.BurnRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.SendCommissionRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventFrozenAmountChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.PreviousAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CurrentAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventWhitelistedAmountChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.PreviousAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CurrentAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventAmountClawedBack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventMetadataUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAdminTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PreviousAdmin)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.CurrentAdmin)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAdminCleared) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PreviousAdmin)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVestingScheduleCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovEvent(uint64(m.StartTime))
+	}
+	if m.CliffTime != 0 {
+		n += 1 + sovEvent(uint64(m.CliffTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovEvent(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *EventMintAllowanceChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.PreviousAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CurrentAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventRateExemptionChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Exempt {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventCommissionRecipientsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventIssued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIssued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIssued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subunit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v Feature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Feature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]Feature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Feature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Feature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFrozenAmountChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFrozenAmountChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFrozenAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWhitelistedAmountChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWhitelistedAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAmountClawedBack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAmountClawedBack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAmountClawedBack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMetadataUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMetadataUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMetadataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovEvent(uint64(l)) + l
 	}
-	l = m.BurnRate.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.SendCommissionRate.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
 
-func (m *EventFrozenAmountChanged) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.PreviousAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.CurrentAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return nil
 }
-
-func (m *EventWhitelistedAmountChanged) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *EventAdminTransferred) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminTransferred: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.PreviousAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.CurrentAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventIssued) Unmarshal(dAtA []byte) error {
+func (m *EventAdminCleared) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -626,10 +3102,10 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventIssued: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAdminCleared: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventIssued: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAdminCleared: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -666,7 +3142,7 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -694,11 +3170,61 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.PreviousAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVestingScheduleCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVestingScheduleCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVestingScheduleCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -726,11 +3252,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -758,30 +3284,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subunit = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
-			}
-			m.Precision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Precision |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -809,15 +3316,15 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -827,98 +3334,16 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType == 0 {
-				var v Feature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Feature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvent
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvent
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]Feature, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Feature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Feature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
 			}
-			var stringLen uint64
+			m.CliffTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -928,31 +3353,16 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CliffTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -962,26 +3372,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1003,7 +3398,7 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFrozenAmountChanged) Unmarshal(dAtA []byte) error {
+func (m *EventMintAllowanceChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1026,15 +3421,15 @@ func (m *EventFrozenAmountChanged) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFrozenAmountChanged: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMintAllowanceChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFrozenAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMintAllowanceChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1062,7 +3457,7 @@ func (m *EventFrozenAmountChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Minter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1185,7 +3580,7 @@ func (m *EventFrozenAmountChanged) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
+func (m *EventRateExemptionChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1208,10 +3603,10 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWhitelistedAmountChanged: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRateExemptionChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWhitelistedAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRateExemptionChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1279,8 +3674,78 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommissionRecipientsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommissionRecipientsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommissionRecipientsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1308,15 +3773,13 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1326,23 +3789,23 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipients = append(m.Recipients, CommissionRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultGenesis returns the default Token genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -27,6 +32,24 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, schedules := range gs.VestingSchedules {
+		if err := schedules.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, allowance := range gs.MintAllowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, exemption := range gs.RateExemptions {
+		if err := exemption.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
@@ -37,6 +60,12 @@ func (token Token) Validate() error {
 		return err
 	}
 
+	if token.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(token.Admin); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address %s", token.Admin)
+		}
+	}
+
 	if err := ValidateSymbol(token.Symbol); err != nil {
 		return err
 	}
@@ -53,5 +82,17 @@ func (token Token) Validate() error {
 		return err
 	}
 
+	if err := validateMetadata(token.Description, token.URI, token.URIHash); err != nil {
+		return err
+	}
+
+	if err := ValidateMaxSupply(token.MaxSupply, sdk.Int{}); err != nil {
+		return err
+	}
+
+	if err := ValidateCommissionRecipients(token.CommissionRecipients); err != nil {
+		return err
+	}
+
 	return ValidateBurnRate(token.BurnRate)
 }
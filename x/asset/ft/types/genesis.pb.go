@@ -35,6 +35,12 @@ type GenesisState struct {
 	FrozenBalances []Balance `protobuf:"bytes,3,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances"`
 	// whitelisted_balances contains the whitelisted balances on all of the accounts
 	WhitelistedBalances []Balance `protobuf:"bytes,4,rep,name=whitelisted_balances,json=whitelistedBalances,proto3" json:"whitelisted_balances"`
+	// vesting_schedules contains the vesting schedules locking the balances on all of the accounts
+	VestingSchedules []AccountVestingSchedules `protobuf:"bytes,5,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules"`
+	// mint_allowances contains the amounts the minters are allowed to mint
+	MintAllowances []MintAllowance `protobuf:"bytes,6,rep,name=mint_allowances,json=mintAllowances,proto3" json:"mint_allowances"`
+	// rate_exemptions contains the accounts which don't pay the burn rate and the send commission
+	RateExemptions []RateExemption `protobuf:"bytes,7,rep,name=rate_exemptions,json=rateExemptions,proto3" json:"rate_exemptions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,6 +104,27 @@ func (m *GenesisState) GetWhitelistedBalances() []Balance {
 	return nil
 }
 
+func (m *GenesisState) GetVestingSchedules() []AccountVestingSchedules {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintAllowances() []MintAllowance {
+	if m != nil {
+		return m.MintAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateExemptions() []RateExemption {
+	if m != nil {
+		return m.RateExemptions
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used module genesis genesis state.
 type Balance struct {
 	// address is the address of the balance holder.
@@ -161,32 +188,40 @@ func init() {
 func init() { proto.RegisterFile("coreum/asset/ft/v1/genesis.proto", fileDescriptor_d281657d6c91cb92) }
 
 var fileDescriptor_d281657d6c91cb92 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6e, 0xea, 0x30,
-	0x14, 0xc6, 0x13, 0xe0, 0x82, 0xae, 0xb9, 0xba, 0x95, 0x5c, 0x86, 0x94, 0x4a, 0x01, 0x31, 0xb1,
-	0xd4, 0x6e, 0x60, 0x68, 0xe7, 0x20, 0xb5, 0x52, 0xa5, 0x4a, 0x15, 0x65, 0xea, 0x52, 0x39, 0x89,
-	0x09, 0x11, 0x24, 0x46, 0xb1, 0xa1, 0x7f, 0x1e, 0xa0, 0x73, 0xdf, 0xa1, 0x5b, 0x9f, 0x84, 0x91,
-	0xb1, 0x53, 0x5b, 0xc1, 0x8b, 0x54, 0xb1, 0x1d, 0x81, 0x04, 0x43, 0xa7, 0xc4, 0x39, 0xdf, 0xf7,
-	0x3b, 0xc7, 0xf9, 0x0e, 0x68, 0xfa, 0x2c, 0xa5, 0xb3, 0x18, 0x13, 0xce, 0xa9, 0xc0, 0x43, 0x81,
-	0xe7, 0x0e, 0x0e, 0x69, 0x42, 0x79, 0xc4, 0xd1, 0x34, 0x65, 0x82, 0x41, 0xa8, 0x14, 0x48, 0x2a,
-	0xd0, 0x50, 0xa0, 0xb9, 0x53, 0xaf, 0x85, 0x2c, 0x64, 0xb2, 0x8c, 0xb3, 0x37, 0xa5, 0xac, 0xdb,
-	0x3e, 0xe3, 0x31, 0xe3, 0xd8, 0x23, 0x9c, 0xe2, 0xb9, 0xe3, 0x51, 0x41, 0x1c, 0xec, 0xb3, 0x28,
-	0xd9, 0xd4, 0x77, 0x7a, 0x09, 0x36, 0xa6, 0x79, 0xbd, 0xb1, 0xa7, 0x3e, 0x25, 0x29, 0x89, 0xf5,
-	0x28, 0xad, 0xb7, 0x02, 0xf8, 0x77, 0xa9, 0x86, 0xbb, 0x15, 0x44, 0x50, 0x78, 0x0e, 0xca, 0x4a,
-	0x60, 0x99, 0x4d, 0xb3, 0x5d, 0xed, 0xd4, 0xd1, 0xee, 0xb0, 0xe8, 0x46, 0x2a, 0xdc, 0xd2, 0xe2,
-	0xb3, 0x61, 0xf4, 0xb5, 0x1e, 0x9e, 0x81, 0xb2, 0x6c, 0xcd, 0xad, 0x42, 0xb3, 0xd8, 0xae, 0x76,
-	0x8e, 0xf6, 0x39, 0x07, 0x99, 0x22, 0x37, 0x2a, 0x39, 0xbc, 0x02, 0x07, 0xc3, 0x94, 0x3d, 0xd3,
-	0xe4, 0xde, 0x23, 0x13, 0x92, 0xf8, 0x94, 0x5b, 0x45, 0x49, 0x38, 0xde, 0x47, 0x70, 0x95, 0x46,
-	0x33, 0xfe, 0x2b, 0xa7, 0xfe, 0xc8, 0xe1, 0x00, 0xd4, 0x1e, 0x46, 0x91, 0xa0, 0x93, 0x88, 0x0b,
-	0x1a, 0x6c, 0x80, 0xa5, 0xdf, 0x02, 0x0f, 0xb7, 0xec, 0x39, 0xb5, 0xf5, 0x62, 0x82, 0x8a, 0x3e,
-	0x40, 0x0b, 0x54, 0x48, 0x10, 0xa4, 0x94, 0xab, 0x3f, 0xf4, 0xb7, 0x9f, 0x1f, 0x21, 0x01, 0x7f,
-	0xb2, 0x68, 0xb6, 0xef, 0x9f, 0x85, 0x87, 0xb2, 0xf0, 0x90, 0x0e, 0x0f, 0xf5, 0x58, 0x94, 0xb8,
-	0xa7, 0x59, 0xab, 0xf7, 0xaf, 0x46, 0x3b, 0x8c, 0xc4, 0x68, 0xe6, 0x21, 0x9f, 0xc5, 0x58, 0x27,
-	0xad, 0x1e, 0x27, 0x3c, 0x18, 0x63, 0xf1, 0x34, 0xa5, 0x5c, 0x1a, 0x78, 0x5f, 0x91, 0xdd, 0xeb,
-	0xc5, 0xca, 0x36, 0x97, 0x2b, 0xdb, 0xfc, 0x5e, 0xd9, 0xe6, 0xeb, 0xda, 0x36, 0x96, 0x6b, 0xdb,
-	0xf8, 0x58, 0xdb, 0xc6, 0x5d, 0x77, 0x0b, 0xd5, 0x93, 0x97, 0xbc, 0x60, 0xb3, 0x24, 0x20, 0x22,
-	0x62, 0x09, 0xd6, 0x5b, 0xf0, 0xb8, 0xd9, 0x03, 0xc9, 0xf6, 0xca, 0x72, 0x09, 0xba, 0x3f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x08, 0x16, 0xe5, 0x63, 0xb3, 0x02, 0x00, 0x00,
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xaf, 0x69, 0xa2, 0x6f, 0x8b, 0x28, 0x98, 0x1e, 0x4c, 0x90, 0x9c, 0xd0, 0x0b,
+	0x91, 0x10, 0xbb, 0xa4, 0x3d, 0xc0, 0xb5, 0xa9, 0x00, 0x09, 0xa9, 0x52, 0x95, 0x56, 0x1c, 0x38,
+	0x10, 0x6d, 0xec, 0x49, 0xb2, 0x6a, 0xbc, 0x1b, 0x79, 0x36, 0x6e, 0xe1, 0x01, 0x38, 0xf3, 0x16,
+	0x48, 0x3c, 0x49, 0x8f, 0x3d, 0x72, 0x02, 0x94, 0xbc, 0x08, 0xf2, 0xee, 0x9a, 0xb8, 0xd4, 0x20,
+	0x4e, 0x89, 0x67, 0xfe, 0xf3, 0xdb, 0x99, 0xd9, 0xfd, 0x93, 0x4e, 0xa4, 0x52, 0x58, 0x24, 0x8c,
+	0x23, 0x82, 0x66, 0x63, 0xcd, 0xb2, 0x1e, 0x9b, 0x80, 0x04, 0x14, 0x48, 0xe7, 0xa9, 0xd2, 0xca,
+	0xf7, 0xad, 0x82, 0x1a, 0x05, 0x1d, 0x6b, 0x9a, 0xf5, 0x5a, 0x3b, 0x13, 0x35, 0x51, 0x26, 0xcd,
+	0xf2, 0x7f, 0x56, 0xd9, 0x0a, 0x23, 0x85, 0x89, 0x42, 0x36, 0xe2, 0x08, 0x2c, 0xeb, 0x8d, 0x40,
+	0xf3, 0x1e, 0x8b, 0x94, 0x90, 0xeb, 0xfc, 0x8d, 0xb3, 0xb4, 0x3a, 0x83, 0x22, 0xdf, 0xae, 0xc8,
+	0xcf, 0x79, 0xca, 0x13, 0xd7, 0x4a, 0xab, 0xaa, 0xd9, 0x0c, 0x50, 0x0b, 0x39, 0x71, 0x8a, 0x47,
+	0x15, 0x8a, 0x44, 0x48, 0x3d, 0xe4, 0xb3, 0x99, 0x3a, 0xe7, 0x32, 0x82, 0xbf, 0x08, 0x53, 0xae,
+	0x61, 0x08, 0x17, 0x90, 0xcc, 0xb5, 0x50, 0xae, 0xa9, 0xdd, 0xcf, 0x75, 0x72, 0xeb, 0x95, 0x5d,
+	0xc8, 0x89, 0xe6, 0x1a, 0xfc, 0xe7, 0xa4, 0x61, 0x9b, 0x0a, 0xbc, 0x8e, 0xd7, 0xdd, 0xda, 0x6b,
+	0xd1, 0x9b, 0x0b, 0xa2, 0xc7, 0x46, 0xd1, 0xaf, 0x5f, 0x7e, 0x6b, 0xd7, 0x06, 0x4e, 0xef, 0x3f,
+	0x23, 0x0d, 0x33, 0x2e, 0x06, 0xff, 0x75, 0x36, 0xba, 0x5b, 0x7b, 0xf7, 0xab, 0x2a, 0x4f, 0x73,
+	0x45, 0x51, 0x68, 0xe5, 0xfe, 0x6b, 0xb2, 0x3d, 0x4e, 0xd5, 0x07, 0x90, 0xc3, 0x11, 0x9f, 0xe5,
+	0x43, 0x60, 0xb0, 0x61, 0x08, 0x0f, 0xaa, 0x08, 0x7d, 0xab, 0x71, 0x8c, 0xdb, 0xb6, 0xd2, 0x05,
+	0xd1, 0x3f, 0x25, 0x3b, 0xe7, 0x53, 0xa1, 0x61, 0x26, 0x50, 0x43, 0xbc, 0x06, 0xd6, 0xff, 0x15,
+	0x78, 0xaf, 0x54, 0xfe, 0x8b, 0xfa, 0x8e, 0xdc, 0x75, 0x17, 0x31, 0xc4, 0x68, 0x0a, 0xf1, 0x62,
+	0x06, 0x18, 0x6c, 0x1a, 0xe4, 0xe3, 0x2a, 0xe4, 0x41, 0x14, 0xa9, 0x85, 0xd4, 0x6f, 0x6c, 0xcd,
+	0x49, 0x51, 0xe2, 0x8e, 0xb8, 0x93, 0xfd, 0x16, 0xf7, 0x8f, 0xc9, 0xf6, 0xf5, 0x6b, 0xc4, 0xa0,
+	0x61, 0xe8, 0x0f, 0xab, 0xe8, 0x47, 0x42, 0xea, 0x83, 0x42, 0x59, 0xec, 0x21, 0x29, 0x07, 0x0d,
+	0xf1, 0xfa, 0x7d, 0x63, 0xd0, 0xfc, 0x33, 0x71, 0xc0, 0x35, 0xbc, 0x28, 0x94, 0x05, 0x31, 0x2d,
+	0x07, 0x71, 0xf7, 0xa3, 0x47, 0x9a, 0x6e, 0x21, 0x7e, 0x40, 0x9a, 0x3c, 0x8e, 0x53, 0x40, 0xfb,
+	0x4a, 0xfe, 0x1f, 0x14, 0x9f, 0x3e, 0x27, 0x9b, 0xb9, 0x25, 0xca, 0x6f, 0x20, 0x37, 0x0d, 0xcd,
+	0x4d, 0x43, 0x9d, 0x69, 0xe8, 0xa1, 0x12, 0xb2, 0xff, 0x34, 0x3f, 0xe5, 0xcb, 0xf7, 0x76, 0x77,
+	0x22, 0xf4, 0x74, 0x31, 0xa2, 0x91, 0x4a, 0x98, 0x73, 0x98, 0xfd, 0x79, 0x82, 0xf1, 0x19, 0xd3,
+	0xef, 0xe7, 0x80, 0xa6, 0x00, 0x07, 0x96, 0xdc, 0x3f, 0xba, 0x5c, 0x86, 0xde, 0xd5, 0x32, 0xf4,
+	0x7e, 0x2c, 0x43, 0xef, 0xd3, 0x2a, 0xac, 0x5d, 0xad, 0xc2, 0xda, 0xd7, 0x55, 0x58, 0x7b, 0xbb,
+	0x5f, 0x42, 0x1d, 0x9a, 0x29, 0x5f, 0xaa, 0x85, 0x8c, 0x79, 0x3e, 0x00, 0x73, 0x8e, 0xb8, 0x58,
+	0x7b, 0xc2, 0xb0, 0x47, 0x0d, 0x63, 0x84, 0xfd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x74, 0x7d,
+	0x09, 0x6c, 0x2b, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -209,6 +244,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateExemptions) > 0 {
+		for iNdEx := len(m.RateExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for iNdEx := len(m.MintAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.WhitelistedBalances) > 0 {
 		for iNdEx := len(m.WhitelistedBalances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -345,6 +422,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for _, e := range m.MintAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateExemptions) > 0 {
+		for _, e := range m.RateExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -537,6 +632,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, AccountVestingSchedules{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAllowances = append(m.MintAllowances, MintAllowance{})
+			if err := m.MintAllowances[len(m.MintAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateExemptions = append(m.RateExemptions, RateExemption{})
+			if err := m.RateExemptions[len(m.RateExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -35,6 +35,14 @@ var (
 	GlobalFreezeKeyPrefix = []byte{0x04}
 	// WhitelistedBalancesKeyPrefix defines the key prefix to track whitelisted balances.
 	WhitelistedBalancesKeyPrefix = []byte{0x05}
+	// VestingSchedulesKeyPrefix defines the key prefix to track vesting schedules locking the balances.
+	VestingSchedulesKeyPrefix = []byte{0x06}
+	// MintAllowancesKeyPrefix defines the key prefix to track mint allowances.
+	MintAllowancesKeyPrefix = []byte{0x07}
+	// RateExemptionsKeyPrefix defines the key prefix to track the accounts exempted from the burn rate and the send commission.
+	RateExemptionsKeyPrefix = []byte{0x08}
+	// HoldersKeyPrefix defines the key prefix of the denom-first index of the accounts holding the fungible tokens.
+	HoldersKeyPrefix = []byte{0x09}
 )
 
 // CreateTokenKey constructs the key for the fungible token.
@@ -67,6 +75,41 @@ func CreateWhitelistedBalancesKey(addr []byte) []byte {
 	return store.JoinKeys(WhitelistedBalancesKeyPrefix, address.MustLengthPrefix(addr))
 }
 
+// CreateVestingSchedulesKey creates the key for the vesting schedules of the denom on the account.
+func CreateVestingSchedulesKey(addr []byte, denom string) []byte {
+	return store.JoinKeys(store.JoinKeys(VestingSchedulesKeyPrefix, address.MustLengthPrefix(addr)), []byte(denom))
+}
+
+// CreateMintAllowancesKey creates the prefix for the mint allowances of the denom.
+func CreateMintAllowancesKey(denom string) []byte {
+	return store.JoinKeys(MintAllowancesKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateMintAllowanceKey creates the key for the mint allowance of the denom granted to the minter.
+func CreateMintAllowanceKey(denom string, minter sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateMintAllowancesKey(denom), minter)
+}
+
+// CreateRateExemptionsKey creates the prefix for the rate exemptions of the denom.
+func CreateRateExemptionsKey(denom string) []byte {
+	return store.JoinKeys(RateExemptionsKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateRateExemptionKey creates the key for the rate exemption of the denom granted to the account.
+func CreateRateExemptionKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateRateExemptionsKey(denom), addr)
+}
+
+// CreateHoldersKey creates the prefix for the holders of the denom.
+func CreateHoldersKey(denom string) []byte {
+	return store.JoinKeys(HoldersKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateHolderKey creates the key for the holder of the denom.
+func CreateHolderKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateHoldersKey(denom), addr)
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the prefix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
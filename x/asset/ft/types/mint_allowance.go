@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the denom and minter are valid and the amount is positive.
+func (a MintAllowance) Validate() error {
+	if _, _, err := DeconstructDenom(a.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Minter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address %s", a.Minter)
+	}
+
+	if a.Amount.IsNil() || !a.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "mint allowance of %s for %s must be positive", a.Denom, a.Minter)
+	}
+
+	return nil
+}
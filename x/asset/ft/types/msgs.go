@@ -14,12 +14,26 @@ var (
 	_ sdk.Msg = &MsgGloballyFreeze{}
 	_ sdk.Msg = &MsgGloballyUnfreeze{}
 	_ sdk.Msg = &MsgSetWhitelistedLimit{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgUpdateMetadata{}
+	_ sdk.Msg = &MsgTransferAdmin{}
+	_ sdk.Msg = &MsgClearAdmin{}
+	_ sdk.Msg = &MsgCreateVestingSchedule{}
+	_ sdk.Msg = &MsgGrantMintAllowance{}
+	_ sdk.Msg = &MsgRevokeMintAllowance{}
+	_ sdk.Msg = &MsgSetRateExemption{}
+	_ sdk.Msg = &MsgUpdateCommissionRecipients{}
+)
+
+// Constraints.
+const (
+	MaxDescriptionLength = 200
+	MaxURILength         = 256
+	MaxURIHashLength     = 128
 )
 
 // ValidateBasic validates the message.
 func (msg MsgIssue) ValidateBasic() error {
-	const maxDescriptionLength = 200
-
 	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer %s", msg.Issuer)
 	}
@@ -49,8 +63,12 @@ func (msg MsgIssue) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInput, "invalid initial amount %s, can't be negative", msg.InitialAmount.String())
 	}
 
-	if len(msg.Description) > maxDescriptionLength {
-		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must be less than %d", msg.Description, maxDescriptionLength)
+	if err := ValidateMaxSupply(msg.MaxSupply, msg.InitialAmount); err != nil {
+		return err
+	}
+
+	if err := validateMetadata(msg.Description, msg.URI, msg.URIHash); err != nil {
+		return err
 	}
 
 	return nil
@@ -113,15 +131,10 @@ func (msg MsgFreeze) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	_, issuer, err := DeconstructDenom(msg.Coin.Denom)
-	if err != nil {
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
 		return err
 	}
 
-	if issuer.String() == msg.Account {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be frozen")
-	}
-
 	return msg.Coin.Validate()
 }
 
@@ -206,20 +219,263 @@ func (msg MsgSetWhitelistedLimit) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	_, issuer, err := DeconstructDenom(msg.Coin.Denom)
-	if err != nil {
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
 		return err
 	}
 
-	if issuer.String() == msg.Account {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "issuer's balance can't be whitelisted")
+	return msg.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgSetWhitelistedLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
 	}
 
 	return msg.Coin.Validate()
 }
 
 // GetSigners returns the required signers of this message type.
-func (msg MsgSetWhitelistedLimit) GetSigners() []sdk.AccAddress {
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgUpdateMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return validateMetadata(msg.Description, msg.URI, msg.URIHash)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgUpdateMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgTransferAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if msg.Sender == msg.Account {
+		return sdkerrors.Wrap(ErrInvalidInput, "sender and account must be different")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgTransferAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgClearAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+func validateMetadata(description, uri, uriHash string) error {
+	if len(description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must be less than %d", description, MaxDescriptionLength)
+	}
+
+	if len(uri) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", uri, MaxURILength)
+	}
+
+	if len(uriHash) > MaxURIHashLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", uriHash, MaxURIHashLength)
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgCreateVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if msg.Sender == msg.Account {
+		return sdkerrors.Wrap(ErrInvalidInput, "vesting schedule can't be created for the sender")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := msg.Coin.Validate(); err != nil {
+		return err
+	}
+
+	return NewVestingSchedule(msg.Coin.Amount, msg.StartTime, msg.CliffTime, msg.EndTime).Validate()
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgCreateVestingSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgGrantMintAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	if msg.Sender == msg.Minter {
+		return sdkerrors.Wrap(ErrInvalidInput, "mint allowance can't be granted to the sender")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := msg.Coin.Validate(); err != nil {
+		return err
+	}
+
+	if !msg.Coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "mint allowance amount should be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgGrantMintAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgRevokeMintAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid minter address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgRevokeMintAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgSetRateExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if msg.Sender == msg.Account {
+		return sdkerrors.Wrap(ErrInvalidInput, "admin is always exempted from the rates")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgSetRateExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgUpdateCommissionRecipients) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateCommissionRecipients(msg.Recipients)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgUpdateCommissionRecipients) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{
 		sdk.MustAccAddressFromBech32(msg.Sender),
 	}
@@ -80,6 +80,26 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 	msg = msgF()
 	msg.SendCommissionRate = sdk.MustNewDecFromStr("-0.1")
 	requireT.Error(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.URI = string(make([]byte, 257))
+	requireT.Error(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.URIHash = string(make([]byte, 129))
+	requireT.Error(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.MaxSupply = sdk.NewInt(777)
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.MaxSupply = sdk.NewInt(776)
+	requireT.Error(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.MaxSupply = sdk.NewInt(-1)
+	requireT.Error(msg.ValidateBasic())
 }
 
 func TestMsgFreeze_ValidateBasic(t *testing.T) {
@@ -123,18 +143,6 @@ func TestMsgFreeze_ValidateBasic(t *testing.T) {
 			},
 			expectedError: sdkerrors.ErrInvalidAddress,
 		},
-		{
-			name: "issuer freezing",
-			message: types.MsgFreeze{
-				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-				Account: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-				Coin: sdk.Coin{
-					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-					Amount: sdk.NewInt(100),
-				},
-			},
-			expectedError: sdkerrors.ErrUnauthorized,
-		},
 	}
 
 	for _, testCase := range testCases {
@@ -372,17 +380,418 @@ func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
 			},
 			expectedErrorString: "invalid denom",
 		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			switch {
+			case tc.expectedError == nil && tc.expectedErrorString == "":
+				assertT.NoError(err)
+			case tc.expectedErrorString != "":
+				assertT.Contains(err.Error(), tc.expectedErrorString)
+			default:
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgClawback_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgClawback
+		expectedError error
+	}{
 		{
-			name: "issuer whitelisting",
-			message: types.MsgSetWhitelistedLimit{
+			name: "valid msg",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateMetadata_ValidateBasic(t *testing.T) {
+	requireT := require.New(t)
+
+	msgF := func() types.MsgUpdateMetadata {
+		return types.MsgUpdateMetadata{
+			Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			Denom:       "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			Description: "ABC Description",
+			URI:         "https://my-token-meta.invalid/1",
+			URIHash:     "e000624",
+		}
+	}
+
+	msg := msgF()
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.Description = ""
+	msg.URI = ""
+	msg.URIHash = ""
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.Sender = "invalid"
+	requireT.ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	msg = msgF()
+	msg.Denom = "abc"
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidDenom)
+
+	msg = msgF()
+	msg.Description = string(make([]byte, 201))
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.URI = string(make([]byte, 257))
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.URIHash = string(make([]byte, 129))
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+}
+
+func TestMsgTransferAdmin_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgTransferAdmin
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "transfer to itself",
+			message: types.MsgTransferAdmin{
 				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 				Account: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgClearAdmin_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgClearAdmin
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgClearAdmin{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgClearAdmin{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgClearAdmin{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgCreateVestingSchedule_ValidateBasic(t *testing.T) {
+	validMsg := types.MsgCreateVestingSchedule{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+		Coin: sdk.Coin{
+			Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			Amount: sdk.NewInt(100),
+		},
+		StartTime: 1000,
+		CliffTime: 1500,
+		EndTime:   2000,
+	}
+
+	testCases := []struct {
+		name          string
+		message       func() types.MsgCreateVestingSchedule
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: func() types.MsgCreateVestingSchedule {
+				return validMsg
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: func() types.MsgCreateVestingSchedule {
+				msg := validMsg
+				msg.Sender = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+"
+				return msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: func() types.MsgCreateVestingSchedule {
+				msg := validMsg
+				msg.Account = "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+"
+				return msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "account equal to sender",
+			message: func() types.MsgCreateVestingSchedule {
+				msg := validMsg
+				msg.Account = msg.Sender
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid denom",
+			message: func() types.MsgCreateVestingSchedule {
+				msg := validMsg
+				msg.Coin.Denom = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+"
+				return msg
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "zero amount",
+			message: func() types.MsgCreateVestingSchedule {
+				msg := validMsg
+				msg.Coin.Amount = sdk.ZeroInt()
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "cliff after end",
+			message: func() types.MsgCreateVestingSchedule {
+				msg := validMsg
+				msg.CliffTime = 2001
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgGrantMintAllowance_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgGrantMintAllowance
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgGrantMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Minter: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgGrantMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Minter: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid minter address",
+			message: types.MsgGrantMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Minter: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "minter equal to sender",
+			message: types.MsgGrantMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Minter: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 				Coin: sdk.Coin{
 					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 					Amount: sdk.NewInt(100),
 				},
 			},
-			expectedError: sdkerrors.ErrUnauthorized,
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgGrantMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Minter: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "zero amount",
+			message: types.MsgGrantMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Minter: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.ZeroInt(),
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidCoins,
 		},
 	}
 
@@ -391,12 +800,214 @@ func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assertT := assert.New(t)
 			err := tc.message.ValidateBasic()
-			switch {
-			case tc.expectedError == nil && tc.expectedErrorString == "":
+			if tc.expectedError == nil {
 				assertT.NoError(err)
-			case tc.expectedErrorString != "":
-				assertT.Contains(err.Error(), tc.expectedErrorString)
-			default:
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgRevokeMintAllowance_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgRevokeMintAllowance
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgRevokeMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Minter: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgRevokeMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Minter: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid minter address",
+			message: types.MsgRevokeMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Minter: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgRevokeMintAllowance{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Minter: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSetRateExemption_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgSetRateExemption
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Exempt:  true,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Exempt:  true,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account address",
+			message: types.MsgSetRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Exempt:  true,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "account is the sender",
+			message: types.MsgSetRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Exempt:  true,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Exempt:  false,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateCommissionRecipients_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgUpdateCommissionRecipients
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgUpdateCommissionRecipients{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Recipients: []types.CommissionRecipient{
+					{Address: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq", Weight: 1},
+				},
+			},
+		},
+		{
+			name: "valid msg without recipients",
+			message: types.MsgUpdateCommissionRecipients{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgUpdateCommissionRecipients{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgUpdateCommissionRecipients{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "invalid recipient address",
+			message: types.MsgUpdateCommissionRecipients{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Recipients: []types.CommissionRecipient{
+					{Address: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+", Weight: 1},
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero weight",
+			message: types.MsgUpdateCommissionRecipients{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Recipients: []types.CommissionRecipient{
+					{Address: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq", Weight: 0},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
 				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
 			}
 		})
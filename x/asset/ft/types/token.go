@@ -29,6 +29,8 @@ const (
 	denomSeparator = "-"
 	// MaxPrecision used when issuing a token.
 	MaxPrecision = 20
+	// MaxCommissionRecipients is the maximum number of accounts receiving the send commission.
+	MaxCommissionRecipients = 10
 )
 
 func init() {
@@ -47,6 +49,9 @@ type IssueSettings struct {
 	Features           []Feature
 	BurnRate           sdk.Dec
 	SendCommissionRate sdk.Dec
+	URI                string
+	URIHash            string
+	MaxSupply          sdk.Int
 }
 
 // BuildDenom builds the denom string from the symbol and issuer address.
@@ -158,12 +163,12 @@ func (def Definition) CheckFeatureAllowed(addr sdk.AccAddress, feature Feature)
 // IsFeatureAllowed returns true if feature is allowed for the address.
 func (def Definition) IsFeatureAllowed(addr sdk.Address, feature Feature) bool {
 	featureEnabled := def.IsFeatureEnabled(feature)
-	// issuer can use any enabled feature and burning even if it is disabled
-	if def.IsIssuer(addr) {
+	// admin can use any enabled feature and burning even if it is disabled
+	if def.IsAdmin(addr) {
 		return featureEnabled || feature == Feature_burning
 	}
 
-	// non-issuer can use only burning and only if it is enabled
+	// non-admin can use only burning and only if it is enabled
 	return featureEnabled && feature == Feature_burning
 }
 
@@ -177,6 +182,64 @@ func (def Definition) IsIssuer(addr sdk.Address) bool {
 	return def.Issuer == addr.String()
 }
 
+// IsAdmin returns true if the addr is the admin.
+func (def Definition) IsAdmin(addr sdk.Address) bool {
+	return def.Admin != "" && def.Admin == addr.String()
+}
+
+// HasAdmin returns true if the admin is set.
+func (def Definition) HasAdmin() bool {
+	return def.Admin != ""
+}
+
+// IsSupplyLimited returns true if the max supply is set for the token.
+func (def Definition) IsSupplyLimited() bool {
+	return !def.MaxSupply.IsNil() && def.MaxSupply.IsPositive()
+}
+
+// ValidateMaxSupply checks that the max supply is not negative and the initial amount doesn't exceed it.
+// Nil or zero max supply means that the supply is not limited.
+func ValidateMaxSupply(maxSupply, initialAmount sdk.Int) error {
+	if maxSupply.IsNil() || maxSupply.IsZero() {
+		return nil
+	}
+
+	if maxSupply.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid max supply %s, can't be negative", maxSupply.String())
+	}
+
+	if !initialAmount.IsNil() && initialAmount.GT(maxSupply) {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "initial amount %s exceeds the max supply %s", initialAmount.String(), maxSupply.String(),
+		)
+	}
+
+	return nil
+}
+
+// ValidateCommissionRecipients checks that the commission recipients are unique valid addresses with positive weights.
+func ValidateCommissionRecipients(recipients []CommissionRecipient) error {
+	if len(recipients) > MaxCommissionRecipients {
+		return sdkerrors.Wrapf(ErrInvalidInput, "number of commission recipients must not exceed %d", MaxCommissionRecipients)
+	}
+
+	addresses := make(map[string]struct{}, len(recipients))
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid commission recipient address %s", recipient.Address)
+		}
+		if recipient.Weight == 0 {
+			return sdkerrors.Wrapf(ErrInvalidInput, "weight of the commission recipient %s must be positive", recipient.Address)
+		}
+		if _, ok := addresses[recipient.Address]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated commission recipient %s", recipient.Address)
+		}
+		addresses[recipient.Address] = struct{}{}
+	}
+
+	return nil
+}
+
 // ValidateBurnRate checks that the provided burn rate is valid.
 func ValidateBurnRate(burnRate sdk.Dec) error {
 	if err := validateRate(burnRate); err != nil {
@@ -29,10 +29,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Feature int32
 
 const (
-	Feature_minting      Feature = 0
-	Feature_burning      Feature = 1
-	Feature_freezing     Feature = 2
-	Feature_whitelisting Feature = 3
+	Feature_minting            Feature = 0
+	Feature_burning            Feature = 1
+	Feature_freezing           Feature = 2
+	Feature_whitelisting       Feature = 3
+	Feature_clawback           Feature = 4
+	Feature_updatable_metadata Feature = 5
+	Feature_ibc                Feature = 6
 )
 
 var Feature_name = map[int32]string{
@@ -40,13 +43,19 @@ var Feature_name = map[int32]string{
 	1: "burning",
 	2: "freezing",
 	3: "whitelisting",
+	4: "clawback",
+	5: "updatable_metadata",
+	6: "ibc",
 }
 
 var Feature_value = map[string]int32{
-	"minting":      0,
-	"burning":      1,
-	"freezing":     2,
-	"whitelisting": 3,
+	"minting":            0,
+	"burning":            1,
+	"freezing":           2,
+	"whitelisting":       3,
+	"clawback":           4,
+	"updatable_metadata": 5,
+	"ibc":                6,
 }
 
 func (x Feature) String() string {
@@ -66,8 +75,20 @@ type Definition struct {
 	// burn_amount. This value will be burnt on top of the send amount.
 	BurnRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_rate"`
 	// send_commission_rate is a number between 0 and 1 which will be multiplied by send amount to determine
-	// amount sent to the token issuer account.
+	// amount sent to the token admin account.
 	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
+	// admin is the account allowed to execute the privileged operations on the token. It is set to the issuer
+	// on issuance, might be transferred to another account and is empty if the admin has been cleared.
+	Admin string `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
+	// uri is the URI of the token metadata.
+	URI string `protobuf:"bytes,7,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the hash of the metadata referenced by the URI.
+	URIHash string `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// max_supply is the maximum total supply of the token, zero means that the supply is not limited.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// commission_recipients are the accounts receiving the send commission proportionally to their weights.
+	// If empty, the send commission is sent to the admin.
+	CommissionRecipients []CommissionRecipient `protobuf:"bytes,10,rep,name=commission_recipients,json=commissionRecipients,proto3" json:"commission_recipients"`
 }
 
 func (m *Definition) Reset()         { *m = Definition{} }
@@ -117,8 +138,19 @@ type Token struct {
 	// burn_amount. This value will be burnt on top of the send amount.
 	BurnRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_rate"`
 	// send_commission_rate is a number between 0 and 1 which will be multiplied by send amount to determine
-	// amount sent to the token issuer account.
+	// amount sent to the token admin account.
 	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
+	// admin is the account allowed to execute the privileged operations on the token.
+	Admin string `protobuf:"bytes,11,opt,name=admin,proto3" json:"admin,omitempty"`
+	// uri is the URI of the token metadata.
+	URI string `protobuf:"bytes,12,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the hash of the metadata referenced by the URI.
+	URIHash string `protobuf:"bytes,13,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// max_supply is the maximum total supply of the token, zero means that the supply is not limited.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// commission_recipients are the accounts receiving the send commission proportionally to their weights.
+	// If empty, the send commission is sent to the admin.
+	CommissionRecipients []CommissionRecipient `protobuf:"bytes,15,rep,name=commission_recipients,json=commissionRecipients,proto3" json:"commission_recipients"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -154,48 +186,116 @@ func (m *Token) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Token proto.InternalMessageInfo
 
+// CommissionRecipient defines the account receiving the part of the send commission.
+type CommissionRecipient struct {
+	// address is the address of the account receiving the commission.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the part of the send commission received by the account relatively to the other recipients.
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *CommissionRecipient) Reset()         { *m = CommissionRecipient{} }
+func (m *CommissionRecipient) String() string { return proto.CompactTextString(m) }
+func (*CommissionRecipient) ProtoMessage()    {}
+func (*CommissionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{2}
+}
+func (m *CommissionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionRecipient.Merge(m, src)
+}
+func (m *CommissionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionRecipient proto.InternalMessageInfo
+
+func (m *CommissionRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CommissionRecipient) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("coreum.asset.ft.v1.Feature", Feature_name, Feature_value)
 	proto.RegisterType((*Definition)(nil), "coreum.asset.ft.v1.Definition")
 	proto.RegisterType((*Token)(nil), "coreum.asset.ft.v1.Token")
+	proto.RegisterType((*CommissionRecipient)(nil), "coreum.asset.ft.v1.CommissionRecipient")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/token.proto", fileDescriptor_fe80c7a2c55589e7) }
 
 var fileDescriptor_fe80c7a2c55589e7 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xb5, 0x63, 0x92, 0x38, 0xd3, 0x52, 0xaa, 0x51, 0x54, 0x59, 0x05, 0x39, 0x51, 0x17, 0x10,
-	0x21, 0xe1, 0x51, 0xe8, 0x02, 0x89, 0x65, 0x53, 0x65, 0x83, 0xd8, 0x58, 0xac, 0xd8, 0x04, 0x3f,
-	0xae, 0xdd, 0x51, 0xed, 0x99, 0x68, 0x66, 0x1c, 0x48, 0xbf, 0x80, 0x25, 0x9f, 0xd0, 0x0f, 0xe0,
-	0x2b, 0x58, 0x75, 0xd9, 0x25, 0x62, 0x51, 0xa1, 0x64, 0xc3, 0x67, 0xa0, 0x19, 0xbb, 0x0f, 0xc4,
-	0x8a, 0x88, 0xae, 0xec, 0x73, 0xee, 0xf5, 0xf1, 0xb9, 0xf7, 0xe8, 0x22, 0x3f, 0xe1, 0x02, 0xaa,
-	0x92, 0x44, 0x52, 0x82, 0x22, 0x99, 0x22, 0x8b, 0x31, 0x51, 0xfc, 0x14, 0x58, 0x30, 0x17, 0x5c,
-	0x71, 0x8c, 0xeb, 0x7a, 0x60, 0xea, 0x41, 0xa6, 0x82, 0xc5, 0x78, 0xbf, 0x9f, 0xf3, 0x9c, 0x9b,
-	0x32, 0xd1, 0x6f, 0x75, 0xe7, 0xbe, 0x9f, 0x70, 0x59, 0x72, 0x49, 0xe2, 0x48, 0x02, 0x59, 0x8c,
-	0x63, 0x50, 0xd1, 0x98, 0x24, 0x9c, 0x36, 0x4a, 0x07, 0x5f, 0x5b, 0x08, 0x1d, 0x43, 0x46, 0x19,
-	0x55, 0x94, 0x33, 0xdc, 0x47, 0xed, 0x14, 0x18, 0x2f, 0x3d, 0x7b, 0x68, 0x8f, 0x7a, 0x61, 0x0d,
-	0xf0, 0x1e, 0xea, 0x50, 0x29, 0x2b, 0x10, 0x5e, 0xcb, 0xd0, 0x0d, 0xc2, 0xaf, 0x90, 0x9b, 0x41,
-	0xa4, 0x2a, 0x01, 0xd2, 0x73, 0x86, 0xce, 0x68, 0xe7, 0xe5, 0xe3, 0xe0, 0x6f, 0x67, 0xc1, 0xb4,
-	0xee, 0x09, 0x6f, 0x9a, 0xf1, 0x1b, 0xd4, 0x8b, 0x2b, 0xc1, 0x66, 0x22, 0x52, 0xe0, 0x3d, 0xd0,
-	0x9a, 0x47, 0xc1, 0xc5, 0xd5, 0xc0, 0xfa, 0x71, 0x35, 0x78, 0x9a, 0x53, 0x75, 0x52, 0xc5, 0x41,
-	0xc2, 0x4b, 0xd2, 0x78, 0xaf, 0x1f, 0x2f, 0x64, 0x7a, 0x4a, 0xd4, 0x72, 0x0e, 0x32, 0x38, 0x86,
-	0x24, 0x74, 0xb5, 0x40, 0x18, 0x29, 0xc0, 0x1f, 0x50, 0x5f, 0x02, 0x4b, 0x67, 0x09, 0x2f, 0x4b,
-	0x2a, 0x25, 0xe5, 0x8d, 0x6e, 0x7b, 0x23, 0x5d, 0xac, 0xb5, 0x26, 0x37, 0x52, 0xfa, 0x0f, 0xaf,
-	0xdd, 0xcf, 0xe7, 0x03, 0xeb, 0xd7, 0xf9, 0xc0, 0x3a, 0xf8, 0xe6, 0xa0, 0xf6, 0x3b, 0x1d, 0xc4,
-	0x3f, 0x6e, 0x6a, 0x0f, 0x75, 0xe4, 0xb2, 0x8c, 0x79, 0xe1, 0x39, 0x35, 0x5f, 0x23, 0xec, 0xa1,
-	0xae, 0xac, 0xe2, 0x8a, 0x51, 0x55, 0xaf, 0x21, 0xbc, 0x86, 0xf8, 0x09, 0xea, 0xcd, 0x05, 0x24,
-	0x54, 0x9b, 0x30, 0xa3, 0x3c, 0x0c, 0x6f, 0x09, 0x3c, 0x44, 0x5b, 0x29, 0xc8, 0x44, 0xd0, 0xb9,
-	0x8e, 0xcd, 0xeb, 0x98, 0x6f, 0xef, 0x52, 0xf8, 0x19, 0x7a, 0x94, 0x17, 0x3c, 0x8e, 0x8a, 0x62,
-	0x39, 0xcb, 0x04, 0x3f, 0x03, 0xe6, 0x75, 0x87, 0xf6, 0xc8, 0x0d, 0x77, 0xae, 0xe9, 0xa9, 0x61,
-	0xff, 0x08, 0xd1, 0xdd, 0x38, 0xc4, 0xde, 0x3d, 0x85, 0x88, 0xfe, 0x7f, 0x88, 0xcf, 0x27, 0xa8,
-	0xdb, 0x4c, 0x83, 0xb7, 0x50, 0xb7, 0xa4, 0x4c, 0x51, 0x96, 0xef, 0x5a, 0x1a, 0x68, 0x3f, 0x1a,
-	0xd8, 0x78, 0x1b, 0xb9, 0x99, 0x00, 0x38, 0xd3, 0xa8, 0x85, 0x77, 0xd1, 0xf6, 0xc7, 0x13, 0xaa,
-	0xa0, 0xa0, 0xd2, 0x34, 0x3b, 0x47, 0x6f, 0x2f, 0x56, 0xbe, 0x7d, 0xb9, 0xf2, 0xed, 0x9f, 0x2b,
-	0xdf, 0xfe, 0xb2, 0xf6, 0xad, 0xcb, 0xb5, 0x6f, 0x7d, 0x5f, 0xfb, 0xd6, 0xfb, 0xc3, 0x3b, 0x26,
-	0x27, 0x66, 0x91, 0x53, 0x5e, 0xb1, 0x34, 0xd2, 0xb1, 0x90, 0xe6, 0xb0, 0x3f, 0xdd, 0x9e, 0xb6,
-	0x71, 0x1d, 0x77, 0xcc, 0x39, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xa0, 0x2e, 0x15,
-	0xfa, 0x03, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x4e, 0xdb, 0x4e,
+	0x10, 0xc7, 0x13, 0x9c, 0xc4, 0xce, 0x84, 0x3f, 0xd1, 0xfe, 0xf2, 0x43, 0x2e, 0xad, 0x9c, 0x88,
+	0x03, 0xa0, 0x4a, 0xb5, 0x15, 0x38, 0x54, 0xea, 0x11, 0x10, 0x2d, 0xaa, 0xb8, 0xb8, 0xe5, 0xd2,
+	0x4b, 0xba, 0xb6, 0x37, 0xc9, 0x0a, 0xdb, 0x6b, 0x79, 0xd7, 0x40, 0x78, 0x82, 0x1e, 0xfb, 0x08,
+	0x3c, 0x46, 0x1f, 0x81, 0x53, 0xc5, 0xb1, 0xea, 0x21, 0xaa, 0xc2, 0xa5, 0x8f, 0x51, 0xed, 0xda,
+	0x81, 0x54, 0x20, 0xb5, 0xa0, 0x72, 0xca, 0x7e, 0x67, 0x26, 0x5f, 0x8f, 0x67, 0x3e, 0xd6, 0x82,
+	0xe5, 0xb3, 0x94, 0x64, 0x91, 0x83, 0x39, 0x27, 0xc2, 0xe9, 0x0b, 0xe7, 0xb8, 0xeb, 0x08, 0x76,
+	0x44, 0x62, 0x3b, 0x49, 0x99, 0x60, 0x08, 0xe5, 0x79, 0x5b, 0xe5, 0xed, 0xbe, 0xb0, 0x8f, 0xbb,
+	0x2b, 0xad, 0x01, 0x1b, 0x30, 0x95, 0x76, 0xe4, 0x29, 0xaf, 0x5c, 0xb1, 0x7c, 0xc6, 0x23, 0xc6,
+	0x1d, 0x0f, 0x73, 0xe2, 0x1c, 0x77, 0x3d, 0x22, 0x70, 0xd7, 0xf1, 0x19, 0x2d, 0x9c, 0x56, 0xbf,
+	0x54, 0x00, 0x76, 0x49, 0x9f, 0xc6, 0x54, 0x50, 0x16, 0xa3, 0x16, 0x54, 0x03, 0x12, 0xb3, 0xc8,
+	0x2c, 0x77, 0xca, 0x1b, 0x75, 0x37, 0x17, 0x68, 0x19, 0x6a, 0x94, 0xf3, 0x8c, 0xa4, 0xe6, 0x9c,
+	0x0a, 0x17, 0x0a, 0xbd, 0x04, 0xa3, 0x4f, 0xb0, 0xc8, 0x52, 0xc2, 0x4d, 0xad, 0xa3, 0x6d, 0x2c,
+	0x6e, 0x3e, 0xb5, 0x6f, 0x77, 0x66, 0xef, 0xe5, 0x35, 0xee, 0x75, 0x31, 0x7a, 0x0b, 0x75, 0x2f,
+	0x4b, 0xe3, 0x5e, 0x8a, 0x05, 0x31, 0x2b, 0xd2, 0x73, 0xdb, 0xbe, 0x18, 0xb7, 0x4b, 0xdf, 0xc7,
+	0xed, 0xb5, 0x01, 0x15, 0xc3, 0xcc, 0xb3, 0x7d, 0x16, 0x39, 0x45, 0xef, 0xf9, 0xcf, 0x0b, 0x1e,
+	0x1c, 0x39, 0x62, 0x94, 0x10, 0x6e, 0xef, 0x12, 0xdf, 0x35, 0xa4, 0x81, 0x8b, 0x05, 0x41, 0x1f,
+	0xa1, 0xc5, 0x49, 0x1c, 0xf4, 0x7c, 0x16, 0x45, 0x94, 0x73, 0xca, 0x0a, 0xdf, 0xea, 0x83, 0x7c,
+	0x91, 0xf4, 0xda, 0xb9, 0xb6, 0x52, 0x4f, 0x68, 0x41, 0x15, 0x07, 0x11, 0x8d, 0xcd, 0x5a, 0x3e,
+	0x15, 0x25, 0xd0, 0x13, 0xd0, 0xb2, 0x94, 0x9a, 0xba, 0x7a, 0x8c, 0x3e, 0x19, 0xb7, 0xb5, 0x43,
+	0x77, 0xdf, 0x95, 0x31, 0xb4, 0x06, 0x46, 0x96, 0xd2, 0xde, 0x10, 0xf3, 0xa1, 0x69, 0xa8, 0x7c,
+	0x63, 0x32, 0x6e, 0xeb, 0x87, 0xee, 0xfe, 0x1b, 0xcc, 0x87, 0xae, 0x9e, 0xa5, 0x54, 0x1e, 0xd0,
+	0x01, 0x40, 0x84, 0x4f, 0x7b, 0x3c, 0x4b, 0x92, 0x70, 0x64, 0xd6, 0xef, 0xdd, 0xf0, 0x7e, 0x2c,
+	0xdc, 0x7a, 0x84, 0x4f, 0xdf, 0x29, 0x03, 0xe4, 0xc1, 0xff, 0xb3, 0x43, 0x20, 0x3e, 0x4d, 0x28,
+	0x89, 0x05, 0x37, 0xa1, 0xa3, 0x6d, 0x34, 0x36, 0xd7, 0xef, 0x5a, 0xce, 0xcc, 0xab, 0x4e, 0xeb,
+	0xb7, 0x2b, 0xb2, 0x05, 0xb7, 0xe5, 0xdf, 0x4e, 0xf1, 0x57, 0xc6, 0xa7, 0xf3, 0x76, 0xe9, 0xe7,
+	0x79, 0xbb, 0xb4, 0xfa, 0xb5, 0x0a, 0xd5, 0xf7, 0x12, 0xca, 0x7b, 0x52, 0xb3, 0x0c, 0x35, 0x3e,
+	0x8a, 0x3c, 0x16, 0x9a, 0x5a, 0x1e, 0xcf, 0x15, 0x32, 0x41, 0xe7, 0x99, 0x97, 0xc5, 0x54, 0xe4,
+	0x48, 0xb8, 0x53, 0x89, 0x9e, 0x41, 0x3d, 0x91, 0x6f, 0x23, 0x5b, 0x51, 0x6b, 0x5d, 0x70, 0x6f,
+	0x02, 0xa8, 0x03, 0x8d, 0x80, 0x70, 0x3f, 0xa5, 0x89, 0x44, 0xb8, 0xd8, 0xd1, 0x6c, 0x08, 0xad,
+	0xc3, 0xd2, 0x20, 0x64, 0x1e, 0x0e, 0xc3, 0x51, 0xaf, 0x9f, 0xb2, 0x33, 0x12, 0xab, 0xad, 0x19,
+	0xee, 0xe2, 0x34, 0xbc, 0xa7, 0xa2, 0xbf, 0x01, 0x6d, 0x3c, 0x18, 0xe8, 0xfa, 0x23, 0x01, 0x0d,
+	0xff, 0x1e, 0xe8, 0xc6, 0x1d, 0x40, 0xcf, 0xff, 0x01, 0xe8, 0x85, 0xbf, 0x06, 0x7a, 0xf1, 0xd1,
+	0x80, 0x5e, 0x7a, 0x0c, 0xa0, 0x5f, 0xc3, 0x7f, 0x77, 0xfc, 0x59, 0x72, 0x89, 0x83, 0x20, 0x25,
+	0x9c, 0x17, 0x7c, 0x4f, 0xa5, 0x24, 0xf9, 0x84, 0xd0, 0xc1, 0x50, 0x28, 0xc2, 0x17, 0xdc, 0x42,
+	0x3d, 0x4f, 0x41, 0x2f, 0x10, 0x41, 0x0d, 0xd0, 0x23, 0x1a, 0x0b, 0x1a, 0x0f, 0x9a, 0x25, 0x29,
+	0xe4, 0x92, 0xa5, 0x28, 0xa3, 0x79, 0x30, 0xfa, 0x29, 0x21, 0x67, 0x52, 0xcd, 0xa1, 0x26, 0xcc,
+	0x9f, 0x0c, 0xa9, 0x20, 0x21, 0xe5, 0xaa, 0x58, 0x93, 0x79, 0x3f, 0xc4, 0x27, 0x1e, 0xf6, 0x8f,
+	0x9a, 0x15, 0xb4, 0x0c, 0x28, 0x4b, 0x02, 0x2c, 0xb0, 0x17, 0x92, 0x5e, 0x44, 0x04, 0x96, 0xe7,
+	0x66, 0x15, 0xe9, 0xa0, 0x51, 0xcf, 0x6f, 0xd6, 0xb6, 0x0f, 0x2e, 0x26, 0x56, 0xf9, 0x72, 0x62,
+	0x95, 0x7f, 0x4c, 0xac, 0xf2, 0xe7, 0x2b, 0xab, 0x74, 0x79, 0x65, 0x95, 0xbe, 0x5d, 0x59, 0xa5,
+	0x0f, 0x5b, 0x33, 0x73, 0xdf, 0x51, 0xf3, 0xda, 0x63, 0x59, 0x1c, 0x60, 0xf9, 0x69, 0x38, 0xc5,
+	0x45, 0x73, 0x7a, 0x73, 0xd5, 0xa8, 0x45, 0x78, 0x35, 0x75, 0x3d, 0x6c, 0xfd, 0x1a, 0x00, 0x7b,
+	0xb3, 0xa4, 0xf2, 0x8a, 0x06, 0x00, 0x00,
 }
 
 func (m *Definition) Marshal() (dAtA []byte, err error) {
@@ -218,6 +318,51 @@ func (m *Definition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionRecipients) > 0 {
+		for iNdEx := len(m.CommissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size := m.SendCommissionRate.Size()
 		i -= size
@@ -293,6 +438,51 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionRecipients) > 0 {
+		for iNdEx := len(m.CommissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
 		size := m.SendCommissionRate.Size()
 		i -= size
@@ -384,6 +574,41 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovToken(v)
 	base := offset
@@ -420,6 +645,26 @@ func (m *Definition) Size() (n int) {
 	n += 1 + l + sovToken(uint64(l))
 	l = m.SendCommissionRate.Size()
 	n += 1 + l + sovToken(uint64(l))
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if len(m.CommissionRecipients) > 0 {
+		for _, e := range m.CommissionRecipients {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -466,6 +711,42 @@ func (m *Token) Size() (n int) {
 	n += 1 + l + sovToken(uint64(l))
 	l = m.SendCommissionRate.Size()
 	n += 1 + l + sovToken(uint64(l))
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if len(m.CommissionRecipients) > 0 {
+		for _, e := range m.CommissionRecipients {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovToken(uint64(m.Weight))
+	}
 	return n
 }
 
@@ -705,6 +986,170 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionRecipients = append(m.CommissionRecipients, CommissionRecipient{})
+			if err := m.CommissionRecipients[len(m.CommissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -1091,6 +1536,271 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionRecipients = append(m.CommissionRecipients, CommissionRecipient{})
+			if err := m.CommissionRecipients[len(m.CommissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
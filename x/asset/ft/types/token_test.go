@@ -346,6 +346,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 		Features           []types.Feature
 		BurnRate           sdk.Dec
 		SendCommissionRate sdk.Dec
+		Admin              string
 	}
 	type args struct {
 		addr    sdk.AccAddress
@@ -361,6 +362,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_enabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_minting,
 				},
@@ -375,6 +377,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "burning_feature_always_enabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_burning,
 				},
@@ -389,6 +392,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "burning_feature_enabled_for_non_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 			},
 			args: args{
 				addr:    issuer,
@@ -400,6 +404,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_disabled_for_non_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_minting,
 				},
@@ -419,6 +424,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_disabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 			},
 			args: args{
 				addr:    issuer,
@@ -431,6 +437,25 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 				t.FailNow()
 			},
 		},
+		{
+			name: "minting_feature_disabled_for_issuer_without_admin",
+			fields: fields{
+				Issuer: issuer.String(),
+				Features: []types.Feature{
+					types.Feature_minting,
+				},
+			},
+			args: args{
+				addr:    issuer,
+				feature: types.Feature_minting,
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				if assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized) {
+					return
+				}
+				t.FailNow()
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -441,8 +466,52 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 				Features:           tt.fields.Features,
 				BurnRate:           tt.fields.BurnRate,
 				SendCommissionRate: tt.fields.SendCommissionRate,
+				Admin:              tt.fields.Admin,
 			}
 			tt.wantErr(t, def.CheckFeatureAllowed(tt.args.addr, tt.args.feature), fmt.Sprintf("CheckFeatureAllowed(%v, %v)", tt.args.addr, tt.args.feature))
 		})
 	}
 }
+
+func TestValidateMaxSupply(t *testing.T) {
+	assertT := assert.New(t)
+
+	assertT.NoError(types.ValidateMaxSupply(sdk.Int{}, sdk.NewInt(100)))
+	assertT.NoError(types.ValidateMaxSupply(sdk.ZeroInt(), sdk.NewInt(100)))
+	assertT.NoError(types.ValidateMaxSupply(sdk.NewInt(100), sdk.NewInt(100)))
+	assertT.NoError(types.ValidateMaxSupply(sdk.NewInt(100), sdk.Int{}))
+	assertT.ErrorIs(types.ValidateMaxSupply(sdk.NewInt(-1), sdk.ZeroInt()), types.ErrInvalidInput)
+	assertT.ErrorIs(types.ValidateMaxSupply(sdk.NewInt(99), sdk.NewInt(100)), types.ErrInvalidInput)
+}
+
+func TestValidateCommissionRecipients(t *testing.T) {
+	assertT := assert.New(t)
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	assertT.NoError(types.ValidateCommissionRecipients(nil))
+	assertT.NoError(types.ValidateCommissionRecipients([]types.CommissionRecipient{
+		{Address: addr1, Weight: 3},
+		{Address: addr2, Weight: 1},
+	}))
+	assertT.ErrorIs(types.ValidateCommissionRecipients([]types.CommissionRecipient{
+		{Address: addr1 + "x", Weight: 1},
+	}), sdkerrors.ErrInvalidAddress)
+	assertT.ErrorIs(types.ValidateCommissionRecipients([]types.CommissionRecipient{
+		{Address: addr1, Weight: 0},
+	}), types.ErrInvalidInput)
+	assertT.ErrorIs(types.ValidateCommissionRecipients([]types.CommissionRecipient{
+		{Address: addr1, Weight: 1},
+		{Address: addr1, Weight: 2},
+	}), types.ErrInvalidInput)
+
+	tooMany := make([]types.CommissionRecipient, 0, types.MaxCommissionRecipients+1)
+	for i := 0; i <= types.MaxCommissionRecipients; i++ {
+		tooMany = append(tooMany, types.CommissionRecipient{
+			Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Weight:  1,
+		})
+	}
+	assertT.ErrorIs(types.ValidateCommissionRecipients(tooMany), types.ErrInvalidInput)
+}
@@ -47,6 +47,12 @@ type MsgIssue struct {
 	// send_commission_rate is a number between 0 and 1 which will be multiplied by send amount to determine
 	// amount sent to the token issuer account.
 	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
+	// uri is the URI of the token metadata.
+	URI string `protobuf:"bytes,10,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the hash of the metadata referenced by the URI.
+	URIHash string `protobuf:"bytes,11,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// max_supply is the maximum total supply of the token, zero means that the supply is not limited.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
 }
 
 func (m *MsgIssue) Reset()         { *m = MsgIssue{} }
@@ -351,6 +357,395 @@ func (m *MsgSetWhitelistedLimit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetWhitelistedLimit proto.InternalMessageInfo
 
+// MsgClawback defines message for the Clawback method.
+type MsgClawback struct {
+	// sender is the admin of the token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account is the account the tokens are clawed back from.
+	Account string     `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Coin    types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{8}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+// MsgUpdateMetadata defines message for the UpdateMetadata method.
+type MsgUpdateMetadata struct {
+	// sender is the admin of the token.
+	Sender      string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// uri is the URI of the token metadata.
+	URI string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the hash of the metadata referenced by the URI.
+	URIHash string `protobuf:"bytes,5,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+}
+
+func (m *MsgUpdateMetadata) Reset()         { *m = MsgUpdateMetadata{} }
+func (m *MsgUpdateMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMetadata) ProtoMessage()    {}
+func (*MsgUpdateMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{9}
+}
+func (m *MsgUpdateMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMetadata.Merge(m, src)
+}
+func (m *MsgUpdateMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMetadata proto.InternalMessageInfo
+
+// MsgTransferAdmin defines message for the TransferAdmin method.
+type MsgTransferAdmin struct {
+	// sender is the current admin of the token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account is the new admin of the token.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgTransferAdmin) Reset()         { *m = MsgTransferAdmin{} }
+func (m *MsgTransferAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAdmin) ProtoMessage()    {}
+func (*MsgTransferAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{10}
+}
+func (m *MsgTransferAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAdmin.Merge(m, src)
+}
+func (m *MsgTransferAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferAdmin proto.InternalMessageInfo
+
+// MsgClearAdmin defines message for the ClearAdmin method.
+type MsgClearAdmin struct {
+	// sender is the current admin of the token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgClearAdmin) Reset()         { *m = MsgClearAdmin{} }
+func (m *MsgClearAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgClearAdmin) ProtoMessage()    {}
+func (*MsgClearAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{11}
+}
+func (m *MsgClearAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClearAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearAdmin.Merge(m, src)
+}
+func (m *MsgClearAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClearAdmin proto.InternalMessageInfo
+
+// MsgCreateVestingSchedule defines message for the CreateVestingSchedule method.
+type MsgCreateVestingSchedule struct {
+	// sender is the admin of the token sending the locked tokens.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account is the account receiving the locked tokens.
+	Account string     `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Coin    types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	// start_time is the unix time in seconds when the linear release starts.
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cliff_time is the unix time in seconds before which nothing is released.
+	CliffTime int64 `protobuf:"varint,5,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+	// end_time is the unix time in seconds when the whole amount is released.
+	EndTime int64 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *MsgCreateVestingSchedule) Reset()         { *m = MsgCreateVestingSchedule{} }
+func (m *MsgCreateVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingSchedule) ProtoMessage()    {}
+func (*MsgCreateVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{12}
+}
+func (m *MsgCreateVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingSchedule.Merge(m, src)
+}
+func (m *MsgCreateVestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateVestingSchedule proto.InternalMessageInfo
+
+// MsgGrantMintAllowance defines message for the GrantMintAllowance method.
+type MsgGrantMintAllowance struct {
+	// sender is the admin of the token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// minter is the account allowed to mint the tokens.
+	Minter string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	// coin is the amount the minter is allowed to mint.
+	Coin types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *MsgGrantMintAllowance) Reset()         { *m = MsgGrantMintAllowance{} }
+func (m *MsgGrantMintAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantMintAllowance) ProtoMessage()    {}
+func (*MsgGrantMintAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{13}
+}
+func (m *MsgGrantMintAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantMintAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantMintAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantMintAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantMintAllowance.Merge(m, src)
+}
+func (m *MsgGrantMintAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantMintAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantMintAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantMintAllowance proto.InternalMessageInfo
+
+// MsgRevokeMintAllowance defines message for the RevokeMintAllowance method.
+type MsgRevokeMintAllowance struct {
+	// sender is the admin of the token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// minter is the account the mint allowance is revoked from.
+	Minter string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRevokeMintAllowance) Reset()         { *m = MsgRevokeMintAllowance{} }
+func (m *MsgRevokeMintAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeMintAllowance) ProtoMessage()    {}
+func (*MsgRevokeMintAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{14}
+}
+func (m *MsgRevokeMintAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeMintAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeMintAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeMintAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeMintAllowance.Merge(m, src)
+}
+func (m *MsgRevokeMintAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeMintAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeMintAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeMintAllowance proto.InternalMessageInfo
+
+// MsgSetRateExemption defines message for the SetRateExemption method.
+type MsgSetRateExemption struct {
+	// sender is the admin of the token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account is the account added to or removed from the exemption list.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exempt defines whether the account doesn't pay the burn rate and the send commission.
+	Exempt bool `protobuf:"varint,4,opt,name=exempt,proto3" json:"exempt,omitempty"`
+}
+
+func (m *MsgSetRateExemption) Reset()         { *m = MsgSetRateExemption{} }
+func (m *MsgSetRateExemption) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateExemption) ProtoMessage()    {}
+func (*MsgSetRateExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{15}
+}
+func (m *MsgSetRateExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRateExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateExemption.Merge(m, src)
+}
+func (m *MsgSetRateExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateExemption proto.InternalMessageInfo
+
+// MsgUpdateCommissionRecipients defines message for the UpdateCommissionRecipients method.
+type MsgUpdateCommissionRecipients struct {
+	// sender is the admin of the token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// recipients are the accounts receiving the send commission, empty list means that the commission is
+	// sent to the admin.
+	Recipients []CommissionRecipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *MsgUpdateCommissionRecipients) Reset()         { *m = MsgUpdateCommissionRecipients{} }
+func (m *MsgUpdateCommissionRecipients) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCommissionRecipients) ProtoMessage()    {}
+func (*MsgUpdateCommissionRecipients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{16}
+}
+func (m *MsgUpdateCommissionRecipients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCommissionRecipients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCommissionRecipients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCommissionRecipients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCommissionRecipients.Merge(m, src)
+}
+func (m *MsgUpdateCommissionRecipients) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCommissionRecipients) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCommissionRecipients.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCommissionRecipients proto.InternalMessageInfo
+
 type EmptyResponse struct {
 }
 
@@ -358,7 +753,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e54b0962ccfc4ca0, []int{8}
+	return fileDescriptor_e54b0962ccfc4ca0, []int{17}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,58 +791,95 @@ func init() {
 	proto.RegisterType((*MsgGloballyFreeze)(nil), "coreum.asset.ft.v1.MsgGloballyFreeze")
 	proto.RegisterType((*MsgGloballyUnfreeze)(nil), "coreum.asset.ft.v1.MsgGloballyUnfreeze")
 	proto.RegisterType((*MsgSetWhitelistedLimit)(nil), "coreum.asset.ft.v1.MsgSetWhitelistedLimit")
+	proto.RegisterType((*MsgClawback)(nil), "coreum.asset.ft.v1.MsgClawback")
+	proto.RegisterType((*MsgUpdateMetadata)(nil), "coreum.asset.ft.v1.MsgUpdateMetadata")
+	proto.RegisterType((*MsgTransferAdmin)(nil), "coreum.asset.ft.v1.MsgTransferAdmin")
+	proto.RegisterType((*MsgClearAdmin)(nil), "coreum.asset.ft.v1.MsgClearAdmin")
+	proto.RegisterType((*MsgCreateVestingSchedule)(nil), "coreum.asset.ft.v1.MsgCreateVestingSchedule")
+	proto.RegisterType((*MsgGrantMintAllowance)(nil), "coreum.asset.ft.v1.MsgGrantMintAllowance")
+	proto.RegisterType((*MsgRevokeMintAllowance)(nil), "coreum.asset.ft.v1.MsgRevokeMintAllowance")
+	proto.RegisterType((*MsgSetRateExemption)(nil), "coreum.asset.ft.v1.MsgSetRateExemption")
+	proto.RegisterType((*MsgUpdateCommissionRecipients)(nil), "coreum.asset.ft.v1.MsgUpdateCommissionRecipients")
 	proto.RegisterType((*EmptyResponse)(nil), "coreum.asset.ft.v1.EmptyResponse")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/tx.proto", fileDescriptor_e54b0962ccfc4ca0) }
 
 var fileDescriptor_e54b0962ccfc4ca0 = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc7, 0x93, 0x9b, 0xef, 0xa9, 0xda, 0x7b, 0xaf, 0x5b, 0x15, 0xf7, 0x03, 0x37, 0x54, 0x02,
-	0x2a, 0x24, 0x6c, 0xa5, 0x5d, 0xb0, 0x6e, 0x02, 0x81, 0x02, 0x46, 0xc2, 0xa8, 0x20, 0x75, 0x41,
-	0xf1, 0xc7, 0x64, 0x3a, 0x6a, 0x3c, 0x13, 0xf9, 0x8c, 0xab, 0x86, 0x0d, 0xaf, 0xc0, 0x0b, 0xf0,
-	0x3e, 0x5d, 0x56, 0x62, 0x83, 0x58, 0x54, 0xd0, 0xbe, 0x08, 0x9a, 0xb1, 0xd3, 0xb4, 0x34, 0x56,
-	0xd2, 0x2e, 0xba, 0xb2, 0xcf, 0xfc, 0xcf, 0xfc, 0xce, 0x8c, 0xcf, 0xdf, 0x33, 0x68, 0xc9, 0xe7,
-	0x11, 0x8e, 0x43, 0xcb, 0x05, 0xc0, 0xc2, 0xea, 0x08, 0xeb, 0xa0, 0x61, 0x89, 0x43, 0xb3, 0x17,
-	0x71, 0xc1, 0x35, 0x2d, 0x11, 0x4d, 0x25, 0x9a, 0x1d, 0x61, 0x1e, 0x34, 0x16, 0xe7, 0x08, 0x27,
-	0x5c, 0xc9, 0x96, 0x7c, 0x4b, 0x32, 0x17, 0x17, 0x08, 0xe7, 0xa4, 0x8b, 0x2d, 0x15, 0x79, 0x71,
-	0xc7, 0x72, 0x59, 0x3f, 0x95, 0x0c, 0x9f, 0x43, 0xc8, 0xc1, 0xf2, 0x5c, 0xc0, 0xd6, 0x41, 0xc3,
-	0xc3, 0xc2, 0x6d, 0x58, 0x3e, 0xa7, 0x2c, 0xd5, 0xef, 0xa4, 0x7a, 0x08, 0x44, 0x16, 0x0f, 0x81,
-	0x0c, 0x27, 0x5e, 0x5d, 0x1a, 0xdf, 0xc7, 0xe9, 0xc4, 0xd5, 0xef, 0x05, 0x54, 0xb5, 0x81, 0x6c,
-	0x01, 0xc4, 0x58, 0x9b, 0x47, 0x65, 0x2a, 0x5f, 0x22, 0x3d, 0x5f, 0xcf, 0xaf, 0xd5, 0x9c, 0x34,
-	0x92, 0xe3, 0xd0, 0x0f, 0x3d, 0xde, 0xd5, 0xff, 0x49, 0xc6, 0x93, 0x48, 0xd3, 0x51, 0x05, 0x62,
-	0x2f, 0x66, 0x54, 0xe8, 0x05, 0x25, 0x0c, 0x42, 0x6d, 0x19, 0xd5, 0x7a, 0x11, 0xf6, 0x29, 0x50,
-	0xce, 0xf4, 0x62, 0x3d, 0xbf, 0x36, 0xed, 0x0c, 0x07, 0xb4, 0x6d, 0x34, 0x43, 0x19, 0x15, 0xd4,
-	0xed, 0xee, 0xba, 0x21, 0x8f, 0x99, 0xd0, 0x4b, 0x72, 0x7a, 0xd3, 0x3c, 0x3a, 0x59, 0xc9, 0xfd,
-	0x3c, 0x59, 0x79, 0x40, 0xa8, 0xd8, 0x8b, 0x3d, 0xd3, 0xe7, 0xa1, 0x95, 0x6e, 0x2c, 0x79, 0x3c,
-	0x86, 0x60, 0xdf, 0x12, 0xfd, 0x1e, 0x06, 0x73, 0x8b, 0x09, 0x67, 0x3a, 0xa5, 0x6c, 0x2a, 0x88,
-	0x56, 0x47, 0x53, 0x01, 0x06, 0x3f, 0xa2, 0x3d, 0x21, 0xcb, 0x96, 0xd5, 0x92, 0x2e, 0x0e, 0x69,
-	0x4f, 0x50, 0xb5, 0x83, 0x5d, 0x11, 0x47, 0x18, 0xf4, 0x4a, 0xbd, 0xb0, 0x36, 0xb3, 0xbe, 0x64,
-	0x5e, 0x6d, 0x8f, 0xd9, 0x4e, 0x72, 0x9c, 0xf3, 0x64, 0xed, 0x15, 0xaa, 0x79, 0x71, 0xc4, 0x76,
-	0x23, 0x57, 0x60, 0xbd, 0x7a, 0xed, 0xc5, 0x3e, 0xc5, 0xbe, 0x53, 0x95, 0x00, 0xc7, 0x15, 0x58,
-	0xfb, 0x84, 0xe6, 0x00, 0xb3, 0x60, 0xd7, 0xe7, 0x61, 0x48, 0x41, 0x7e, 0x91, 0x84, 0x5b, 0xbb,
-	0x11, 0x57, 0x93, 0xac, 0xd6, 0x39, 0x4a, 0x56, 0x58, 0x7d, 0x8f, 0x2a, 0x36, 0x10, 0x9b, 0x32,
-	0xa1, 0x7a, 0x87, 0x59, 0x30, 0xec, 0x69, 0x12, 0x69, 0x1b, 0xa8, 0x28, 0xfd, 0xa3, 0x3a, 0x3a,
-	0xb5, 0xbe, 0x60, 0x26, 0x6c, 0x53, 0x1a, 0xcc, 0x4c, 0x0d, 0x66, 0xb6, 0x38, 0x65, 0xcd, 0xa2,
-	0x5c, 0x8f, 0xa3, 0x92, 0x53, 0x6e, 0x33, 0x8e, 0xd8, 0x58, 0x6e, 0xe1, 0x3a, 0xdc, 0x08, 0xd5,
-	0x6c, 0x20, 0xed, 0x08, 0xe3, 0xcf, 0x38, 0x93, 0xac, 0xa3, 0x8a, 0xeb, 0xfb, 0xca, 0x2e, 0x89,
-	0x0d, 0x07, 0xe1, 0xcd, 0x6a, 0x0a, 0x34, 0x65, 0x03, 0xd9, 0x66, 0x9d, 0x5b, 0xad, 0xba, 0x89,
-	0xfe, 0xb7, 0x81, 0x3c, 0xef, 0x72, 0xcf, 0xed, 0x76, 0xfb, 0x63, 0x76, 0x3c, 0x87, 0x4a, 0x01,
-	0x66, 0x3c, 0x4c, 0x2b, 0x27, 0xc1, 0x6a, 0x0b, 0xcd, 0x5e, 0x40, 0x8c, 0xdd, 0xc0, 0x68, 0xc8,
-	0x17, 0x34, 0x6f, 0x03, 0x79, 0x87, 0xc5, 0x87, 0x3d, 0x2a, 0x70, 0x97, 0x82, 0xc0, 0xc1, 0x6b,
-	0x1a, 0x52, 0x71, 0x5b, 0x1f, 0xe2, 0x5f, 0x34, 0xfd, 0x2c, 0xec, 0x89, 0xbe, 0x83, 0xa1, 0xc7,
-	0x19, 0xe0, 0xf5, 0x6f, 0x25, 0x54, 0xb0, 0x81, 0x68, 0x2f, 0x50, 0x29, 0x39, 0x8d, 0x96, 0x47,
-	0xfd, 0x9a, 0x83, 0xb3, 0x6a, 0xf1, 0xde, 0x28, 0xf5, 0x12, 0x51, 0x6b, 0xa3, 0xa2, 0xfa, 0x05,
-	0x96, 0x32, 0x40, 0x52, 0x9c, 0x90, 0xa3, 0x2c, 0x9f, 0xc5, 0x91, 0xe2, 0x24, 0x9c, 0x97, 0xa8,
-	0x9c, 0x36, 0xfc, 0x6e, 0x06, 0x29, 0x91, 0x27, 0x61, 0xbd, 0x41, 0xd5, 0xf3, 0xce, 0xaf, 0x64,
-	0xd0, 0x06, 0x09, 0x93, 0xf0, 0x76, 0xd0, 0xcc, 0x5f, 0xa6, 0xbc, 0x9f, 0x41, 0xbd, 0x9c, 0x36,
-	0x09, 0xfb, 0x23, 0xfa, 0xef, 0x8a, 0x5b, 0x1f, 0x8e, 0xa1, 0x5f, 0x67, 0xed, 0x01, 0x9a, 0x1d,
-	0x65, 0xe4, 0x47, 0x19, 0x25, 0x46, 0xe4, 0x4e, 0x50, 0xa5, 0xf9, 0xf6, 0xe8, 0xb7, 0x91, 0x3b,
-	0x3a, 0x35, 0xf2, 0xc7, 0xa7, 0x46, 0xfe, 0xd7, 0xa9, 0x91, 0xff, 0x7a, 0x66, 0xe4, 0x8e, 0xcf,
-	0x8c, 0xdc, 0x8f, 0x33, 0x23, 0xb7, 0xb3, 0x71, 0xe1, 0xb4, 0x6e, 0x29, 0x54, 0x9b, 0xc7, 0x2c,
-	0x70, 0xe5, 0xb5, 0x63, 0xa5, 0x77, 0xf0, 0xe1, 0xf0, 0x16, 0x56, 0xc7, 0xb7, 0x57, 0x56, 0x77,
-	0xf0, 0xc6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0xcf, 0x5f, 0xe5, 0x40, 0x08, 0x00, 0x00,
+	// 1158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0xdf, 0x72, 0xe3, 0xb4,
+	0x17, 0xc7, 0x9b, 0x5f, 0xd2, 0xfc, 0x39, 0xf9, 0x75, 0x59, 0xbc, 0x7f, 0x70, 0xdb, 0x6d, 0xda,
+	0xcd, 0xc0, 0xb6, 0x30, 0x60, 0x4f, 0xdb, 0x0b, 0xae, 0xb8, 0x68, 0xcb, 0x96, 0x2d, 0x60, 0x66,
+	0x70, 0xb7, 0x0b, 0xd3, 0x8b, 0x0d, 0x8a, 0xad, 0x38, 0x9a, 0xda, 0x52, 0xc6, 0x92, 0xbb, 0x0d,
+	0xc3, 0x0c, 0xaf, 0xc0, 0x03, 0x70, 0xcd, 0xb3, 0xf4, 0x72, 0x2f, 0x99, 0xbd, 0xe8, 0x40, 0x3b,
+	0x3c, 0x07, 0x8c, 0x64, 0x27, 0x4d, 0x1a, 0x7b, 0xe3, 0x16, 0xa6, 0x57, 0xb1, 0x74, 0x8e, 0x3e,
+	0x47, 0x3a, 0x3a, 0xd2, 0xd7, 0x31, 0x2c, 0x3a, 0x2c, 0xc4, 0x51, 0x60, 0x22, 0xce, 0xb1, 0x30,
+	0x3b, 0xc2, 0x3c, 0x5e, 0x37, 0xc5, 0x89, 0xd1, 0x0b, 0x99, 0x60, 0x9a, 0x16, 0x1b, 0x0d, 0x65,
+	0x34, 0x3a, 0xc2, 0x38, 0x5e, 0x5f, 0xb8, 0xef, 0x31, 0x8f, 0x29, 0xb3, 0x29, 0x9f, 0x62, 0xcf,
+	0x85, 0x79, 0x8f, 0x31, 0xcf, 0xc7, 0xa6, 0x6a, 0xb5, 0xa3, 0x8e, 0x89, 0x68, 0x3f, 0x31, 0x35,
+	0x1c, 0xc6, 0x03, 0xc6, 0xcd, 0x36, 0xe2, 0xd8, 0x3c, 0x5e, 0x6f, 0x63, 0x81, 0xd6, 0x4d, 0x87,
+	0x11, 0x9a, 0xd8, 0xdf, 0x4b, 0xec, 0x01, 0xf7, 0x64, 0xf0, 0x80, 0x7b, 0x97, 0x03, 0x27, 0xa7,
+	0xc6, 0x8e, 0x70, 0x32, 0xb0, 0xf9, 0x57, 0x09, 0xaa, 0x16, 0xf7, 0xf6, 0x38, 0x8f, 0xb0, 0xf6,
+	0x10, 0xca, 0x44, 0x3e, 0x84, 0x7a, 0x61, 0xa5, 0xb0, 0x56, 0xb3, 0x93, 0x96, 0xec, 0xe7, 0xfd,
+	0xa0, 0xcd, 0x7c, 0xfd, 0x7f, 0x71, 0x7f, 0xdc, 0xd2, 0x74, 0xa8, 0xf0, 0xa8, 0x1d, 0x51, 0x22,
+	0xf4, 0xa2, 0x32, 0x0c, 0x9a, 0xda, 0x23, 0xa8, 0xf5, 0x42, 0xec, 0x10, 0x4e, 0x18, 0xd5, 0x4b,
+	0x2b, 0x85, 0xb5, 0x39, 0xfb, 0xb2, 0x43, 0x3b, 0x80, 0x3b, 0x84, 0x12, 0x41, 0x90, 0xdf, 0x42,
+	0x01, 0x8b, 0xa8, 0xd0, 0x67, 0xe5, 0xf0, 0x6d, 0xe3, 0xf4, 0x6c, 0x79, 0xe6, 0xcd, 0xd9, 0xf2,
+	0x13, 0x8f, 0x88, 0x6e, 0xd4, 0x36, 0x1c, 0x16, 0x98, 0xc9, 0xc2, 0xe2, 0x9f, 0x4f, 0xb8, 0x7b,
+	0x64, 0x8a, 0x7e, 0x0f, 0x73, 0x63, 0x8f, 0x0a, 0x7b, 0x2e, 0xa1, 0x6c, 0x29, 0x88, 0xb6, 0x02,
+	0x75, 0x17, 0x73, 0x27, 0x24, 0x3d, 0x21, 0xc3, 0x96, 0xd5, 0x94, 0x46, 0xbb, 0xb4, 0x4f, 0xa1,
+	0xda, 0xc1, 0x48, 0x44, 0x21, 0xe6, 0x7a, 0x65, 0xa5, 0xb8, 0x76, 0x67, 0x63, 0xd1, 0x98, 0xdc,
+	0x1e, 0x63, 0x37, 0xf6, 0xb1, 0x87, 0xce, 0xda, 0x57, 0x50, 0x6b, 0x47, 0x21, 0x6d, 0x85, 0x48,
+	0x60, 0xbd, 0x7a, 0xed, 0xc9, 0x7e, 0x8e, 0x1d, 0xbb, 0x2a, 0x01, 0x36, 0x12, 0x58, 0xfb, 0x01,
+	0xee, 0x73, 0x4c, 0xdd, 0x96, 0xc3, 0x82, 0x80, 0x70, 0x99, 0x91, 0x98, 0x5b, 0xbb, 0x11, 0x57,
+	0x93, 0xac, 0x9d, 0x21, 0x4a, 0x45, 0x98, 0x87, 0x62, 0x14, 0x12, 0x1d, 0x14, 0xb0, 0x72, 0x7e,
+	0xb6, 0x5c, 0x3c, 0xb0, 0xf7, 0x6c, 0xd9, 0xa7, 0x3d, 0x81, 0x6a, 0x14, 0x92, 0x56, 0x17, 0xf1,
+	0xae, 0x5e, 0x57, 0xf6, 0xfa, 0xf9, 0xd9, 0x72, 0xe5, 0xc0, 0xde, 0x7b, 0x86, 0x78, 0xd7, 0xae,
+	0x44, 0x21, 0x91, 0x0f, 0x9a, 0x05, 0x10, 0xa0, 0x93, 0x16, 0x8f, 0x7a, 0x3d, 0xbf, 0xaf, 0xff,
+	0xff, 0x46, 0xfb, 0x53, 0x0b, 0xd0, 0xc9, 0xbe, 0x02, 0x34, 0x5f, 0x40, 0xc5, 0xe2, 0x9e, 0x45,
+	0xa8, 0x50, 0xd5, 0x84, 0xa9, 0x7b, 0x59, 0x65, 0x71, 0x4b, 0xdb, 0x84, 0x92, 0xac, 0x68, 0x55,
+	0x63, 0xf5, 0x8d, 0x79, 0x23, 0x46, 0x1a, 0xb2, 0xe4, 0x8d, 0xa4, 0xe4, 0x8d, 0x1d, 0x46, 0xe8,
+	0x76, 0x49, 0x4e, 0xc3, 0x56, 0xce, 0x09, 0x77, 0x3b, 0x0a, 0xe9, 0x54, 0x6e, 0xf1, 0x3a, 0xdc,
+	0x10, 0x6a, 0x16, 0xf7, 0x76, 0x43, 0x8c, 0x7f, 0xc4, 0x99, 0x64, 0x1d, 0x2a, 0xc8, 0x71, 0x54,
+	0x01, 0xc7, 0x07, 0x63, 0xd0, 0xbc, 0x59, 0x4c, 0x01, 0x75, 0x8b, 0x7b, 0x07, 0xb4, 0x73, 0xab,
+	0x51, 0xb7, 0xe0, 0x5d, 0x8b, 0x7b, 0x5f, 0xf8, 0xac, 0x8d, 0x7c, 0xbf, 0x3f, 0x65, 0xc5, 0xf7,
+	0x61, 0xd6, 0xc5, 0x94, 0x05, 0x49, 0xe4, 0xb8, 0xd1, 0xdc, 0x81, 0x7b, 0x23, 0x88, 0xa9, 0x0b,
+	0x48, 0x87, 0xfc, 0x0c, 0x0f, 0x2d, 0xee, 0xed, 0x63, 0xf1, 0x5d, 0x97, 0x08, 0xec, 0x13, 0x2e,
+	0xb0, 0xfb, 0x35, 0x09, 0x88, 0xb8, 0xdd, 0xf4, 0xef, 0xf8, 0xe8, 0x55, 0x1b, 0x39, 0x47, 0xb7,
+	0x15, 0xf5, 0xb7, 0x82, 0xca, 0xff, 0x41, 0xcf, 0x45, 0x02, 0x5b, 0x58, 0x20, 0x17, 0x09, 0x74,
+	0xbd, 0xd4, 0x5d, 0xbd, 0xf8, 0x8a, 0x93, 0x17, 0x5f, 0x72, 0x21, 0x94, 0xa6, 0x5c, 0x08, 0xb3,
+	0xd9, 0x17, 0x42, 0xf3, 0x10, 0xee, 0x5a, 0xdc, 0x7b, 0x1e, 0x22, 0xca, 0x3b, 0x38, 0xdc, 0x72,
+	0x03, 0x42, 0x6f, 0x90, 0xa3, 0xe1, 0x02, 0x8a, 0xa3, 0x7b, 0xff, 0x19, 0xcc, 0xa9, 0xd4, 0x63,
+	0x34, 0x05, 0x9c, 0x5e, 0x3a, 0x6f, 0x0a, 0xa0, 0xcb, 0xf1, 0x21, 0x46, 0x02, 0xbf, 0xc0, 0x5c,
+	0x10, 0xea, 0xed, 0x3b, 0x5d, 0xec, 0x46, 0xfe, 0x6d, 0x1d, 0x23, 0x6d, 0x09, 0x80, 0x0b, 0x14,
+	0x8a, 0x96, 0x20, 0x01, 0x56, 0x89, 0x2e, 0xda, 0x35, 0xd5, 0xf3, 0x9c, 0x04, 0x58, 0x9a, 0x1d,
+	0x9f, 0x74, 0x3a, 0xb1, 0x79, 0x36, 0x36, 0xab, 0x1e, 0x65, 0x9e, 0x87, 0xaa, 0x54, 0x04, 0x65,
+	0x2c, 0x2b, 0x63, 0x05, 0x53, 0x57, 0x9a, 0x9a, 0x3f, 0xc1, 0x03, 0x79, 0xb8, 0x42, 0x44, 0x85,
+	0xbc, 0x3e, 0xb7, 0x7c, 0x9f, 0xbd, 0x42, 0xd4, 0xc9, 0x5e, 0xd8, 0x43, 0x28, 0x07, 0x84, 0x0a,
+	0x1c, 0x0e, 0xd4, 0x3a, 0x6e, 0xdd, 0xac, 0x3c, 0x5f, 0xaa, 0x53, 0x69, 0xe3, 0x63, 0x76, 0x84,
+	0xff, 0x5d, 0xf8, 0xf4, 0x9d, 0x8f, 0xd4, 0xd5, 0xb1, 0x8f, 0x85, 0xd4, 0xad, 0xa7, 0x27, 0x38,
+	0x88, 0xeb, 0xf5, 0x3f, 0x2a, 0x2c, 0xc9, 0xc1, 0x0a, 0xaa, 0x76, 0xa4, 0x6a, 0x27, 0xad, 0xe6,
+	0xaf, 0x05, 0x58, 0x1a, 0x9e, 0xba, 0x11, 0xf1, 0xc4, 0x0e, 0xe9, 0x11, 0x4c, 0x05, 0xbf, 0xe6,
+	0x09, 0xb4, 0x00, 0xc2, 0xe1, 0x58, 0xbd, 0xb8, 0x52, 0x5c, 0xab, 0x6f, 0xac, 0xa6, 0xbd, 0x5a,
+	0xa4, 0xc4, 0x4a, 0xf2, 0x3d, 0x02, 0x68, 0xbe, 0x03, 0x73, 0x4f, 0x83, 0x9e, 0xe8, 0xdb, 0x98,
+	0xf7, 0x18, 0xe5, 0x78, 0xe3, 0xef, 0x3a, 0x14, 0x2d, 0xee, 0x69, 0xcf, 0x60, 0x36, 0x7e, 0x55,
+	0x7b, 0x94, 0x06, 0x1f, 0xbc, 0xc8, 0x2d, 0x3c, 0x4e, 0xb3, 0x8e, 0x11, 0xb5, 0x5d, 0x28, 0x29,
+	0x35, 0x5e, 0xcc, 0x00, 0x49, 0x63, 0x4e, 0x8e, 0x52, 0xdf, 0x2c, 0x8e, 0x34, 0xe6, 0xe1, 0x7c,
+	0x09, 0xe5, 0x44, 0x7b, 0x96, 0x32, 0x48, 0xb1, 0x39, 0x0f, 0xeb, 0x1b, 0xa8, 0x0e, 0x45, 0x68,
+	0x39, 0x83, 0x36, 0x70, 0xc8, 0xc3, 0x3b, 0x84, 0x3b, 0x57, 0xf4, 0xf1, 0x83, 0x0c, 0xea, 0xb8,
+	0x5b, 0x1e, 0xf6, 0x4b, 0xb8, 0x3b, 0x21, 0x9c, 0xab, 0x53, 0xe8, 0xd7, 0x99, 0xbb, 0x0b, 0xf7,
+	0xd2, 0x34, 0xf5, 0xa3, 0x8c, 0x10, 0x29, 0xbe, 0x39, 0x33, 0x3e, 0x14, 0xce, 0xac, 0x8c, 0x0f,
+	0x1c, 0x72, 0x66, 0xfc, 0x8a, 0x22, 0x66, 0x65, 0x7c, 0xdc, 0x2d, 0x0f, 0xfb, 0x7b, 0x98, 0x1b,
+	0x57, 0xb1, 0xf7, 0x33, 0xd0, 0x63, 0x5e, 0x79, 0xc8, 0x36, 0xc0, 0x88, 0x86, 0x3d, 0xce, 0xcc,
+	0x03, 0x46, 0xf9, 0x99, 0x5d, 0x78, 0x90, 0xae, 0x6b, 0x1f, 0x67, 0xe1, 0xd3, 0xbc, 0xf3, 0x44,
+	0x6a, 0x83, 0x96, 0xa2, 0x32, 0x1f, 0x66, 0xd5, 0xe2, 0x84, 0x6b, 0xce, 0x6a, 0x4c, 0xd3, 0x92,
+	0xac, 0x6a, 0x4c, 0xf1, 0xcd, 0x79, 0xa6, 0x26, 0x14, 0x65, 0x35, 0xbb, 0xe0, 0xc7, 0x1c, 0xf3,
+	0xf0, 0x43, 0x58, 0x78, 0x8b, 0x72, 0xac, 0xbf, 0xb5, 0x52, 0xd3, 0x86, 0xe4, 0x88, 0xb9, 0xfd,
+	0xed, 0xe9, 0x9f, 0x8d, 0x99, 0xd3, 0xf3, 0x46, 0xe1, 0xf5, 0x79, 0xa3, 0xf0, 0xc7, 0x79, 0xa3,
+	0xf0, 0xcb, 0x45, 0x63, 0xe6, 0xf5, 0x45, 0x63, 0xe6, 0xf7, 0x8b, 0xc6, 0xcc, 0xe1, 0xe6, 0xc8,
+	0x3f, 0xb2, 0x1d, 0x85, 0xda, 0x65, 0x11, 0x75, 0x91, 0x5c, 0x91, 0x99, 0x7c, 0x02, 0x38, 0xb9,
+	0xfc, 0x08, 0xa0, 0xfe, 0xa2, 0xb5, 0xcb, 0xea, 0x13, 0xc0, 0xe6, 0x3f, 0x03, 0x00, 0xac, 0x9b,
+	0xf7, 0xd2, 0xbf, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -482,6 +914,29 @@ type MsgClient interface {
 	GloballyUnfreeze(ctx context.Context, in *MsgGloballyUnfreeze, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// SetWhitelistedLimit sets the limit of how many tokens a specific account may hold.
 	SetWhitelistedLimit(ctx context.Context, in *MsgSetWhitelistedLimit, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// Clawback returns the fungible tokens from an account to the admin, only if the clawback feature
+	// is enabled on that token.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateMetadata updates the description, URI and URI hash of the fungible token, only if the
+	// updatable_metadata feature is enabled on that token.
+	UpdateMetadata(ctx context.Context, in *MsgUpdateMetadata, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// TransferAdmin transfers the admin role of the fungible token to another account.
+	TransferAdmin(ctx context.Context, in *MsgTransferAdmin, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ClearAdmin removes the admin of the fungible token, so the privileged operations can't be executed anymore.
+	ClearAdmin(ctx context.Context, in *MsgClearAdmin, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// CreateVestingSchedule sends the fungible tokens from the admin to an account and locks them
+	// according to the vesting schedule.
+	CreateVestingSchedule(ctx context.Context, in *MsgCreateVestingSchedule, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// GrantMintAllowance sets the amount of the fungible token the minter is allowed to mint.
+	// The allowance is decreased by each mint executed by the minter.
+	GrantMintAllowance(ctx context.Context, in *MsgGrantMintAllowance, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// RevokeMintAllowance removes the mint allowance of the minter.
+	RevokeMintAllowance(ctx context.Context, in *MsgRevokeMintAllowance, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SetRateExemption adds the account to or removes it from the list of accounts which don't pay
+	// the burn rate and the send commission of the fungible token.
+	SetRateExemption(ctx context.Context, in *MsgSetRateExemption, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateCommissionRecipients replaces the accounts receiving the send commission of the fungible token.
+	UpdateCommissionRecipients(ctx context.Context, in *MsgUpdateCommissionRecipients, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type msgClient struct {
@@ -564,42 +1019,146 @@ func (c *msgClient) SetWhitelistedLimit(ctx context.Context, in *MsgSetWhitelist
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Issue defines a method to issue a new fungible token.
-	Issue(context.Context, *MsgIssue) (*EmptyResponse, error)
-	// Mint mints new fungible tokens.
-	Mint(context.Context, *MsgMint) (*EmptyResponse, error)
-	// Burn burns the specified fungible tokens from senders balance if the sender has enough balance.
-	Burn(context.Context, *MsgBurn) (*EmptyResponse, error)
-	// Freeze freezes a part of the fungible tokens in an
-	// account, only if the freezable feature is enabled on that token.
-	Freeze(context.Context, *MsgFreeze) (*EmptyResponse, error)
-	// Unfreeze unfreezes a part of the frozen fungible tokens in an
-	// account, only if there are such frozen tokens on that account.
-	Unfreeze(context.Context, *MsgUnfreeze) (*EmptyResponse, error)
-	// GloballyFreeze freezes fungible token so no operations are allowed with it before unfrozen.
-	// This operation is idempotent so global freeze of already frozen token does nothing.
-	GloballyFreeze(context.Context, *MsgGloballyFreeze) (*EmptyResponse, error)
-	// GloballyUnfreeze unfreezes fungible token and unblocks basic operations on it.
-	// This operation is idempotent so global unfreezing of non-frozen token does nothing.
-	GloballyUnfreeze(context.Context, *MsgGloballyUnfreeze) (*EmptyResponse, error)
-	// SetWhitelistedLimit sets the limit of how many tokens a specific account may hold.
-	SetWhitelistedLimit(context.Context, *MsgSetWhitelistedLimit) (*EmptyResponse, error)
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (c *msgClient) UpdateMetadata(ctx context.Context, in *MsgUpdateMetadata, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/UpdateMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedMsgServer) Issue(ctx context.Context, req *MsgIssue) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Issue not implemented")
+func (c *msgClient) TransferAdmin(ctx context.Context, in *MsgTransferAdmin, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/TransferAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMint) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+
+func (c *msgClient) ClearAdmin(ctx context.Context, in *MsgClearAdmin, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/ClearAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+
+func (c *msgClient) CreateVestingSchedule(ctx context.Context, in *MsgCreateVestingSchedule, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/CreateVestingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantMintAllowance(ctx context.Context, in *MsgGrantMintAllowance, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/GrantMintAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeMintAllowance(ctx context.Context, in *MsgRevokeMintAllowance, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/RevokeMintAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRateExemption(ctx context.Context, in *MsgSetRateExemption, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/SetRateExemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCommissionRecipients(ctx context.Context, in *MsgUpdateCommissionRecipients, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/UpdateCommissionRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Issue defines a method to issue a new fungible token.
+	Issue(context.Context, *MsgIssue) (*EmptyResponse, error)
+	// Mint mints new fungible tokens.
+	Mint(context.Context, *MsgMint) (*EmptyResponse, error)
+	// Burn burns the specified fungible tokens from senders balance if the sender has enough balance.
+	Burn(context.Context, *MsgBurn) (*EmptyResponse, error)
+	// Freeze freezes a part of the fungible tokens in an
+	// account, only if the freezable feature is enabled on that token.
+	Freeze(context.Context, *MsgFreeze) (*EmptyResponse, error)
+	// Unfreeze unfreezes a part of the frozen fungible tokens in an
+	// account, only if there are such frozen tokens on that account.
+	Unfreeze(context.Context, *MsgUnfreeze) (*EmptyResponse, error)
+	// GloballyFreeze freezes fungible token so no operations are allowed with it before unfrozen.
+	// This operation is idempotent so global freeze of already frozen token does nothing.
+	GloballyFreeze(context.Context, *MsgGloballyFreeze) (*EmptyResponse, error)
+	// GloballyUnfreeze unfreezes fungible token and unblocks basic operations on it.
+	// This operation is idempotent so global unfreezing of non-frozen token does nothing.
+	GloballyUnfreeze(context.Context, *MsgGloballyUnfreeze) (*EmptyResponse, error)
+	// SetWhitelistedLimit sets the limit of how many tokens a specific account may hold.
+	SetWhitelistedLimit(context.Context, *MsgSetWhitelistedLimit) (*EmptyResponse, error)
+	// Clawback returns the fungible tokens from an account to the admin, only if the clawback feature
+	// is enabled on that token.
+	Clawback(context.Context, *MsgClawback) (*EmptyResponse, error)
+	// UpdateMetadata updates the description, URI and URI hash of the fungible token, only if the
+	// updatable_metadata feature is enabled on that token.
+	UpdateMetadata(context.Context, *MsgUpdateMetadata) (*EmptyResponse, error)
+	// TransferAdmin transfers the admin role of the fungible token to another account.
+	TransferAdmin(context.Context, *MsgTransferAdmin) (*EmptyResponse, error)
+	// ClearAdmin removes the admin of the fungible token, so the privileged operations can't be executed anymore.
+	ClearAdmin(context.Context, *MsgClearAdmin) (*EmptyResponse, error)
+	// CreateVestingSchedule sends the fungible tokens from the admin to an account and locks them
+	// according to the vesting schedule.
+	CreateVestingSchedule(context.Context, *MsgCreateVestingSchedule) (*EmptyResponse, error)
+	// GrantMintAllowance sets the amount of the fungible token the minter is allowed to mint.
+	// The allowance is decreased by each mint executed by the minter.
+	GrantMintAllowance(context.Context, *MsgGrantMintAllowance) (*EmptyResponse, error)
+	// RevokeMintAllowance removes the mint allowance of the minter.
+	RevokeMintAllowance(context.Context, *MsgRevokeMintAllowance) (*EmptyResponse, error)
+	// SetRateExemption adds the account to or removes it from the list of accounts which don't pay
+	// the burn rate and the send commission of the fungible token.
+	SetRateExemption(context.Context, *MsgSetRateExemption) (*EmptyResponse, error)
+	// UpdateCommissionRecipients replaces the accounts receiving the send commission of the fungible token.
+	UpdateCommissionRecipients(context.Context, *MsgUpdateCommissionRecipients) (*EmptyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Issue(ctx context.Context, req *MsgIssue) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Issue not implemented")
+}
+func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMint) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
 }
 func (*UnimplementedMsgServer) Freeze(ctx context.Context, req *MsgFreeze) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Freeze not implemented")
@@ -616,6 +1175,33 @@ func (*UnimplementedMsgServer) GloballyUnfreeze(ctx context.Context, req *MsgGlo
 func (*UnimplementedMsgServer) SetWhitelistedLimit(ctx context.Context, req *MsgSetWhitelistedLimit) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetWhitelistedLimit not implemented")
 }
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMetadata(ctx context.Context, req *MsgUpdateMetadata) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetadata not implemented")
+}
+func (*UnimplementedMsgServer) TransferAdmin(ctx context.Context, req *MsgTransferAdmin) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAdmin not implemented")
+}
+func (*UnimplementedMsgServer) ClearAdmin(ctx context.Context, req *MsgClearAdmin) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAdmin not implemented")
+}
+func (*UnimplementedMsgServer) CreateVestingSchedule(ctx context.Context, req *MsgCreateVestingSchedule) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVestingSchedule not implemented")
+}
+func (*UnimplementedMsgServer) GrantMintAllowance(ctx context.Context, req *MsgGrantMintAllowance) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantMintAllowance not implemented")
+}
+func (*UnimplementedMsgServer) RevokeMintAllowance(ctx context.Context, req *MsgRevokeMintAllowance) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMintAllowance not implemented")
+}
+func (*UnimplementedMsgServer) SetRateExemption(ctx context.Context, req *MsgSetRateExemption) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateExemption not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCommissionRecipients(ctx context.Context, req *MsgUpdateCommissionRecipients) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommissionRecipients not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -765,6 +1351,168 @@ func _Msg_SetWhitelistedLimit_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/UpdateMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMetadata(ctx, req.(*MsgUpdateMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/TransferAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferAdmin(ctx, req.(*MsgTransferAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/ClearAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearAdmin(ctx, req.(*MsgClearAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/CreateVestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVestingSchedule(ctx, req.(*MsgCreateVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantMintAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantMintAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantMintAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/GrantMintAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantMintAllowance(ctx, req.(*MsgGrantMintAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeMintAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeMintAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeMintAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/RevokeMintAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeMintAllowance(ctx, req.(*MsgRevokeMintAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/SetRateExemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateExemption(ctx, req.(*MsgSetRateExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCommissionRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCommissionRecipients)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCommissionRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/UpdateCommissionRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCommissionRecipients(ctx, req.(*MsgUpdateCommissionRecipients))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.asset.ft.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -801,6 +1549,42 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetWhitelistedLimit",
 			Handler:    _Msg_SetWhitelistedLimit_Handler,
 		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "UpdateMetadata",
+			Handler:    _Msg_UpdateMetadata_Handler,
+		},
+		{
+			MethodName: "TransferAdmin",
+			Handler:    _Msg_TransferAdmin_Handler,
+		},
+		{
+			MethodName: "ClearAdmin",
+			Handler:    _Msg_ClearAdmin_Handler,
+		},
+		{
+			MethodName: "CreateVestingSchedule",
+			Handler:    _Msg_CreateVestingSchedule_Handler,
+		},
+		{
+			MethodName: "GrantMintAllowance",
+			Handler:    _Msg_GrantMintAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeMintAllowance",
+			Handler:    _Msg_RevokeMintAllowance_Handler,
+		},
+		{
+			MethodName: "SetRateExemption",
+			Handler:    _Msg_SetRateExemption_Handler,
+		},
+		{
+			MethodName: "UpdateCommissionRecipients",
+			Handler:    _Msg_UpdateCommissionRecipients_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/asset/ft/v1/tx.proto",
@@ -826,6 +1610,30 @@ func (m *MsgIssue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x52
+	}
 	{
 		size := m.SendCommissionRate.Size()
 		i -= size
@@ -1205,7 +2013,7 @@ func (m *MsgSetWhitelistedLimit) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1215,208 +2023,2228 @@ func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgIssue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Subunit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Precision != 0 {
-		n += 1 + sovTx(uint64(m.Precision))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.InitialAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.BurnRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.SendCommissionRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBurn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgTransferAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFreeze) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTransferAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnfreeze) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClearAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGloballyFreeze) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateVestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CliffTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *MsgGloballyUnfreeze) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetWhitelistedLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantMintAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantMintAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantMintAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeMintAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeMintAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeMintAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exempt {
+		i--
+		if m.Exempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCommissionRecipients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommissionRecipients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommissionRecipients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgIssue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Account)
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subunit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovTx(uint64(m.Precision))
+	}
+	l = m.InitialAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = m.BurnRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SendCommissionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnfreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGloballyFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGloballyUnfreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWhitelistedLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateVestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if m.CliffTime != 0 {
+		n += 1 + sovTx(uint64(m.CliffTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *MsgGrantMintAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRevokeMintAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRateExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Exempt {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateCommissionRecipients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmptyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgIssue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subunit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v Feature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Feature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]Feature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Feature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Feature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGloballyFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGloballyFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGloballyUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGloballyUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWhitelistedLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWhitelistedLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EmptyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgIssue) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1439,15 +4267,15 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssue: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1475,11 +4303,11 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1507,13 +4335,13 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1523,46 +4351,78 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subunit = string(dAtA[iNdEx:postIndex])
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Precision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Precision |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1590,13 +4450,11 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1624,80 +4482,43 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType == 0 {
-				var v Feature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Feature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]Feature, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Feature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Feature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1725,13 +4546,11 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1759,9 +4578,7 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1784,7 +4601,7 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1807,10 +4624,10 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1847,9 +4664,9 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1859,24 +4676,55 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1899,7 +4747,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1922,10 +4770,10 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1960,11 +4808,11 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1974,24 +4822,23 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2014,7 +4861,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateVestingSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2037,10 +4884,10 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateVestingSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2140,6 +4987,63 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2161,7 +5065,7 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantMintAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2184,10 +5088,10 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnfreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantMintAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantMintAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2224,7 +5128,7 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2252,7 +5156,7 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Minter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -2308,7 +5212,7 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeMintAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2331,10 +5235,10 @@ func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGloballyFreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeMintAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGloballyFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeMintAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2370,6 +5274,38 @@ func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -2422,7 +5358,7 @@ func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRateExemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,10 +5381,10 @@ func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGloballyUnfreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRateExemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGloballyUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRateExemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2484,6 +5420,38 @@ func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -2515,6 +5483,26 @@ func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempt = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2536,7 +5524,7 @@ func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCommissionRecipients) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2559,10 +5547,10 @@ func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWhitelistedLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCommissionRecipients: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWhitelistedLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCommissionRecipients: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2599,7 +5587,7 @@ func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2627,11 +5615,11 @@ func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2658,7 +5646,8 @@ func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipients = append(m.Recipients, CommissionRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewVestingSchedule returns a new instance of the VestingSchedule.
+func NewVestingSchedule(amount sdk.Int, startTime, cliffTime, endTime int64) VestingSchedule {
+	return VestingSchedule{
+		Amount:    amount,
+		StartTime: startTime,
+		CliffTime: cliffTime,
+		EndTime:   endTime,
+	}
+}
+
+// Validate checks that the amount is positive and the times are ordered properly.
+func (s VestingSchedule) Validate() error {
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "vesting amount must be positive")
+	}
+
+	if s.StartTime < 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "vesting start time can't be negative")
+	}
+
+	if s.CliffTime < s.StartTime || s.CliffTime > s.EndTime {
+		return sdkerrors.Wrap(ErrInvalidInput, "vesting cliff time must be between the start time and the end time")
+	}
+
+	return nil
+}
+
+// LockedAmount returns the amount which is still locked at the provided unix time.
+func (s VestingSchedule) LockedAmount(blockTime int64) sdk.Int {
+	switch {
+	case blockTime < s.CliffTime:
+		return s.Amount
+	case blockTime >= s.EndTime:
+		return sdk.ZeroInt()
+	default:
+		released := s.Amount.MulRaw(blockTime - s.StartTime).QuoRaw(s.EndTime - s.StartTime)
+		return s.Amount.Sub(released)
+	}
+}
+
+// Validate checks that the account, denom and all the schedules are valid.
+func (s AccountVestingSchedules) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address %s", s.Account)
+	}
+
+	if _, _, err := DeconstructDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if len(s.Schedules) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidInput, "no vesting schedules for %s on account %s", s.Denom, s.Account)
+	}
+
+	for _, schedule := range s.Schedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LockedAmount returns the amount locked by all the schedules at the provided unix time.
+func (s AccountVestingSchedules) LockedAmount(blockTime int64) sdk.Int {
+	locked := sdk.ZeroInt()
+	for _, schedule := range s.Schedules {
+		locked = locked.Add(schedule.LockedAmount(blockTime))
+	}
+	return locked
+}
+
+// ReduceLocked reduces the amount locked by the schedules at the provided unix time by the provided amount, starting
+// from the most recent schedule. The reduced schedule keeps its times and releases the remaining amount proportionally.
+// The schedules which don't lock anything anymore are removed.
+func (s AccountVestingSchedules) ReduceLocked(blockTime int64, amount sdk.Int) AccountVestingSchedules {
+	schedules := append([]VestingSchedule(nil), s.Schedules...)
+	for i := len(schedules) - 1; i >= 0 && amount.IsPositive(); i-- {
+		locked := schedules[i].LockedAmount(blockTime)
+		if !locked.IsPositive() {
+			continue
+		}
+		reduction := sdk.MinInt(amount, locked)
+		amount = amount.Sub(reduction)
+		// rounding down guarantees that the schedule doesn't lock more than the remaining amount
+		schedules[i].Amount = schedules[i].Amount.Mul(locked.Sub(reduction)).Quo(locked)
+	}
+
+	s.Schedules = make([]VestingSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		if schedule.LockedAmount(blockTime).IsPositive() {
+			s.Schedules = append(s.Schedules, schedule)
+		}
+	}
+	return s
+}
@@ -0,0 +1,722 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/ft/v1/vesting.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VestingSchedule defines the amount of the fungible token locked on the account. Nothing is released before
+// the cliff time, after that the amount is released linearly from the start time to the end time.
+type VestingSchedule struct {
+	// amount is the total amount locked by the schedule.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// start_time is the unix time in seconds when the linear release starts.
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cliff_time is the unix time in seconds before which nothing is released.
+	CliffTime int64 `protobuf:"varint,3,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+	// end_time is the unix time in seconds when the whole amount is released.
+	EndTime int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a8ca5366c13a276, []int{0}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetCliffTime() int64 {
+	if m != nil {
+		return m.CliffTime
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// AccountVestingSchedules defines the vesting schedules of the fungible token created for the account.
+type AccountVestingSchedules struct {
+	// account is the address of the account holding the locked tokens.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom is the denom of the locked tokens.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// schedules contains the vesting schedules which are not fully released yet.
+	Schedules []VestingSchedule `protobuf:"bytes,3,rep,name=schedules,proto3" json:"schedules"`
+}
+
+func (m *AccountVestingSchedules) Reset()         { *m = AccountVestingSchedules{} }
+func (m *AccountVestingSchedules) String() string { return proto.CompactTextString(m) }
+func (*AccountVestingSchedules) ProtoMessage()    {}
+func (*AccountVestingSchedules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a8ca5366c13a276, []int{1}
+}
+func (m *AccountVestingSchedules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountVestingSchedules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountVestingSchedules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountVestingSchedules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVestingSchedules.Merge(m, src)
+}
+func (m *AccountVestingSchedules) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountVestingSchedules) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVestingSchedules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountVestingSchedules proto.InternalMessageInfo
+
+func (m *AccountVestingSchedules) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountVestingSchedules) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AccountVestingSchedules) GetSchedules() []VestingSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*VestingSchedule)(nil), "coreum.asset.ft.v1.VestingSchedule")
+	proto.RegisterType((*AccountVestingSchedules)(nil), "coreum.asset.ft.v1.AccountVestingSchedules")
+}
+
+func init() { proto.RegisterFile("coreum/asset/ft/v1/vesting.proto", fileDescriptor_1a8ca5366c13a276) }
+
+var fileDescriptor_1a8ca5366c13a276 = []byte{
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x33, 0x7f, 0xfa, 0xb7, 0x66, 0x5c, 0x08, 0x43, 0xc1, 0x28, 0x98, 0x86, 0x0a, 0xd2,
+	0x8d, 0x33, 0xd4, 0x3e, 0x81, 0x15, 0x2a, 0x2e, 0xdc, 0x44, 0x71, 0xe1, 0x46, 0xd2, 0xc9, 0x24,
+	0x0d, 0x76, 0x66, 0x4a, 0x67, 0x52, 0xf4, 0x2d, 0xc4, 0x47, 0xf1, 0x29, 0xba, 0xec, 0x52, 0x5c,
+	0x14, 0x69, 0x5f, 0x44, 0x32, 0x93, 0x52, 0xa9, 0xab, 0xe4, 0xde, 0xef, 0x9e, 0xb9, 0x97, 0x73,
+	0x60, 0x48, 0xe5, 0x94, 0x15, 0x9c, 0xc4, 0x4a, 0x31, 0x4d, 0x52, 0x4d, 0x66, 0x5d, 0x32, 0x63,
+	0x4a, 0xe7, 0x22, 0xc3, 0x93, 0xa9, 0xd4, 0x12, 0x21, 0x3b, 0x81, 0xcd, 0x04, 0x4e, 0x35, 0x9e,
+	0x75, 0x8f, 0x9b, 0x99, 0xcc, 0xa4, 0xc1, 0xa4, 0xfc, 0xb3, 0x93, 0xed, 0x0f, 0x00, 0x0f, 0x1e,
+	0xac, 0xf6, 0x8e, 0x8e, 0x58, 0x52, 0x8c, 0x19, 0x1a, 0xc0, 0x7a, 0xcc, 0x65, 0x21, 0xb4, 0x0f,
+	0x42, 0xd0, 0xf1, 0xfa, 0x78, 0xbe, 0x6c, 0x39, 0x5f, 0xcb, 0xd6, 0x59, 0x96, 0xeb, 0x51, 0x31,
+	0xc4, 0x54, 0x72, 0x42, 0xa5, 0xe2, 0x52, 0x55, 0x9f, 0x73, 0x95, 0x3c, 0x13, 0xfd, 0x3a, 0x61,
+	0x0a, 0xdf, 0x08, 0x1d, 0x55, 0x6a, 0x74, 0x02, 0xa1, 0xd2, 0xf1, 0x54, 0x3f, 0xe9, 0x9c, 0x33,
+	0xff, 0x5f, 0x08, 0x3a, 0x6e, 0xe4, 0x99, 0xce, 0x7d, 0xce, 0x59, 0x89, 0xe9, 0x38, 0x4f, 0x53,
+	0x8b, 0x5d, 0x8b, 0x4d, 0xc7, 0xe0, 0x23, 0xb8, 0xc7, 0x44, 0x62, 0x61, 0xcd, 0xc0, 0x06, 0x13,
+	0x49, 0x89, 0xda, 0xef, 0x00, 0x1e, 0x5e, 0x52, 0x5a, 0x2e, 0xd9, 0xb9, 0x5d, 0x21, 0x1f, 0x36,
+	0x62, 0x8b, 0xec, 0xf5, 0xd1, 0xa6, 0x44, 0x4d, 0xf8, 0x3f, 0x61, 0x42, 0x72, 0x73, 0x89, 0x17,
+	0xd9, 0x02, 0x5d, 0x43, 0x4f, 0x6d, 0xc4, 0xbe, 0x1b, 0xba, 0x9d, 0xfd, 0x8b, 0x53, 0xfc, 0xd7,
+	0x3e, 0xbc, 0xb3, 0xa8, 0x5f, 0x2b, 0x4d, 0x89, 0xb6, 0xda, 0xfe, 0xed, 0x7c, 0x15, 0x80, 0xc5,
+	0x2a, 0x00, 0xdf, 0xab, 0x00, 0xbc, 0xad, 0x03, 0x67, 0xb1, 0x0e, 0x9c, 0xcf, 0x75, 0xe0, 0x3c,
+	0xf6, 0x7e, 0xf9, 0x76, 0x65, 0x5e, 0x1e, 0xc8, 0x42, 0x24, 0xb1, 0xce, 0xa5, 0x20, 0x55, 0x96,
+	0x2f, 0xdb, 0x34, 0x8d, 0x91, 0xc3, 0xba, 0xc9, 0xa7, 0xf7, 0x13, 0x00, 0x00, 0xff, 0xff, 0x98,
+	0x42, 0x41, 0x42, 0xed, 0x01, 0x00, 0x00,
+}
+
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CliffTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountVestingSchedules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountVestingSchedules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVestingSchedules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if m.CliffTime != 0 {
+		n += 1 + sovVesting(uint64(m.CliffTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovVesting(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *AccountVestingSchedules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
+	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountVestingSchedules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountVestingSchedules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountVestingSchedules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, VestingSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVesting(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVesting
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVesting
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVesting
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVesting        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVesting          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVesting = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestVestingSchedule_Validate(t *testing.T) {
+	assertT := assert.New(t)
+
+	assertT.NoError(types.NewVestingSchedule(sdk.NewInt(100), 0, 0, 0).Validate())
+	assertT.NoError(types.NewVestingSchedule(sdk.NewInt(100), 10, 20, 30).Validate())
+	assertT.ErrorIs(types.NewVestingSchedule(sdk.ZeroInt(), 10, 20, 30).Validate(), types.ErrInvalidInput)
+	assertT.ErrorIs(types.NewVestingSchedule(sdk.NewInt(-1), 10, 20, 30).Validate(), types.ErrInvalidInput)
+	assertT.ErrorIs(types.NewVestingSchedule(sdk.Int{}, 10, 20, 30).Validate(), types.ErrInvalidInput)
+	assertT.ErrorIs(types.NewVestingSchedule(sdk.NewInt(100), -1, 20, 30).Validate(), types.ErrInvalidInput)
+	assertT.ErrorIs(types.NewVestingSchedule(sdk.NewInt(100), 10, 9, 30).Validate(), types.ErrInvalidInput)
+	assertT.ErrorIs(types.NewVestingSchedule(sdk.NewInt(100), 10, 31, 30).Validate(), types.ErrInvalidInput)
+}
+
+func TestVestingSchedule_LockedAmount(t *testing.T) {
+	schedule := types.NewVestingSchedule(sdk.NewInt(1000), 100, 150, 500)
+
+	testCases := []struct {
+		blockTime int64
+		locked    int64
+	}{
+		{blockTime: 0, locked: 1000},
+		{blockTime: 100, locked: 1000},
+		{blockTime: 149, locked: 1000},
+		{blockTime: 150, locked: 875},
+		{blockTime: 301, locked: 498},
+		{blockTime: 499, locked: 3},
+		{blockTime: 500, locked: 0},
+		{blockTime: 1000, locked: 0},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, sdk.NewInt(tc.locked).String(), schedule.LockedAmount(tc.blockTime).String(), "block time: %d", tc.blockTime)
+	}
+}
+
+func TestAccountVestingSchedules_Validate(t *testing.T) {
+	requireT := require.New(t)
+
+	schedules := types.AccountVestingSchedules{
+		Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+		Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Schedules: []types.VestingSchedule{
+			types.NewVestingSchedule(sdk.NewInt(100), 0, 50, 100),
+			types.NewVestingSchedule(sdk.NewInt(200), 50, 50, 150),
+		},
+	}
+	requireT.NoError(schedules.Validate())
+	requireT.Equal(sdk.NewInt(175).String(), schedules.LockedAmount(75).String())
+
+	invalid := schedules
+	invalid.Account = "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+"
+	requireT.Error(invalid.Validate())
+
+	invalid = schedules
+	invalid.Denom = "abc"
+	requireT.ErrorIs(invalid.Validate(), types.ErrInvalidDenom)
+
+	invalid = schedules
+	invalid.Schedules = nil
+	requireT.ErrorIs(invalid.Validate(), types.ErrInvalidInput)
+
+	invalid = schedules
+	invalid.Schedules = []types.VestingSchedule{types.NewVestingSchedule(sdk.NewInt(100), 50, 0, 100)}
+	requireT.ErrorIs(invalid.Validate(), types.ErrInvalidInput)
+}
+
+func TestAccountVestingSchedules_ReduceLocked(t *testing.T) {
+	requireT := require.New(t)
+
+	schedules := types.AccountVestingSchedules{
+		Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+		Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Schedules: []types.VestingSchedule{
+			types.NewVestingSchedule(sdk.NewInt(100), 0, 50, 100),
+			types.NewVestingSchedule(sdk.NewInt(200), 50, 50, 150),
+			types.NewVestingSchedule(sdk.NewInt(10), 0, 0, 50),
+		},
+	}
+	requireT.Equal(sdk.NewInt(175).String(), schedules.LockedAmount(75).String())
+
+	// nothing to reduce, only the released schedule is removed
+	reduced := schedules.ReduceLocked(75, sdk.ZeroInt())
+	requireT.Equal(schedules.Schedules[:2], reduced.Schedules)
+
+	// the most recent schedule is reduced first and keeps releasing proportionally
+	reduced = schedules.ReduceLocked(75, sdk.NewInt(75))
+	requireT.Equal(sdk.NewInt(100).String(), reduced.LockedAmount(75).String())
+	requireT.Equal([]types.VestingSchedule{
+		types.NewVestingSchedule(sdk.NewInt(100), 0, 50, 100),
+		types.NewVestingSchedule(sdk.NewInt(100), 50, 50, 150),
+	}, reduced.Schedules)
+	requireT.Equal(sdk.NewInt(50).String(), reduced.LockedAmount(100).String())
+
+	// the schedules which don't lock anything anymore are removed
+	reduced = schedules.ReduceLocked(75, sdk.NewInt(160))
+	requireT.Equal(sdk.NewInt(15).String(), reduced.LockedAmount(75).String())
+	requireT.Equal([]types.VestingSchedule{
+		types.NewVestingSchedule(sdk.NewInt(60), 0, 50, 100),
+	}, reduced.Schedules)
+
+	// everything is unlocked
+	reduced = schedules.ReduceLocked(75, sdk.NewInt(1000))
+	requireT.Empty(reduced.Schedules)
+	requireT.Equal(sdk.ZeroInt().String(), reduced.LockedAmount(75).String())
+
+	// the original schedules are not modified
+	requireT.Equal(sdk.NewInt(175).String(), schedules.LockedAmount(75).String())
+}
@@ -3,7 +3,9 @@ package cli_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
@@ -56,3 +58,67 @@ func TestCmdTxBurn(t *testing.T) {
 	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxBurn(), args)
 	requireT.NoError(err)
 }
+
+func TestCmdTxMintAndBurnBatch(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+
+	args := []string{symbol, "class name", "class description", "https://my-class-meta.invalid/1", "content-hash"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxIssueClass(), args)
+	requireT.NoError(err)
+
+	classID := types.BuildClassID(symbol, validator.Address)
+	args = []string{classID, "nft-1", "nft-2", "nft-3"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMintBatch(), args)
+	requireT.NoError(err)
+
+	args = []string{classID, "nft-1", "nft-3"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxBurnBatch(), args)
+	requireT.NoError(err)
+}
+
+func TestCmdTxRevoke(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	ctx := testNetwork.Validators[0].ClientCtx
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID := issueClass(
+		requireT, ctx,
+		symbol, "class name", "class description", "https://my-class-meta.invalid/1", "",
+		testNetwork,
+		"0",
+		types.ClassFeature_soulbound,
+		types.ClassFeature_revocable,
+	)
+
+	args := []string{classID, "nft-1", "https://my-nft-meta.invalid/1", "content-hash", "--recipient", holder.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMint(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryOwnerNFTsResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryOwnerNFTs(), []string{holder.String(), classID, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Len(resp.Nfts, 1)
+
+	args = []string{classID, "nft-1"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxRevoke(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryOwnerNFTs(), []string{holder.String(), classID, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.Nfts)
+}
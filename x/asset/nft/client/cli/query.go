@@ -13,6 +13,8 @@ import (
 	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
 )
 
+const issuerFlag = "issuer"
+
 // GetQueryCmd returns the cli query commands for the module.
 func GetQueryCmd() *cobra.Command {
 	// Group nft-asset queries under a subcommand
@@ -26,9 +28,19 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdQueryClass(),
+		CmdQueryClasses(),
 		CmdQueryFrozen(),
 		CmdQueryWhitelisted(),
 		CmdQueryWhitelistedAccounts(),
+		CmdQueryClassFrozen(),
+		CmdQueryClassFrozenAccounts(),
+		CmdQueryClassWhitelisted(),
+		CmdQueryClassWhitelistedAccounts(),
+		CmdQueryOwnerNFTs(),
+		CmdQueryUser(),
+		CmdQueryClassSupply(),
+		CmdQueryClassMinters(),
+		CmdQueryNFTData(),
 	)
 	return cmd
 }
@@ -69,6 +81,70 @@ $ %[1]s query %s class [id]
 	return cmd
 }
 
+// CmdQueryClasses return the QueryClasses cobra command.
+func CmdQueryClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("classes --%s=[issuer] --%s=[features]", issuerFlag, featuresFlag),
+		Args:  cobra.NoArgs,
+		Short: "Query non-fungible token classes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query non-fungible token classes, optionally filtered by the issuer and the enabled features.
+
+Example:
+$ %[1]s query %[2]s classes --%[3]s=%[4]s --%[5]s=freezing,whitelisting
+`,
+				version.AppName, types.ModuleName, issuerFlag, constant.AddressSampleTest, featuresFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			issuer, err := cmd.Flags().GetString(issuerFlag)
+			if err != nil {
+				return err
+			}
+
+			featuresString, err := cmd.Flags().GetStringSlice(featuresFlag)
+			if err != nil {
+				return err
+			}
+
+			var features []types.ClassFeature
+			for _, str := range featuresString {
+				feature, ok := types.ClassFeature_value[str]
+				if !ok {
+					return fmt.Errorf("unknown feature '%s'", str)
+				}
+				features = append(features, types.ClassFeature(feature))
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Classes(cmd.Context(), &types.QueryClassesRequest{
+				Pagination: pageReq,
+				Issuer:     issuer,
+				Features:   features,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(issuerFlag, "", "Issuer of the non-fungible token classes")
+	cmd.Flags().StringSlice(featuresFlag, []string{}, "Features which must be enabled on the non-fungible token classes")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "classes")
+
+	return cmd
+}
+
 // CmdQueryFrozen return the CmdQueryFrozen cobra command.
 func CmdQueryFrozen() *cobra.Command {
 	cmd := &cobra.Command{
@@ -191,3 +267,368 @@ $ %s query %s whitelisted [class-id] [id]
 
 	return cmd
 }
+
+// CmdQueryClassFrozen return the CmdQueryClassFrozen cobra command.
+func CmdQueryClassFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-frozen [class-id] [account]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query if non-fungible token class is frozen for the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query if non-fungible token class is frozen for the account. If the account is omitted, it is checked whether the whole class is frozen.
+
+Example:
+$ %s query %s class-frozen [class-id] [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+			var account string
+			if len(args) > 1 {
+				account = args[1]
+			}
+
+			res, err := queryClient.ClassFrozen(cmd.Context(), &types.QueryClassFrozenRequest{
+				ClassId: classID,
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassFrozenAccounts return the CmdQueryClassFrozenAccounts cobra command.
+func CmdQueryClassFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-frozen-accounts [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the list of accounts for which non-fungible token class is frozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the list of accounts for which non-fungible token class is frozen.
+
+Example:
+$ %s query %s class-frozen-accounts [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClassFrozenAccounts(cmd.Context(), &types.QueryClassFrozenAccountsRequest{
+				Pagination: pageReq,
+				ClassId:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class-frozen-accounts")
+
+	return cmd
+}
+
+// CmdQueryClassWhitelisted return the CmdQueryClassWhitelisted cobra command.
+func CmdQueryClassWhitelisted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-whitelisted [class-id] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query if account is whitelisted to hold all the non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query if account is whitelisted to hold all the non-fungible tokens of the class.
+
+Example:
+$ %s query %s class-whitelisted [class-id] [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+			account := args[1]
+			res, err := queryClient.ClassWhitelisted(cmd.Context(), &types.QueryClassWhitelistedRequest{
+				ClassId: classID,
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassWhitelistedAccounts return the CmdQueryClassWhitelistedAccounts cobra command.
+func CmdQueryClassWhitelistedAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-whitelisted-accounts [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the list of accounts whitelisted for the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the list of accounts whitelisted to hold all the non-fungible tokens of the class.
+
+Example:
+$ %s query %s class-whitelisted-accounts [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClassWhitelistedAccounts(cmd.Context(), &types.QueryClassWhitelistedAccountsRequest{
+				Pagination: pageReq,
+				ClassId:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class-whitelisted-accounts")
+
+	return cmd
+}
+
+// CmdQueryOwnerNFTs return the CmdQueryOwnerNFTs cobra command.
+func CmdQueryOwnerNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner-nfts [owner] [class-id]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query for the non-fungible tokens held by the owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the non-fungible tokens held by the owner together with their class features, issuer,
+frozen and whitelisted flags. If the class id is provided, only the tokens of that class are returned.
+
+Example:
+$ %s query %s owner-nfts [owner] [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner := args[0]
+			var classID string
+			if len(args) > 1 {
+				classID = args[1]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OwnerNFTs(cmd.Context(), &types.QueryOwnerNFTsRequest{
+				Pagination: pageReq,
+				Owner:      owner,
+				ClassId:    classID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owner-nfts")
+
+	return cmd
+}
+
+// CmdQueryUser return the CmdQueryUser cobra command.
+func CmdQueryUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user [class-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the current user of non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current user of non-fungible token, the expired user is not returned.
+
+Example:
+$ %[1]s query %s user [class-id] [id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+			id := args[1]
+			res, err := queryClient.User(cmd.Context(), &types.QueryUserRequest{
+				Id:      id,
+				ClassId: classID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassSupply return the CmdQueryClassSupply cobra command.
+func CmdQueryClassSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-supply [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the supply of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of existing and burnt non-fungible tokens of the class together with its max supply.
+
+Example:
+$ %s query %s class-supply [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassSupply(cmd.Context(), &types.QueryClassSupplyRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassMinters return the CmdQueryClassMinters cobra command.
+func CmdQueryClassMinters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-minters [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the list of accounts allowed to mint the non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the list of accounts allowed to mint the non-fungible tokens of the class together with their quotas.
+
+Example:
+$ %s query %s class-minters [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClassMinters(cmd.Context(), &types.QueryClassMintersRequest{
+				Pagination: pageReq,
+				ClassId:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class-minters")
+
+	return cmd
+}
+
+// CmdQueryNFTData return the CmdQueryNFTData cobra command.
+func CmdQueryNFTData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-data [class-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the decoded data of the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the data of the non-fungible token decoded to JSON according to the data schema of its class.
+
+Example:
+$ %s query %s nft-data [class-id] [id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NFTData(cmd.Context(), &types.QueryNFTDataRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
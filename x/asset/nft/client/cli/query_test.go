@@ -60,6 +60,93 @@ func TestQueryClass(t *testing.T) {
 	}, resp.Class)
 }
 
+func TestQueryClasses(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	issuer := testNetwork.Validators[0].Address
+
+	classID1 := issueClass(
+		requireT, ctx,
+		"nft"+uuid.NewString()[:4], "class name", "class description", "https://my-class-meta.invalid/1", "",
+		testNetwork,
+		"0",
+		types.ClassFeature_freezing,
+	)
+	classID2 := issueClass(
+		requireT, ctx,
+		"nft"+uuid.NewString()[:4], "class name", "class description", "https://my-class-meta.invalid/1", "",
+		testNetwork,
+		"0",
+		types.ClassFeature_burning,
+	)
+
+	var resp types.QueryClassesResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClasses(), []string{
+		"--issuer", issuer.String(), "--output", "json",
+	})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.ElementsMatch([]string{classID1, classID2}, lo.Map(resp.Classes, func(c types.Class, _ int) string {
+		return c.Id
+	}))
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClasses(), []string{
+		"--issuer", issuer.String(), "--features", "freezing", "--output", "json",
+	})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Len(resp.Classes, 1)
+	requireT.Equal(classID1, resp.Classes[0].Id)
+}
+
+func TestQueryOwnerNFTs(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	ctx := testNetwork.Validators[0].ClientCtx
+	owner := testNetwork.Validators[0].Address
+
+	classID := issueClass(
+		requireT, ctx,
+		symbol, "class name", "class description", "https://my-class-meta.invalid/1", "",
+		testNetwork,
+		"0",
+		types.ClassFeature_freezing,
+	)
+	nftID := "nft-1"
+	mint(
+		requireT,
+		ctx,
+		classID,
+		nftID,
+		"https://my-nft-meta.invalid/1",
+		"content-hash",
+		testNetwork,
+	)
+
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryOwnerNFTs(), []string{owner.String(), classID, "--output", "json"})
+	requireT.NoError(err)
+
+	var resp types.QueryOwnerNFTsResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+
+	requireT.Equal([]types.OwnerNFT{
+		{
+			ClassId:  classID,
+			Id:       nftID,
+			URI:      "https://my-nft-meta.invalid/1",
+			URIHash:  "content-hash",
+			Issuer:   owner.String(),
+			Features: []types.ClassFeature{types.ClassFeature_freezing},
+		},
+	}, resp.Nfts)
+}
+
 func issueClass(
 	requireT *require.Assertions,
 	ctx client.Context,
@@ -95,3 +182,36 @@ func mint(
 	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMint(), args)
 	requireT.NoError(err)
 }
+
+func TestQueryNFTData(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	ctx := testNetwork.Validators[0].ClientCtx
+	jsonSchema := `{"type":"object"}`
+
+	args := []string{symbol, "class name", "class description", "", "", fmt.Sprintf("--data-json-schema=%s", jsonSchema)}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxIssueClass(), args)
+	requireT.NoError(err)
+	classID := types.BuildClassID(symbol, testNetwork.Validators[0].Address)
+
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClass(), []string{classID, "--output", "json"})
+	requireT.NoError(err)
+	var classResp types.QueryClassResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &classResp))
+	requireT.Equal(&types.DataSchema{JSONSchema: jsonSchema}, classResp.Class.DataSchema)
+
+	nftID := "nft" + uuid.NewString()[:4]
+	mint(requireT, ctx, classID, nftID, "", "", testNetwork)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryNFTData(), []string{classID, nftID, "--output", "json"})
+	requireT.NoError(err)
+	var resp types.QueryNFTDataResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	// the token is minted without data
+	requireT.Empty(resp.TypeUrl)
+	requireT.Empty(resp.Json)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -19,6 +20,11 @@ import (
 const (
 	featuresFlag    = "features"
 	royaltyRateFlag = "royalty-rate"
+	recipientFlag   = "recipient"
+	maxSupplyFlag   = "max-supply"
+	mintFeeFlag     = "mint-fee"
+	dataTypeURLFlag = "data-type-url"
+	dataSchemaFlag  = "data-json-schema"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -39,6 +45,20 @@ func GetTxCmd() *cobra.Command {
 		CmdTxUnfreeze(),
 		CmdTxWhitelist(),
 		CmdTxUnwhitelist(),
+		CmdTxTransferWithPrice(),
+		CmdTxMintBatch(),
+		CmdTxBurnBatch(),
+		CmdTxSendBatch(),
+		CmdTxUpdateNFT(),
+		CmdTxUpdateClass(),
+		CmdTxClassFreeze(),
+		CmdTxClassUnfreeze(),
+		CmdTxClassWhitelist(),
+		CmdTxClassUnwhitelist(),
+		CmdTxRevoke(),
+		CmdTxSetUser(),
+		CmdTxGrantMinter(),
+		CmdTxRevokeMinter(),
 	)
 
 	return cmd
@@ -100,6 +120,40 @@ $ %s tx %s issue-class abc "ABC Name" "ABC class description." https://my-class-
 				features = append(features, types.ClassFeature(feature))
 			}
 
+			maxSupply, err := cmd.Flags().GetUint64(maxSupplyFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			var mintFee *sdk.Coin
+			mintFeeString, err := cmd.Flags().GetString(mintFeeFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if mintFeeString != "" {
+				fee, err := sdk.ParseCoinNormalized(mintFeeString)
+				if err != nil {
+					return errors.Wrap(err, "invalid mint fee")
+				}
+				mintFee = &fee
+			}
+
+			var dataSchema *types.DataSchema
+			dataTypeURL, err := cmd.Flags().GetString(dataTypeURLFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			dataJSONSchema, err := cmd.Flags().GetString(dataSchemaFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if dataTypeURL != "" || dataJSONSchema != "" {
+				dataSchema = &types.DataSchema{
+					TypeURL:    dataTypeURL,
+					JSONSchema: dataJSONSchema,
+				}
+			}
+
 			msg := &types.MsgIssueClass{
 				Issuer:      issuer.String(),
 				Symbol:      symbol,
@@ -109,6 +163,9 @@ $ %s tx %s issue-class abc "ABC Name" "ABC class description." https://my-class-
 				URIHash:     uriHash,
 				Features:    features,
 				RoyaltyRate: royaltyRate,
+				MaxSupply:   maxSupply,
+				MintFee:     mintFee,
+				DataSchema:  dataSchema,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -117,6 +174,10 @@ $ %s tx %s issue-class abc "ABC Name" "ABC class description." https://my-class-
 
 	cmd.Flags().StringSlice(featuresFlag, []string{}, fmt.Sprintf("Features to be enabled on non-fungible token. e.g --%s=%s", featuresFlag, allowedFeaturesString))
 	cmd.Flags().String(royaltyRateFlag, "0", "royalty-rate is a number between 0 and 1, and will be used to determine royalties sent to issuer, when an nft in this class is traded.")
+	cmd.Flags().Uint64(maxSupplyFlag, 0, "Maximum number of non-fungible tokens which can be minted in the class, 0 means the supply is unlimited.")
+	cmd.Flags().String(mintFeeFlag, "", "Fee paid to the issuer by the minter for every minted non-fungible token, e.g. 100ucore.")
+	cmd.Flags().String(dataTypeURLFlag, "", "Protobuf type URL of the message the data of the minted non-fungible tokens must contain.")
+	cmd.Flags().String(dataSchemaFlag, "", "JSON schema the data of the minted non-fungible tokens must satisfy.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -125,16 +186,16 @@ $ %s tx %s issue-class abc "ABC Name" "ABC class description." https://my-class-
 // CmdTxMint returns Mint cobra command.
 func CmdTxMint() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "mint [class-id] [id] [uri] [uri_hash] --from [sender]",
+		Use:   fmt.Sprintf("mint [class-id] [id] [uri] [uri_hash] --from [sender] --%s=[recipient]", recipientFlag),
 		Args:  cobra.ExactArgs(4),
 		Short: "Mint new non-fungible token",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Mint new non-fungible token.
+			fmt.Sprintf(`Mint new non-fungible token, the token is minted to the sender if the recipient is not set.
 
 Example:
-$ %s tx %s mint abc-%s id1 https://my-nft-meta.invalid/1 e000624 --from [sender]
+$ %s tx %s mint abc-%s id1 https://my-nft-meta.invalid/1 e000624 --from [sender] --%s=[recipient]
 `,
-				version.AppName, types.ModuleName, constant.AddressSampleTest,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, recipientFlag,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -149,12 +210,18 @@ $ %s tx %s mint abc-%s id1 https://my-nft-meta.invalid/1 e000624 --from [sender]
 			uri := args[2]
 			uriHash := args[3]
 
+			recipient, err := cmd.Flags().GetString(recipientFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
 			msg := &types.MsgMint{
-				Sender:  sender.String(),
-				ClassID: classID,
-				ID:      ID,
-				URI:     uri,
-				URIHash: uriHash,
+				Sender:    sender.String(),
+				ClassID:   classID,
+				ID:        ID,
+				URI:       uri,
+				URIHash:   uriHash,
+				Recipient: recipient,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -162,6 +229,7 @@ $ %s tx %s mint abc-%s id1 https://my-nft-meta.invalid/1 e000624 --from [sender]
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(recipientFlag, "", "Address of the account receiving the minted token, the sender is used if not set.")
 
 	return cmd
 }
@@ -369,3 +437,597 @@ $ %s tx %s unwhitelist abc-%[3]s id1 %[3]s --from [sender]
 
 	return cmd
 }
+
+// CmdTxTransferWithPrice returns TransferWithPrice cobra command.
+func CmdTxTransferWithPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-with-price [class-id] [id] [receiver] [price] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Transfer a non-fungible token in exchange for the price paid by the receiver",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer a non-fungible token in exchange for the price paid by the receiver.
+The royalty defined by the class is sent to the class issuer. The transaction must be signed by both the sender
+and the receiver, so it should be generated with --generate-only and signed by both parties.
+
+Example:
+$ %s tx %s transfer-with-price abc-%[3]s id1 %[3]s 100000%[4]s --from [sender] --generate-only
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.DenomDev,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			ID := args[1]
+			receiver := args[2]
+			price, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return errors.Wrap(err, "invalid price")
+			}
+
+			msg := &types.MsgTransferWithPrice{
+				Sender:   sender.String(),
+				Receiver: receiver,
+				ClassID:  classID,
+				ID:       ID,
+				Price:    price,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxMintBatch returns MintBatch cobra command.
+func CmdTxMintBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-batch [class-id] [id]... --from [sender]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Mint multiple non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint multiple non-fungible tokens of the class in a single message.
+
+Example:
+$ %s tx %s mint-batch abc-%s id1 id2 id3 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			items := make([]types.MintBatchItem, 0, len(args)-1)
+			for _, ID := range args[1:] {
+				items = append(items, types.MintBatchItem{ID: ID})
+			}
+
+			msg := &types.MsgMintBatch{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Items:   items,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxBurnBatch returns BurnBatch cobra command.
+func CmdTxBurnBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-batch [class-id] [id]... --from [sender]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Burn multiple non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn multiple non-fungible tokens of the class in a single message.
+
+Example:
+$ %s tx %s burn-batch abc-%s id1 id2 id3 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			msg := &types.MsgBurnBatch{
+				Sender:  sender.String(),
+				ClassID: args[0],
+				IDs:     args[1:],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSendBatch returns SendBatch cobra command.
+func CmdTxSendBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-batch [class-id] [receiver] [id]... --from [sender]",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "Send multiple non-fungible tokens of the class to the receiver",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send multiple non-fungible tokens of the class to the receiver in a single message.
+
+Example:
+$ %s tx %s send-batch abc-%[3]s %[3]s id1 id2 id3 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			msg := &types.MsgSendBatch{
+				Sender:   sender.String(),
+				Receiver: args[1],
+				ClassID:  args[0],
+				IDs:      args[2:],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateNFT returns UpdateNFT cobra command.
+func CmdTxUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-nft [class-id] [id] [uri] [uri_hash] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Update the metadata of the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the metadata of the non-fungible token. The class must have the updatable_metadata feature enabled.
+
+Example:
+$ %s tx %s update-nft abc-%s id1 https://my-nft-meta.invalid/2 e000625 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			msg := &types.MsgUpdateNFT{
+				Sender:  sender.String(),
+				ClassID: args[0],
+				ID:      args[1],
+				URI:     args[2],
+				URIHash: args[3],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateClass returns UpdateClass cobra command.
+func CmdTxUpdateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class [class-id] [uri] [uri_hash] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Update the metadata of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the metadata of the non-fungible token class. The class must have the updatable_metadata feature enabled.
+
+Example:
+$ %s tx %s update-class abc-%s https://my-class-meta.invalid/2 e000625 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			msg := &types.MsgUpdateClass{
+				Sender:  sender.String(),
+				ClassID: args[0],
+				URI:     args[1],
+				URIHash: args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassFreeze returns ClassFreeze cobra command.
+func CmdTxClassFreeze() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "class-freeze [class-id] [account] --from [sender]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Freeze a non-fungible token class for the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze a non-fungible token class for the account. If the account is omitted, the whole class is affected.
+
+Example:
+$ %s tx %s class-freeze abc-%s [account] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			var account string
+			if len(args) > 1 {
+				account = args[1]
+			}
+
+			msg := &types.MsgClassFreeze{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Account: account,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassUnfreeze returns ClassUnfreeze cobra command.
+func CmdTxClassUnfreeze() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "class-unfreeze [class-id] [account] --from [sender]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Unfreeze a non-fungible token class for the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze a non-fungible token class for the account. If the account is omitted, the whole class is affected.
+
+Example:
+$ %s tx %s class-unfreeze abc-%s [account] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			var account string
+			if len(args) > 1 {
+				account = args[1]
+			}
+
+			msg := &types.MsgClassUnfreeze{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Account: account,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassWhitelist returns ClassWhitelist cobra command.
+func CmdTxClassWhitelist() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "class-whitelist [class-id] [account] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Whitelist an account to hold all the non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Whitelist an account to hold all the non-fungible tokens of the class.
+
+Example:
+$ %s tx %s class-whitelist abc-%s [account] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			account := args[1]
+
+			msg := &types.MsgAddToClassWhitelist{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Account: account,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassUnwhitelist returns ClassUnwhitelist cobra command.
+func CmdTxClassUnwhitelist() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "class-unwhitelist [class-id] [account] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove an account from the whitelist of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove an account from the whitelist of the non-fungible token class.
+
+Example:
+$ %s tx %s class-unwhitelist abc-%s [account] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			account := args[1]
+
+			msg := &types.MsgRemoveFromClassWhitelist{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Account: account,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRevoke returns Revoke cobra command.
+func CmdTxRevoke() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "revoke [class-id] [id] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Revoke non-fungible token of the revocable class from its holder",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke non-fungible token of the revocable class from its holder, the token is burnt.
+
+Example:
+$ %s tx %s revoke abc-%s id1 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			ID := args[1]
+
+			msg := &types.MsgRevoke{
+				Sender:  sender.String(),
+				ClassID: classID,
+				ID:      ID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetUser returns SetUser cobra command.
+func CmdTxSetUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-user [class-id] [id] [user] [expires] --from [sender]",
+		Args:  cobra.RangeArgs(2, 4),
+		Short: "Set the user of non-fungible token until the expiration time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the user of non-fungible token until the expiration time given as unix time in seconds.
+If the user and the expiration time are not provided, the current user of the token is removed.
+
+Example:
+$ %s tx %s set-user abc-%s id1 [user] 1700000000 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			ID := args[1]
+
+			var (
+				user    string
+				expires int64
+			)
+			if len(args) > 2 {
+				if len(args) != 4 {
+					return errors.New("the expiration time must be provided together with the user")
+				}
+				user = args[2]
+				expires, err = strconv.ParseInt(args[3], 10, 64)
+				if err != nil {
+					return errors.Wrap(err, "invalid expiration time")
+				}
+			}
+
+			msg := &types.MsgSetUser{
+				Sender:  sender.String(),
+				ClassID: classID,
+				ID:      ID,
+				User:    user,
+				Expires: expires,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGrantMinter returns GrantMinter cobra command.
+func CmdTxGrantMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-minter [class-id] [minter] [quota] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Allow the account to mint the given number of non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow the account to mint the given number of non-fungible tokens of the class.
+The quota replaces the quota granted to the minter before.
+
+Example:
+$ %s tx %s grant-minter abc-%s [minter] 100 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			minter := args[1]
+			quota, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid quota")
+			}
+
+			msg := &types.MsgGrantMinter{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Minter:  minter,
+				Quota:   quota,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRevokeMinter returns RevokeMinter cobra command.
+func CmdTxRevokeMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-minter [class-id] [minter] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the right to mint non-fungible tokens of the class from the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the right to mint non-fungible tokens of the class from the account.
+
+Example:
+$ %s tx %s revoke-minter abc-%s [minter] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			minter := args[1]
+
+			msg := &types.MsgRevokeMinter{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Minter:  minter,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -173,6 +173,231 @@ func TestCmdWhitelist(t *testing.T) {
 	requireT.False(whitelistedResp.Whitelisted)
 }
 
+func TestCmdClassFreeze(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// create class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.0",
+		types.ClassFeature_freezing,
+	)
+
+	// class freeze
+	args := []string{classID, account.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxClassFreeze(), args)
+	requireT.NoError(err)
+
+	// query class frozen
+	var frozenResp types.QueryClassFrozenResponse
+	args = []string{classID, account.String()}
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassFrozen(), args)
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &frozenResp))
+	requireT.True(frozenResp.Frozen)
+
+	// query class frozen accounts
+	var accountsResp types.QueryClassFrozenAccountsResponse
+	args = []string{classID}
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassFrozenAccounts(), args)
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &accountsResp))
+	requireT.ElementsMatch([]string{account.String()}, accountsResp.Accounts)
+
+	// class unfreeze
+	args = []string{classID, account.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxClassUnfreeze(), args)
+	requireT.NoError(err)
+
+	// query class frozen
+	args = []string{classID, account.String()}
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassFrozen(), args)
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &frozenResp))
+	requireT.False(frozenResp.Frozen)
+
+	// whole class freeze
+	args = []string{classID}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxClassFreeze(), args)
+	requireT.NoError(err)
+
+	args = []string{classID}
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassFrozen(), args)
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &frozenResp))
+	requireT.True(frozenResp.Frozen)
+}
+
+func TestCmdClassWhitelist(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// create class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0",
+		types.ClassFeature_whitelisting,
+	)
+
+	// class whitelist
+	args := []string{classID, account.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxClassWhitelist(), args)
+	requireT.NoError(err)
+
+	// query class whitelisted
+	var whitelistedResp types.QueryClassWhitelistedResponse
+	args = []string{classID, account.String()}
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassWhitelisted(), args)
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &whitelistedResp))
+	requireT.True(whitelistedResp.Whitelisted)
+
+	// query class whitelisted accounts
+	var accountsResp types.QueryClassWhitelistedAccountsResponse
+	args = []string{classID}
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassWhitelistedAccounts(), args)
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &accountsResp))
+	requireT.ElementsMatch([]string{account.String()}, accountsResp.Accounts)
+
+	// class unwhitelist
+	args = []string{classID, account.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxClassUnwhitelist(), args)
+	requireT.NoError(err)
+
+	// query class whitelisted
+	args = []string{classID, account.String()}
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassWhitelisted(), args)
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &whitelistedResp))
+	requireT.False(whitelistedResp.Whitelisted)
+}
+
+func TestCmdSetUser(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	ctx := testNetwork.Validators[0].ClientCtx
+	user := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID := issueClass(
+		requireT, ctx,
+		symbol, "class name", "class description", "https://my-class-meta.invalid/1", "",
+		testNetwork,
+		"0",
+	)
+	mint(requireT, ctx, classID, "nft-1", "https://my-nft-meta.invalid/1", "content-hash", testNetwork)
+
+	// set user
+	args := []string{classID, "nft-1", user.String(), "4102444800"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxSetUser(), args)
+	requireT.NoError(err)
+
+	// query user
+	var resp types.QueryUserResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryUser(), []string{classID, "nft-1", "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal(types.QueryUserResponse{User: user.String(), Expires: 4102444800}, resp)
+
+	// remove user
+	args = []string{classID, "nft-1"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxSetUser(), args)
+	requireT.NoError(err)
+
+	// query user
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryUser(), []string{classID, "nft-1", "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Empty(resp.User)
+}
+
+func TestCmdClassMinters(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+	minter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// issue class with the max supply and mint fee
+	args := []string{
+		symbol, "class name", "class description", "https://my-class-meta.invalid/1", "",
+		"--max-supply=10",
+		fmt.Sprintf("--mint-fee=10%s", testNetwork.Config.BondDenom),
+	}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxIssueClass(), args)
+	requireT.NoError(err)
+	classID := types.BuildClassID(symbol, validator.Address)
+
+	// grant minter
+	args = []string{classID, minter.String(), "5"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxGrantMinter(), args)
+	requireT.NoError(err)
+
+	// query minters
+	var mintersResp types.QueryClassMintersResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassMinters(), []string{classID, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &mintersResp))
+	requireT.Equal([]types.ClassMinter{{ClassId: classID, Minter: minter.String(), Quota: 5}}, mintersResp.Minters)
+
+	// query supply
+	mint(requireT, ctx, classID, "nft-1", "https://my-nft-meta.invalid/1", "content-hash", testNetwork)
+	var supplyResp types.QueryClassSupplyResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassSupply(), []string{classID, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &supplyResp))
+	requireT.Equal(types.QueryClassSupplyResponse{Supply: 1, MaxSupply: 10}, supplyResp)
+
+	// revoke minter
+	args = []string{classID, minter.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxRevokeMinter(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClassMinters(), []string{classID, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &mintersResp))
+	requireT.Empty(mintersResp.Minters)
+}
+
 func txValidator1Args(testNetwork *network.Network) []string {
 	return []string{
 		fmt.Sprintf("--%s=%s", flags.FlagFrom, testNetwork.Validators[0].Address.String()),
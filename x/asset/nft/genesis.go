@@ -43,6 +43,52 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, classFrozen := range genState.ClassFrozenAccounts {
+		if err := classFrozen.Validate(); err != nil {
+			panic(err)
+		}
+		for _, account := range classFrozen.Accounts {
+			if err := k.SetClassFrozen(ctx, classFrozen.ClassID, sdk.MustAccAddressFromBech32(account), true); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for _, classID := range genState.FrozenClasses {
+		if err := k.SetClassFrozen(ctx, classID, nil, true); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, classWhitelisted := range genState.ClassWhitelistedAccounts {
+		if err := classWhitelisted.Validate(); err != nil {
+			panic(err)
+		}
+		for _, account := range classWhitelisted.Accounts {
+			if err := k.SetClassWhitelisting(ctx, classWhitelisted.ClassID, sdk.MustAccAddressFromBech32(account), true); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for _, nftUser := range genState.NFTUsers {
+		if err := nftUser.Validate(); err != nil {
+			panic(err)
+		}
+		if err := k.SetNFTUser(ctx, nftUser); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, classMinter := range genState.ClassMinters {
+		if err := classMinter.Validate(); err != nil {
+			panic(err)
+		}
+		if err := k.SetClassMinter(ctx, classMinter); err != nil {
+			panic(err)
+		}
+	}
+
 	for _, burnt := range genState.BurntNFTs {
 		if err := burnt.Validate(); err != nil {
 			panic(err)
@@ -77,11 +123,41 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	_, classFrozen, err := k.GetAllClassFrozenAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	_, frozenClasses, err := k.GetFrozenClasses(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	_, classWhitelisted, err := k.GetAllClassWhitelisted(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	_, nftUsers, err := k.GetNFTUsers(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	_, classMinters, err := k.GetAllClassMinters(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		ClassDefinitions:       classDefinitions,
-		Params:                 k.GetParams(ctx),
-		FrozenNFTs:             frozen,
-		WhitelistedNFTAccounts: whitelisted,
-		BurntNFTs:              burnt,
+		ClassDefinitions:         classDefinitions,
+		Params:                   k.GetParams(ctx),
+		FrozenNFTs:               frozen,
+		WhitelistedNFTAccounts:   whitelisted,
+		BurntNFTs:                burnt,
+		ClassFrozenAccounts:      classFrozen,
+		FrozenClasses:            frozenClasses,
+		ClassWhitelistedAccounts: classWhitelisted,
+		NFTUsers:                 nftUsers,
+		ClassMinters:             classMinters,
 	}
 }
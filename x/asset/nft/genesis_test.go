@@ -37,12 +37,21 @@ func TestInitAndExportGenesis(t *testing.T) {
 	var classDefinitions []types.ClassDefinition
 	for i := 0; i < 5; i++ {
 		classDefinition := types.ClassDefinition{
-			ID: fmt.Sprintf("classid%d-%s", i, issuer),
+			ID:     fmt.Sprintf("classid%d-%s", i, issuer),
+			Issuer: issuer.String(),
 			Features: []types.ClassFeature{
 				types.ClassFeature_freezing,
 				types.ClassFeature_whitelisting,
 			},
 			RoyaltyRate: sdk.MustNewDecFromStr(fmt.Sprintf("0.%d", (i+1)%10)),
+			MaxSupply:   uint64(100 + i),
+		}
+		if i%2 == 0 {
+			mintFee := sdk.NewInt64Coin("ucore", int64(i+1))
+			classDefinition.MintFee = &mintFee
+		}
+		if i%2 == 1 {
+			classDefinition.DataSchema = &types.DataSchema{JSONSchema: fmt.Sprintf(`{"maxProperties":%d}`, i)}
 		}
 
 		rawGenState.Classes = append(rawGenState.Classes, &rawnft.Class{
@@ -116,12 +125,68 @@ func TestInitAndExportGenesis(t *testing.T) {
 		})
 	}
 
+	// Class frozen accounts
+	var classFrozen []types.ClassFrozenAccounts
+	for i := 0; i < 5; i++ {
+		classFrozen = append(classFrozen, types.ClassFrozenAccounts{
+			ClassID: fmt.Sprintf("classid%d-%s", i, issuer),
+			Accounts: []string{
+				sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+				sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			},
+		})
+	}
+
+	// Frozen classes
+	frozenClasses := []string{
+		fmt.Sprintf("classid0-%s", issuer),
+		fmt.Sprintf("classid3-%s", issuer),
+	}
+
+	// Class whitelisted accounts
+	var classWhitelisted []types.ClassWhitelistedAccounts
+	for i := 0; i < 5; i++ {
+		classWhitelisted = append(classWhitelisted, types.ClassWhitelistedAccounts{
+			ClassID: fmt.Sprintf("classid%d-%s", i, issuer),
+			Accounts: []string{
+				sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+				sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			},
+		})
+	}
+
+	// NFT users
+	var nftUsers []types.NFTUser
+	for i := 0; i < 5; i++ {
+		nftUsers = append(nftUsers, types.NFTUser{
+			ClassId: fmt.Sprintf("classid%d-%s", i, issuer),
+			Id:      fmt.Sprintf("nft-id-1-%d", i),
+			User:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Expires: int64(1700000000 + i),
+		})
+	}
+
+	// class minters
+	var classMinters []types.ClassMinter
+	for i := 0; i < 5; i++ {
+		classMinters = append(classMinters, types.ClassMinter{
+			ClassId: fmt.Sprintf("classid%d-%s", i, issuer),
+			Minter:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Quota:   uint64(i + 1),
+		})
+	}
+
 	genState := types.GenesisState{
-		Params:                 types.DefaultParams(),
-		ClassDefinitions:       classDefinitions,
-		FrozenNFTs:             frozen,
-		WhitelistedNFTAccounts: whitelisted,
-		BurntNFTs:              burnt,
+		Params:                   types.DefaultParams(),
+		ClassDefinitions:         classDefinitions,
+		FrozenNFTs:               frozen,
+		WhitelistedNFTAccounts:   whitelisted,
+		BurntNFTs:                burnt,
+		ClassFrozenAccounts:      classFrozen,
+		FrozenClasses:            frozenClasses,
+		ClassWhitelistedAccounts: classWhitelisted,
+		NFTUsers:                 nftUsers,
+		ClassMinters:             classMinters,
 	}
 
 	// init the keeper
@@ -154,4 +219,23 @@ func TestInitAndExportGenesis(t *testing.T) {
 	}
 	assertT.ElementsMatch(genState.WhitelistedNFTAccounts, exportedGenState.WhitelistedNFTAccounts)
 	assertT.ElementsMatch(genState.BurntNFTs, exportedGenState.BurntNFTs)
+
+	for _, st := range genState.ClassFrozenAccounts {
+		sort.Strings(st.Accounts)
+	}
+	for _, st := range exportedGenState.ClassFrozenAccounts {
+		sort.Strings(st.Accounts)
+	}
+	assertT.ElementsMatch(genState.ClassFrozenAccounts, exportedGenState.ClassFrozenAccounts)
+	assertT.ElementsMatch(genState.FrozenClasses, exportedGenState.FrozenClasses)
+
+	for _, st := range genState.ClassWhitelistedAccounts {
+		sort.Strings(st.Accounts)
+	}
+	for _, st := range exportedGenState.ClassWhitelistedAccounts {
+		sort.Strings(st.Accounts)
+	}
+	assertT.ElementsMatch(genState.ClassWhitelistedAccounts, exportedGenState.ClassWhitelistedAccounts)
+	assertT.ElementsMatch(genState.NFTUsers, exportedGenState.NFTUsers)
+	assertT.ElementsMatch(genState.ClassMinters, exportedGenState.ClassMinters)
 }
@@ -10,5 +10,10 @@ func (k Keeper) BeforeTransfer(ctx sdk.Context, classID, nftID string, receiver
 		return err
 	}
 
-	return k.isNFTReceivable(ctx, classID, nftID, receiver)
+	if err := k.isNFTReceivable(ctx, classID, nftID, receiver); err != nil {
+		return err
+	}
+
+	// the user is granted by the current owner, so it is removed when the token changes the owner
+	return k.clearUser(ctx, classID, nftID)
 }
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/pkg/store"
+	"github.com/CoreumFoundation/coreum/x/asset"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// ClassFreeze freezes all the non-fungible tokens of the class held by the account.
+// If the account is nil the whole class is frozen.
+func (k Keeper) ClassFreeze(ctx sdk.Context, sender sdk.AccAddress, classID string, account sdk.AccAddress) error {
+	if err := k.checkClassFreezingAllowed(ctx, sender, classID, account); err != nil {
+		return err
+	}
+
+	if err := k.SetClassFrozen(ctx, classID, account, true); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventClassFrozen{
+		ClassId: classID,
+		Account: account.String(),
+	})
+}
+
+// ClassUnfreeze removes the class freezing of the account.
+// If the account is nil the freezing of the whole class is removed.
+func (k Keeper) ClassUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID string, account sdk.AccAddress) error {
+	if err := k.checkClassFreezingAllowed(ctx, sender, classID, account); err != nil {
+		return err
+	}
+
+	if err := k.SetClassFrozen(ctx, classID, account, false); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventClassUnfrozen{
+		ClassId: classID,
+		Account: account.String(),
+	})
+}
+
+// SetClassFrozen marks the class frozen for the account or the whole class if the account is nil,
+// but does not make any checks should not be used directly outside the module except for genesis.
+func (k Keeper) SetClassFrozen(ctx sdk.Context, classID string, account sdk.AccAddress, frozen bool) error {
+	key := types.CreateFrozenClassKey(classID)
+	if len(account) > 0 {
+		var err error
+		key, err = types.CreateClassFreezingKey(classID, account)
+		if err != nil {
+			return err
+		}
+	}
+
+	s := ctx.KVStore(k.storeKey)
+	if frozen {
+		s.Set(key, asset.StoreTrue)
+	} else {
+		s.Delete(key)
+	}
+	return nil
+}
+
+// IsClassFrozen returns whether the class is frozen for the account.
+// If the account is nil it returns whether the whole class is frozen.
+func (k Keeper) IsClassFrozen(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error) {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return false, err
+	}
+
+	if !classDefinition.IsFeatureEnabled(types.ClassFeature_freezing) {
+		return false, sdkerrors.Wrapf(types.ErrFeatureDisabled, `feature "freezing" is disabled`)
+	}
+
+	return k.isClassFrozen(ctx, classID, account)
+}
+
+// GetClassFrozenAccounts returns paginated accounts for which the class is frozen.
+func (k Keeper) GetClassFrozenAccounts(ctx sdk.Context, classID string, q *query.PageRequest) (*query.PageResponse, []string, error) {
+	if _, err := k.GetClassDefinition(ctx, classID); err != nil {
+		return nil, nil, err
+	}
+
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, nil, err
+	}
+	key := store.JoinKeys(types.NFTClassFreezingKeyPrefix, compositeKey)
+	accounts := []string{}
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), key),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return errors.Errorf("value stored in class freezing store is not %x, value %x", asset.StoreTrue, value)
+			}
+
+			account := sdk.AccAddress(key[1:]) // the first byte contains the length prefix
+			accounts = append(accounts, account.String())
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pageRes, accounts, nil
+}
+
+// GetAllClassFrozenAccounts returns paginated accounts for which the classes are frozen.
+func (k Keeper) GetAllClassFrozenAccounts(ctx sdk.Context, q *query.PageRequest) (*query.PageResponse, []types.ClassFrozenAccounts, error) {
+	mp := make(map[string][]string, 0)
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTClassFreezingKeyPrefix),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return errors.Errorf("value stored in class freezing store is not %x, value %x", asset.StoreTrue, value)
+			}
+			classID, account, err := types.ParseClassFreezingKey(key)
+			if err != nil {
+				return err
+			}
+
+			mp[classID] = append(mp[classID], account.String())
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	frozen := make([]types.ClassFrozenAccounts, 0, len(mp))
+	for classID, accounts := range mp {
+		frozen = append(frozen, types.ClassFrozenAccounts{
+			ClassID:  classID,
+			Accounts: accounts,
+		})
+	}
+
+	return pageRes, frozen, nil
+}
+
+// GetFrozenClasses returns paginated IDs of the classes frozen as a whole.
+func (k Keeper) GetFrozenClasses(ctx sdk.Context, q *query.PageRequest) (*query.PageResponse, []string, error) {
+	classIDs := []string{}
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTFrozenClassKeyPrefix),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return errors.Errorf("value stored in frozen class store is not %x, value %x", asset.StoreTrue, value)
+			}
+
+			classIDs = append(classIDs, string(key))
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pageRes, classIDs, nil
+}
+
+func (k Keeper) checkClassFreezingAllowed(ctx sdk.Context, sender sdk.AccAddress, classID string, account sdk.AccAddress) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if err = classDefinition.CheckFeatureAllowed(sender, types.ClassFeature_freezing); err != nil {
+		return err
+	}
+
+	if len(account) > 0 && classDefinition.IsIssuer(account) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "setting class freezing for the nft class issuer is forbidden")
+	}
+
+	return nil
+}
+
+func (k Keeper) isClassFrozen(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error) {
+	s := ctx.KVStore(k.storeKey)
+	if bytes.Equal(s.Get(types.CreateFrozenClassKey(classID)), asset.StoreTrue) {
+		return true, nil
+	}
+
+	if len(account) == 0 {
+		return false, nil
+	}
+
+	key, err := types.CreateClassFreezingKey(classID, account)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(s.Get(key), asset.StoreTrue), nil
+}
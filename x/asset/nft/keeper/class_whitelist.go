@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/pkg/store"
+	"github.com/CoreumFoundation/coreum/x/asset"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// IsClassWhitelisted checks to see if an account is whitelisted for all the NFTs of the class.
+func (k Keeper) IsClassWhitelisted(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error) {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return false, err
+	}
+
+	if !classDefinition.IsFeatureEnabled(types.ClassFeature_whitelisting) {
+		return false, sdkerrors.Wrapf(types.ErrFeatureDisabled, `feature "whitelisting" is disabled`)
+	}
+
+	return k.isClassWhitelisted(ctx, classID, account)
+}
+
+func (k Keeper) isClassWhitelisted(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error) {
+	key, err := types.CreateClassWhitelistingKey(classID, account)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(key), asset.StoreTrue), nil
+}
+
+// GetClassWhitelistedAccounts returns paginated accounts whitelisted for the class.
+func (k Keeper) GetClassWhitelistedAccounts(ctx sdk.Context, classID string, q *query.PageRequest) (*query.PageResponse, []string, error) {
+	if _, err := k.GetClassDefinition(ctx, classID); err != nil {
+		return nil, nil, err
+	}
+
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, nil, err
+	}
+	key := store.JoinKeys(types.NFTClassWhitelistingKeyPrefix, compositeKey)
+	accounts := []string{}
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), key),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return errors.Errorf("value stored in class whitelisting store is not %x, value %x", asset.StoreTrue, value)
+			}
+
+			account := sdk.AccAddress(key[1:]) // the first byte contains the length prefix
+			accounts = append(accounts, account.String())
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pageRes, accounts, nil
+}
+
+// GetAllClassWhitelisted returns paginated accounts whitelisted for all the classes.
+func (k Keeper) GetAllClassWhitelisted(ctx sdk.Context, q *query.PageRequest) (*query.PageResponse, []types.ClassWhitelistedAccounts, error) {
+	mp := make(map[string][]string, 0)
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTClassWhitelistingKeyPrefix),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, asset.StoreTrue) {
+				return errors.Errorf("value stored in class whitelisting store is not %x, value %x", asset.StoreTrue, value)
+			}
+			classID, account, err := types.ParseClassWhitelistingKey(key)
+			if err != nil {
+				return err
+			}
+
+			mp[classID] = append(mp[classID], account.String())
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	whitelisted := make([]types.ClassWhitelistedAccounts, 0, len(mp))
+	for classID, accounts := range mp {
+		whitelisted = append(whitelisted, types.ClassWhitelistedAccounts{
+			ClassID:  classID,
+			Accounts: accounts,
+		})
+	}
+
+	return pageRes, whitelisted, nil
+}
+
+// AddToClassWhitelist adds an account to the whitelisted list of accounts for all the NFTs of the class.
+func (k Keeper) AddToClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error {
+	if err := k.checkClassWhitelistingAllowed(ctx, classID, sender, account); err != nil {
+		return err
+	}
+
+	if err := k.SetClassWhitelisting(ctx, classID, account, true); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAddedToClassWhitelist{
+		ClassId: classID,
+		Account: account.String(),
+	})
+}
+
+// RemoveFromClassWhitelist removes an account from the whitelisted list of accounts for the class.
+func (k Keeper) RemoveFromClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error {
+	if err := k.checkClassWhitelistingAllowed(ctx, classID, sender, account); err != nil {
+		return err
+	}
+
+	if err := k.SetClassWhitelisting(ctx, classID, account, false); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRemovedFromClassWhitelist{
+		ClassId: classID,
+		Account: account.String(),
+	})
+}
+
+// SetClassWhitelisting adds an account to the whitelisting of the class, if whitelisting is true
+// and removes it, if whitelisting is false.
+func (k Keeper) SetClassWhitelisting(ctx sdk.Context, classID string, account sdk.AccAddress, whitelisting bool) error {
+	key, err := types.CreateClassWhitelistingKey(classID, account)
+	if err != nil {
+		return err
+	}
+	s := ctx.KVStore(k.storeKey)
+	if whitelisting {
+		s.Set(key, asset.StoreTrue)
+	} else {
+		s.Delete(key)
+	}
+	return nil
+}
+
+func (k Keeper) checkClassWhitelistingAllowed(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if err = classDefinition.CheckFeatureAllowed(sender, types.ClassFeature_whitelisting); err != nil {
+		return err
+	}
+
+	if classDefinition.IsIssuer(account) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "setting class whitelisting for the nft class issuer is forbidden")
+	}
+
+	return nil
+}
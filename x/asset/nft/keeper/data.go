@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// GetNFTData returns the type URL of the NFT data and the data decoded to JSON according to the data schema of the class.
+// For the class with the JSON schema the stored JSON document is returned.
+func (k Keeper) GetNFTData(ctx sdk.Context, classID, nftID string) (typeURL, json string, err error) {
+	definition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return "", "", err
+	}
+
+	token, found := k.nftKeeper.GetNFT(ctx, classID, nftID)
+	if !found {
+		return "", "", sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	}
+
+	if token.Data == nil {
+		return "", "", nil
+	}
+
+	if definition.DataSchema != nil && definition.DataSchema.JSONSchema != "" {
+		var dataBytes types.DataBytes
+		if err := k.cdc.Unmarshal(token.Data.Value, &dataBytes); err != nil {
+			return "", "", sdkerrors.Wrapf(types.ErrInvalidInput, "can't unmarshal nft data: %s", err)
+		}
+		return token.Data.TypeUrl, string(dataBytes.Data), nil
+	}
+
+	msg, err := k.interfaceRegistry.Resolve(token.Data.TypeUrl)
+	if err != nil {
+		return "", "", sdkerrors.Wrapf(types.ErrInvalidInput, "can't resolve nft data type %q: %s", token.Data.TypeUrl, err)
+	}
+	if err := proto.Unmarshal(token.Data.Value, msg); err != nil {
+		return "", "", sdkerrors.Wrapf(types.ErrInvalidInput, "can't unmarshal nft data: %s", err)
+	}
+	bz, err := codec.ProtoMarshalJSON(msg, k.interfaceRegistry)
+	if err != nil {
+		return "", "", sdkerrors.Wrapf(types.ErrInvalidInput, "can't marshal nft data to JSON: %s", err)
+	}
+
+	return token.Data.TypeUrl, string(bz), nil
+}
+
+// compileJSONSchema compiles the JSON schema of the class, nil is returned if the class doesn't use the JSON schema.
+// The schema is compiled once per message and used to check the data of all its NFTs.
+func compileJSONSchema(definition types.ClassDefinition) (*types.JSONSchema, error) {
+	if definition.DataSchema == nil || definition.DataSchema.JSONSchema == "" {
+		return nil, nil
+	}
+	return definition.DataSchema.CompileJSONSchema()
+}
+
+// checkNFTData checks the NFT data follows the data schema of the class.
+// The missing data is validated against the JSON schema as the empty object and is rejected if the class requires
+// the protobuf type.
+func (k Keeper) checkNFTData(definition types.ClassDefinition, jsonSchema *types.JSONSchema, data *codectypes.Any) error {
+	schema := definition.DataSchema
+	if data == nil {
+		switch {
+		case schema == nil:
+			return nil
+		case schema.JSONSchema != "":
+			return jsonSchema.Validate([]byte("{}"))
+		default:
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "data field must contain %s type", schema.TypeURL)
+		}
+	}
+
+	if schema == nil || schema.JSONSchema != "" {
+		// the unstructured data and the JSON documents are stored as DataBytes
+		if err := types.ValidateData(data); err != nil {
+			return err
+		}
+		if schema == nil {
+			return nil
+		}
+
+		var dataBytes types.DataBytes
+		if err := k.cdc.Unmarshal(data.Value, &dataBytes); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid data: %s", err)
+		}
+		return jsonSchema.Validate(dataBytes.Data)
+	}
+
+	if data.TypeUrl != schema.TypeURL {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "data field must contain %s type", schema.TypeURL)
+	}
+
+	msg, err := k.interfaceRegistry.Resolve(data.TypeUrl)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "data type %q is not registered", data.TypeUrl)
+	}
+	if err := unknownproto.RejectUnknownFieldsStrict(data.Value, msg, k.interfaceRegistry); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid data: %s", err)
+	}
+	if err := proto.Unmarshal(data.Value, msg); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid data: %s", err)
+	}
+
+	return nil
+}
@@ -19,6 +19,16 @@ type QueryKeeper interface {
 	IsFrozen(ctx sdk.Context, classID, nftID string) (bool, error)
 	IsWhitelisted(ctx sdk.Context, classID, nftID string, account sdk.AccAddress) (bool, error)
 	GetAllWhitelistedAccountsForNFT(ctx sdk.Context, classID, nftID string, q *query.PageRequest) (*query.PageResponse, []string, error)
+	IsClassFrozen(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error)
+	GetClassFrozenAccounts(ctx sdk.Context, classID string, q *query.PageRequest) (*query.PageResponse, []string, error)
+	IsClassWhitelisted(ctx sdk.Context, classID string, account sdk.AccAddress) (bool, error)
+	GetClassWhitelistedAccounts(ctx sdk.Context, classID string, q *query.PageRequest) (*query.PageResponse, []string, error)
+	GetClasses(ctx sdk.Context, issuer sdk.AccAddress, features []types.ClassFeature, pagination *query.PageRequest) ([]types.Class, *query.PageResponse, error)
+	GetOwnerNFTs(ctx sdk.Context, owner sdk.AccAddress, classID string, q *query.PageRequest) (*query.PageResponse, []types.OwnerNFT, error)
+	GetUser(ctx sdk.Context, classID, nftID string) (types.NFTUser, bool, error)
+	GetClassSupply(ctx sdk.Context, classID string) (supply, burnt, maxSupply uint64, err error)
+	GetClassMinters(ctx sdk.Context, classID string, q *query.PageRequest) (*query.PageResponse, []types.ClassMinter, error)
+	GetNFTData(ctx sdk.Context, classID, nftID string) (typeURL, json string, err error)
 }
 
 // QueryService serves grpc query requests for assetsnft module.
@@ -52,6 +62,28 @@ func (qs QueryService) Class(ctx context.Context, req *types.QueryClassRequest)
 	}, nil
 }
 
+// Classes queries the non-fungible token classes filtered by the issuer and the features.
+func (qs QueryService) Classes(ctx context.Context, req *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	var issuer sdk.AccAddress
+	if req.Issuer != "" {
+		var err error
+		issuer, err = sdk.AccAddressFromBech32(req.Issuer)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid issuer")
+		}
+	}
+
+	classes, pageRes, err := qs.keeper.GetClasses(sdk.UnwrapSDKContext(ctx), issuer, req.Features, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassesResponse{
+		Pagination: pageRes,
+		Classes:    classes,
+	}, nil
+}
+
 // Frozen returns whether NFT is frozen or not.
 func (qs QueryService) Frozen(ctx context.Context, req *types.QueryFrozenRequest) (*types.QueryFrozenResponse, error) {
 	frozen, err := qs.keeper.IsFrozen(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Id)
@@ -84,3 +116,133 @@ func (qs QueryService) WhitelistedAccountsForNFT(ctx context.Context, req *types
 		Accounts:   accounts,
 	}, err
 }
+
+// ClassFrozen returns whether the class is frozen for the account or as a whole.
+func (qs QueryService) ClassFrozen(ctx context.Context, req *types.QueryClassFrozenRequest) (*types.QueryClassFrozenResponse, error) {
+	var account sdk.AccAddress
+	if req.Account != "" {
+		var err error
+		account, err = sdk.AccAddressFromBech32(req.Account)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+		}
+	}
+
+	frozen, err := qs.keeper.IsClassFrozen(sdk.UnwrapSDKContext(ctx), req.ClassId, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassFrozenResponse{
+		Frozen: frozen,
+	}, nil
+}
+
+// ClassFrozenAccounts returns the list of accounts for which the class is frozen.
+func (qs QueryService) ClassFrozenAccounts(ctx context.Context, req *types.QueryClassFrozenAccountsRequest) (*types.QueryClassFrozenAccountsResponse, error) {
+	pageRes, accounts, err := qs.keeper.GetClassFrozenAccounts(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Pagination)
+	return &types.QueryClassFrozenAccountsResponse{
+		Pagination: pageRes,
+		Accounts:   accounts,
+	}, err
+}
+
+// ClassWhitelisted checks to see if an account is whitelisted for all the NFTs of the class.
+func (qs QueryService) ClassWhitelisted(ctx context.Context, req *types.QueryClassWhitelistedRequest) (*types.QueryClassWhitelistedResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+
+	whitelisted, err := qs.keeper.IsClassWhitelisted(sdk.UnwrapSDKContext(ctx), req.ClassId, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassWhitelistedResponse{
+		Whitelisted: whitelisted,
+	}, nil
+}
+
+// ClassWhitelistedAccounts returns the list of accounts whitelisted for all the NFTs of the class.
+func (qs QueryService) ClassWhitelistedAccounts(ctx context.Context, req *types.QueryClassWhitelistedAccountsRequest) (*types.QueryClassWhitelistedAccountsResponse, error) {
+	pageRes, accounts, err := qs.keeper.GetClassWhitelistedAccounts(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Pagination)
+	return &types.QueryClassWhitelistedAccountsResponse{
+		Pagination: pageRes,
+		Accounts:   accounts,
+	}, err
+}
+
+// OwnerNFTs returns the non-fungible tokens held by the owner together with their class settings and state.
+func (qs QueryService) OwnerNFTs(ctx context.Context, req *types.QueryOwnerNFTsRequest) (*types.QueryOwnerNFTsResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid owner")
+	}
+
+	pageRes, nfts, err := qs.keeper.GetOwnerNFTs(sdk.UnwrapSDKContext(ctx), owner, req.ClassId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOwnerNFTsResponse{
+		Pagination: pageRes,
+		Nfts:       nfts,
+	}, nil
+}
+
+// User returns the current user of the NFT.
+func (qs QueryService) User(ctx context.Context, req *types.QueryUserRequest) (*types.QueryUserResponse, error) {
+	nftUser, found, err := qs.keeper.GetUser(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &types.QueryUserResponse{}, nil
+	}
+
+	return &types.QueryUserResponse{
+		User:    nftUser.User,
+		Expires: nftUser.Expires,
+	}, nil
+}
+
+// ClassSupply returns the current and the maximum supply of the class.
+func (qs QueryService) ClassSupply(ctx context.Context, req *types.QueryClassSupplyRequest) (*types.QueryClassSupplyResponse, error) {
+	supply, burnt, maxSupply, err := qs.keeper.GetClassSupply(sdk.UnwrapSDKContext(ctx), req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassSupplyResponse{
+		Supply:    supply,
+		Burnt:     burnt,
+		MaxSupply: maxSupply,
+	}, nil
+}
+
+// ClassMinters returns the minters of the class.
+func (qs QueryService) ClassMinters(ctx context.Context, req *types.QueryClassMintersRequest) (*types.QueryClassMintersResponse, error) {
+	pageRes, minters, err := qs.keeper.GetClassMinters(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassMintersResponse{
+		Pagination: pageRes,
+		Minters:    minters,
+	}, nil
+}
+
+// NFTData returns the data of the NFT decoded according to the data schema of its class.
+func (qs QueryService) NFTData(ctx context.Context, req *types.QueryNFTDataRequest) (*types.QueryNFTDataResponse, error) {
+	typeURL, json, err := qs.keeper.GetNFTData(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNFTDataResponse{
+		TypeUrl: typeURL,
+		Json:    json,
+	}, nil
+}
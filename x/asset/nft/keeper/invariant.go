@@ -13,12 +13,18 @@ import (
 const (
 	OriginalClassExistsInvariantName = "original-class-exists"
 	FreezingInvariantName            = "freezing"
+	ClassWhitelistingInvariantName   = "class-whitelisting"
+	SupplyInvariantName              = "supply"
+	ClassMintersInvariantName        = "class-minters"
 )
 
 // RegisterInvariants registers the bank module invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, OriginalClassExistsInvariantName, OriginalClassExistsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, FreezingInvariantName, FreezingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, ClassWhitelistingInvariantName, ClassWhitelistingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, SupplyInvariantName, SupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, ClassMintersInvariantName, ClassMintersInvariant(k))
 }
 
 // FreezingInvariant checks that all frozen NFTs have counterpart on the original Cosmos SDK NFT module.
@@ -62,6 +68,128 @@ func FreezingInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// ClassWhitelistingInvariant checks that class whitelisting entries exist only for the classes with whitelisting enabled.
+func ClassWhitelistingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg             string
+			violationsCount int
+		)
+
+		_, classWhitelisted, err := k.GetAllClassWhitelisted(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+		for _, whitelisted := range classWhitelisted {
+			classDefinition, err := k.GetClassDefinition(ctx, whitelisted.ClassID)
+			if types.ErrClassNotFound.Is(err) {
+				violationsCount++
+				msg += fmt.Sprintf("\t class definition not found for class whitelisting of %s\n", whitelisted.ClassID)
+				continue
+			} else if err != nil {
+				panic(err)
+			}
+
+			if !classDefinition.IsFeatureEnabled(types.ClassFeature_whitelisting) {
+				violationsCount++
+				msg += fmt.Sprintf("\t whitelisting is disabled, but class %s has whitelisted accounts\n", whitelisted.ClassID)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, ClassWhitelistingInvariantName,
+			fmt.Sprintf("number of invariant violation %d\n%s", violationsCount, msg),
+		), violationsCount != 0
+	}
+}
+
+// SupplyInvariant checks that the burnt NFTs are counted correctly and the supply of the classes doesn't exceed
+// their max supply.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg             string
+			violationsCount int
+		)
+
+		_, burntNFTs, err := k.GetBurntNFTs(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+		burntCounts := make(map[string]uint64, len(burntNFTs))
+		for _, burnt := range burntNFTs {
+			burntCounts[burnt.ClassID] += uint64(len(burnt.NftIDs))
+		}
+
+		classDefinitions, _, err := k.GetClassDefinitions(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+		for _, classDefinition := range classDefinitions {
+			burntCount := k.GetBurntCount(ctx, classDefinition.ID)
+			if burntCount != burntCounts[classDefinition.ID] {
+				violationsCount++
+				msg += fmt.Sprintf(
+					"\t burnt count %d of class %s doesn't match the number of burnt nfts %d\n",
+					burntCount, classDefinition.ID, burntCounts[classDefinition.ID],
+				)
+			}
+
+			if classDefinition.MaxSupply == 0 {
+				continue
+			}
+			minted := k.nftKeeper.GetTotalSupply(ctx, classDefinition.ID) + burntCount
+			if minted > classDefinition.MaxSupply {
+				violationsCount++
+				msg += fmt.Sprintf(
+					"\t %d nfts are minted in class %s exceeding its max supply %d\n",
+					minted, classDefinition.ID, classDefinition.MaxSupply,
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, SupplyInvariantName,
+			fmt.Sprintf("number of invariant violation %d\n%s", violationsCount, msg),
+		), violationsCount != 0
+	}
+}
+
+// ClassMintersInvariant checks that the minters exist only for the existing classes and the issuer is not a minter.
+func ClassMintersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg             string
+			violationsCount int
+		)
+
+		_, classMinters, err := k.GetAllClassMinters(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+		for _, classMinter := range classMinters {
+			classDefinition, err := k.GetClassDefinition(ctx, classMinter.ClassId)
+			if types.ErrClassNotFound.Is(err) {
+				violationsCount++
+				msg += fmt.Sprintf("\t class definition not found for minter %s of %s\n", classMinter.Minter, classMinter.ClassId)
+				continue
+			} else if err != nil {
+				panic(err)
+			}
+
+			if classDefinition.Issuer == classMinter.Minter {
+				violationsCount++
+				msg += fmt.Sprintf("\t issuer %s is the minter of class %s\n", classMinter.Minter, classMinter.ClassId)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, ClassMintersInvariantName,
+			fmt.Sprintf("number of invariant violation %d\n%s", violationsCount, msg),
+		), violationsCount != 0
+	}
+}
+
 // OriginalClassExistsInvariant checks that all the registered Classes have counterpart on the original Cosmos SDK NFT module.
 func OriginalClassExistsInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
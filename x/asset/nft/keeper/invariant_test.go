@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/CoreumFoundation/coreum/pkg/config/constant"
 	"github.com/CoreumFoundation/coreum/testutil/simapp"
 	"github.com/CoreumFoundation/coreum/x/asset/nft/keeper"
 	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
@@ -86,3 +87,101 @@ func TestFrozenNFTExistsInvariant(t *testing.T) {
 	_, isBroken = keeper.FreezingInvariant(assetNFTKeeper)(ctx)
 	requireT.True(isBroken)
 }
+
+func TestClassWhitelistingInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Issue a class with whitelisting
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:   issuer,
+		Symbol:   "DEF",
+		Features: []types.ClassFeature{types.ClassFeature_whitelisting},
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.AddToClassWhitelist(ctx, classID, issuer, account))
+
+	// invariant is valid
+	_, isBroken := keeper.ClassWhitelistingInvariant(assetNFTKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// Issue a class without whitelisting
+	classID, err = assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "GHI",
+	})
+	requireT.NoError(err)
+
+	// whitelisting is disabled (invariant is broken)
+	requireT.NoError(assetNFTKeeper.SetClassWhitelisting(ctx, classID, account, true))
+	_, isBroken = keeper.ClassWhitelistingInvariant(assetNFTKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
+func TestSupplyInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	assetNFTKeeper.SetParams(ctx, types.Params{MintFee: sdk.NewInt64Coin(constant.DenomDev, 0)})
+
+	// Issue a class with the max supply and reach it
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:    issuer,
+		Symbol:    "DEF",
+		Features:  []types.ClassFeature{types.ClassFeature_burning},
+		MaxSupply: 2,
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.MintBatch(ctx, []types.MintSettings{
+		{Sender: issuer, ClassID: classID, ID: "nft-id-1"},
+		{Sender: issuer, ClassID: classID, ID: "nft-id-2"},
+	}))
+	requireT.NoError(assetNFTKeeper.Burn(ctx, issuer, classID, "nft-id-2"))
+
+	// invariant is valid
+	_, isBroken := keeper.SupplyInvariant(assetNFTKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// the burnt nft exceeds the max supply (invariant is broken)
+	requireT.NoError(assetNFTKeeper.SetBurnt(ctx, classID, "nft-id-3"))
+	_, isBroken = keeper.SupplyInvariant(assetNFTKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
+func TestClassMintersInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "DEF",
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.GrantMinter(ctx, classID, issuer, minter, 1))
+
+	// invariant is valid
+	_, isBroken := keeper.ClassMintersInvariant(assetNFTKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// the issuer is the minter (invariant is broken)
+	requireT.NoError(assetNFTKeeper.SetClassMinter(ctx, types.ClassMinter{
+		ClassId: classID,
+		Minter:  issuer.String(),
+		Quota:   1,
+	}))
+	_, isBroken = keeper.ClassMintersInvariant(assetNFTKeeper)(ctx)
+	requireT.True(isBroken)
+}
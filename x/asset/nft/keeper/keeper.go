@@ -4,6 +4,7 @@ import (
 	"bytes"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -25,27 +26,30 @@ type ParamSubspace interface {
 
 // Keeper is the asset module non-fungible token nftKeeper.
 type Keeper struct {
-	cdc           codec.BinaryCodec
-	paramSubspace ParamSubspace
-	storeKey      sdk.StoreKey
-	nftKeeper     types.NFTKeeper
-	bankKeeper    types.BankKeeper
+	cdc               codec.BinaryCodec
+	interfaceRegistry codectypes.InterfaceRegistry
+	paramSubspace     ParamSubspace
+	storeKey          sdk.StoreKey
+	nftKeeper         types.NFTKeeper
+	bankKeeper        types.BankKeeper
 }
 
 // NewKeeper creates a new instance of the Keeper.
 func NewKeeper(
 	cdc codec.BinaryCodec,
+	interfaceRegistry codectypes.InterfaceRegistry,
 	paramSubspace ParamSubspace,
 	storeKey sdk.StoreKey,
 	nftKeeper types.NFTKeeper,
 	bankKeeper types.BankKeeper,
 ) Keeper {
 	return Keeper{
-		cdc:           cdc,
-		paramSubspace: paramSubspace,
-		storeKey:      storeKey,
-		nftKeeper:     nftKeeper,
-		bankKeeper:    bankKeeper,
+		cdc:               cdc,
+		interfaceRegistry: interfaceRegistry,
+		paramSubspace:     paramSubspace,
+		storeKey:          storeKey,
+		nftKeeper:         nftKeeper,
+		bankKeeper:        bankKeeper,
 	}
 }
 
@@ -68,9 +72,65 @@ func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, error) {
 		return types.Class{}, err
 	}
 
-	class, found := k.nftKeeper.GetClass(ctx, classID)
+	return k.getClassFullInfo(ctx, definition)
+}
+
+// GetClasses returns the paginated non-fungible token classes filtered by the issuer and the features.
+// If the issuer is nil the classes of all the issuers are returned.
+func (k Keeper) GetClasses(
+	ctx sdk.Context,
+	issuer sdk.AccAddress,
+	features []types.ClassFeature,
+	pagination *query.PageRequest,
+) ([]types.Class, *query.PageResponse, error) {
+	var (
+		definitionStore = prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTClassKeyPrefix)
+		paginationStore = definitionStore
+		classes         []types.Class
+	)
+	if len(issuer) > 0 {
+		paginationStore = prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateIssuerClassesPrefix(issuer))
+	}
+
+	pageRes, err := query.FilteredPaginate(paginationStore, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var definition types.ClassDefinition
+		if len(issuer) > 0 {
+			value = definitionStore.Get(key)
+			if value == nil {
+				return false, sdkerrors.Wrapf(types.ErrClassNotFound, "classID: %s", string(key))
+			}
+		}
+		if err := k.cdc.Unmarshal(value, &definition); err != nil {
+			return false, err
+		}
+
+		for _, feature := range features {
+			if !definition.IsFeatureEnabled(feature) {
+				return false, nil
+			}
+		}
+
+		if accumulate {
+			class, err := k.getClassFullInfo(ctx, definition)
+			if err != nil {
+				return false, err
+			}
+			classes = append(classes, class)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return classes, pageRes, nil
+}
+
+func (k Keeper) getClassFullInfo(ctx sdk.Context, definition types.ClassDefinition) (types.Class, error) {
+	class, found := k.nftKeeper.GetClass(ctx, definition.ID)
 	if !found {
-		return types.Class{}, sdkerrors.Wrapf(types.ErrClassNotFound, "nft class with ID:%s not found", classID)
+		return types.Class{}, sdkerrors.Wrapf(types.ErrClassNotFound, "nft class with ID:%s not found", definition.ID)
 	}
 
 	return types.Class{
@@ -84,6 +144,9 @@ func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, error) {
 		Data:        class.Data,
 		Features:    definition.Features,
 		RoyaltyRate: definition.RoyaltyRate,
+		MaxSupply:   definition.MaxSupply,
+		MintFee:     definition.MintFee,
+		DataSchema:  definition.DataSchema,
 	}, nil
 }
 
@@ -97,6 +160,21 @@ func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (
 		return "", err
 	}
 
+	if err := types.ValidateMintFee(settings.MintFee); err != nil {
+		return "", err
+	}
+
+	if err := types.ValidateDataSchema(settings.DataSchema); err != nil {
+		return "", err
+	}
+
+	// the data type must be registered to be able to decode the data in the queries and the genesis export
+	if settings.DataSchema != nil && settings.DataSchema.TypeURL != "" {
+		if _, err := k.interfaceRegistry.Resolve(settings.DataSchema.TypeURL); err != nil {
+			return "", sdkerrors.Wrapf(types.ErrInvalidInput, "data type %q is not registered", settings.DataSchema.TypeURL)
+		}
+	}
+
 	id := types.BuildClassID(settings.Symbol, settings.Issuer)
 	if err := nft.ValidateClassID(id); err != nil {
 		return "", sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
@@ -133,6 +211,9 @@ func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (
 		Issuer:      settings.Issuer.String(),
 		Features:    settings.Features,
 		RoyaltyRate: settings.RoyaltyRate,
+		MaxSupply:   settings.MaxSupply,
+		MintFee:     settings.MintFee,
+		DataSchema:  settings.DataSchema,
 	})
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventClassIssued{
@@ -145,6 +226,9 @@ func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (
 		URIHash:     settings.URIHash,
 		Features:    settings.Features,
 		RoyaltyRate: settings.RoyaltyRate,
+		MaxSupply:   settings.MaxSupply,
+		MintFee:     settings.MintFee,
+		DataSchema:  settings.DataSchema,
 	}); err != nil {
 		return "", sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventClassIssued: %s", err)
 	}
@@ -191,65 +275,144 @@ func (k Keeper) GetClassDefinitions(ctx sdk.Context, pagination *query.PageReque
 	return definitions, pageRes, err
 }
 
-// SetClassDefinition stores the ClassDefinition.
+// SetClassDefinition stores the ClassDefinition and indexes it by the issuer.
 func (k Keeper) SetClassDefinition(ctx sdk.Context, definition types.ClassDefinition) {
-	ctx.KVStore(k.storeKey).Set(types.CreateClassKey(definition.ID), k.cdc.MustMarshal(&definition))
+	s := ctx.KVStore(k.storeKey)
+	s.Set(types.CreateClassKey(definition.ID), k.cdc.MustMarshal(&definition))
+	s.Set(types.CreateIssuerClassKey(sdk.MustAccAddressFromBech32(definition.Issuer), definition.ID), asset.StoreTrue)
+}
+
+// IterateAllClassDefinitions iterates over all class definitions and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllClassDefinitions(ctx sdk.Context, cb func(types.ClassDefinition) bool) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTClassKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var definition types.ClassDefinition
+		k.cdc.MustUnmarshal(iterator.Value(), &definition)
+
+		if cb(definition) {
+			break
+		}
+	}
 }
 
 // Mint mints new non-fungible token.
 func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
-	if err := types.ValidateTokenID(settings.ID); err != nil {
-		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	return k.MintBatch(ctx, []types.MintSettings{settings})
+}
+
+// MintBatch mints new non-fungible tokens of the same class issued by the same sender.
+// The class checks and the mint fee payment are done once for the whole batch.
+func (k Keeper) MintBatch(ctx sdk.Context, settings []types.MintSettings) error {
+	if len(settings) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "at least one nft must be provided")
 	}
 
-	if err := types.ValidateData(settings.Data); err != nil {
-		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	sender, classID := settings[0].Sender, settings[0].ClassID
+	for _, s := range settings {
+		if !s.Sender.Equals(sender) || s.ClassID != classID {
+			return sdkerrors.Wrap(types.ErrInvalidInput, "all nfts in the batch must be minted by the same sender in the same class")
+		}
+
+		if err := types.ValidateTokenID(s.ID); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+		}
+
+		if err := types.ValidateNFTData(s.Data); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+		}
 	}
 
-	definition, err := k.GetClassDefinition(ctx, settings.ClassID)
+	definition, err := k.GetClassDefinition(ctx, classID)
 	if err != nil {
 		return err
 	}
 
-	if !definition.IsIssuer(settings.Sender) {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to perform the mint operation", settings.Sender.String())
+	jsonSchema, err := compileJSONSchema(definition)
+	if err != nil {
+		return err
+	}
+	for _, s := range settings {
+		if err := k.checkNFTData(definition, jsonSchema, s.Data); err != nil {
+			return err
+		}
 	}
 
-	if !k.nftKeeper.HasClass(ctx, settings.ClassID) {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "classID %q not found", settings.ClassID)
+	// the minter uses its quota and pays the class mint fee, the issuer is allowed to mint without limits
+	if !definition.IsIssuer(sender) {
+		if err := k.useMinterQuota(ctx, classID, sender, uint64(len(settings))); err != nil {
+			return err
+		}
+		if err := k.payClassMintFee(ctx, definition, sender, len(settings)); err != nil {
+			return err
+		}
 	}
 
-	if k.nftKeeper.HasNFT(ctx, settings.ClassID, settings.ID) {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "ID %q already defined for the class", settings.ID)
+	if !k.nftKeeper.HasClass(ctx, classID) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "classID %q not found", classID)
 	}
 
-	burnt, err := k.IsBurnt(ctx, settings.ClassID, settings.ID)
-	if err != nil {
-		return err
+	for _, s := range settings {
+		if k.nftKeeper.HasNFT(ctx, classID, s.ID) {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "ID %q already defined for the class", s.ID)
+		}
+
+		burnt, err := k.IsBurnt(ctx, classID, s.ID)
+		if err != nil {
+			return err
+		}
+		if burnt {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "ID %q has been burned for the class", s.ID)
+		}
 	}
-	if burnt {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "ID %q has been burned for the class", settings.ID)
+
+	if definition.MaxSupply > 0 {
+		minted := k.nftKeeper.GetTotalSupply(ctx, classID) + k.GetBurntCount(ctx, classID)
+		if minted+uint64(len(settings)) > definition.MaxSupply {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"minting %d nfts exceeds the max supply %d of the class, %d nfts are already minted",
+				len(settings), definition.MaxSupply, minted,
+			)
+		}
 	}
 
 	params := k.GetParams(ctx)
 	if params.MintFee.IsPositive() {
-		coinsToBurn := sdk.NewCoins(params.MintFee)
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, settings.Sender, types.ModuleName, coinsToBurn); err != nil {
-			return sdkerrors.Wrapf(err, "can't send coins from account %s to module %s", settings.Sender.String(), types.ModuleName)
+		coinsToBurn := sdk.NewCoins(sdk.NewCoin(params.MintFee.Denom, params.MintFee.Amount.MulRaw(int64(len(settings)))))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coinsToBurn); err != nil {
+			return sdkerrors.Wrapf(err, "can't send coins from account %s to module %s", sender.String(), types.ModuleName)
 		}
 		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn); err != nil {
 			return sdkerrors.Wrapf(err, "can't burn %s for the module %s", coinsToBurn.String(), types.ModuleName)
 		}
 	}
 
-	if err := k.nftKeeper.Mint(ctx, nft.NFT{
-		ClassId: settings.ClassID,
-		Id:      settings.ID,
-		Uri:     settings.URI,
-		UriHash: settings.URIHash,
-		Data:    settings.Data,
-	}, settings.Sender); err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't save non-fungible token: %s", err)
+	for _, s := range settings {
+		recipient := sender
+		if !s.Recipient.Empty() {
+			recipient = s.Recipient
+		}
+
+		if err := k.nftKeeper.Mint(ctx, nft.NFT{
+			ClassId: classID,
+			Id:      s.ID,
+			Uri:     s.URI,
+			UriHash: s.URIHash,
+			Data:    s.Data,
+		}, recipient); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "can't save non-fungible token: %s", err)
+		}
+
+		// the token minted to an account other than the issuer is treated as received by it,
+		// the checks are done after the mint since the whitelisting is verified for an existing token
+		if !definition.IsIssuer(recipient) {
+			if err := k.checkNFTReceivable(ctx, definition, s.ID, recipient); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -257,52 +420,84 @@ func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
 
 // Burn burns non-fungible token.
 func (k Keeper) Burn(ctx sdk.Context, owner sdk.AccAddress, classID, id string) error {
+	return k.BurnBatch(ctx, owner, classID, []string{id})
+}
+
+// BurnBatch burns non-fungible tokens of the same class.
+// The class checks are done once for the whole batch and all the tokens are verified before any of them is burnt.
+func (k Keeper) BurnBatch(ctx sdk.Context, owner sdk.AccAddress, classID string, ids []string) error {
 	ndfd, err := k.GetClassDefinition(ctx, classID)
 	if err != nil {
 		return err
 	}
 
-	if err = ndfd.CheckFeatureAllowed(owner, types.ClassFeature_burning); err != nil {
-		return err
+	// the holder of the soulbound token is always allowed to get rid of it
+	if !ndfd.IsFeatureEnabled(types.ClassFeature_soulbound) {
+		if err = ndfd.CheckFeatureAllowed(owner, types.ClassFeature_burning); err != nil {
+			return err
+		}
 	}
 
-	if !k.nftKeeper.HasNFT(ctx, classID, id) {
-		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, id)
-	}
+	for _, id := range ids {
+		if !k.nftKeeper.HasNFT(ctx, classID, id) {
+			return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, id)
+		}
 
-	if k.nftKeeper.GetOwner(ctx, classID, id).String() != owner.String() {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only owner can burn the nft")
-	}
+		if k.nftKeeper.GetOwner(ctx, classID, id).String() != owner.String() {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only owner can burn the nft")
+		}
 
-	if err := k.checkBurnable(ctx, owner, ndfd, classID, id); err != nil {
-		return err
+		if err := k.checkBurnable(ctx, owner, ndfd, id); err != nil {
+			return err
+		}
 	}
 
-	// If the token is burnt the storage needs to be cleaned up.
-	// We clean freezing because it's a single record only.
-	// We don't clean whitelisting because potential number of records is unlimited.
-	if err := k.SetFrozen(ctx, classID, id, false); err != nil {
-		return err
-	}
+	for _, id := range ids {
+		// If the token is burnt the storage needs to be cleaned up.
+		// We clean freezing because it's a single record only.
+		// We don't clean whitelisting because potential number of records is unlimited.
+		if err := k.SetFrozen(ctx, classID, id, false); err != nil {
+			return err
+		}
 
-	if err := k.nftKeeper.Burn(ctx, classID, id); err != nil {
-		return err
+		if err := k.clearUser(ctx, classID, id); err != nil {
+			return err
+		}
+
+		if err := k.nftKeeper.Burn(ctx, classID, id); err != nil {
+			return err
+		}
+
+		if err := k.SetBurnt(ctx, classID, id); err != nil {
+			return err
+		}
 	}
 
-	return k.SetBurnt(ctx, classID, id)
+	return nil
 }
 
-func (k Keeper) checkBurnable(ctx sdk.Context, owner sdk.AccAddress, ndfd types.ClassDefinition, classID, nftID string) error {
-	frozen, err := k.IsFrozen(ctx, classID, nftID)
-	if err != nil && !errors.Is(err, types.ErrFeatureDisabled) {
-		return err
+func (k Keeper) checkBurnable(ctx sdk.Context, owner sdk.AccAddress, ndfd types.ClassDefinition, nftID string) error {
+	// non issuer is not allowed to burn frozen NFT, but the issuer can
+	if !ndfd.IsFeatureEnabled(types.ClassFeature_freezing) || owner.String() == ndfd.Issuer {
+		return nil
 	}
 
-	// non issuer is not allowed to burn frozen NFT, but the issuer can
-	if frozen && owner.String() != ndfd.Issuer {
+	frozen, err := k.isFrozen(ctx, ndfd.ID, nftID)
+	if err != nil {
+		return err
+	}
+	if frozen {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "frozen token cannot be burnt")
 	}
 
+	classFrozen, err := k.isClassFrozen(ctx, ndfd.ID, owner)
+	if err != nil {
+		return err
+	}
+	if classFrozen {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "token of the frozen class cannot be burnt")
+	}
+
 	return nil
 }
 
@@ -335,9 +530,33 @@ func (k Keeper) SetBurnt(ctx sdk.Context, classID, nftID string) error {
 		return err
 	}
 	ctx.KVStore(k.storeKey).Set(key, asset.StoreTrue)
+	k.setBurntCount(ctx, classID, k.GetBurntCount(ctx, classID)+1)
 	return nil
 }
 
+// GetBurntCount returns the number of burnt NFTs of the class.
+func (k Keeper) GetBurntCount(ctx sdk.Context, classID string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateClassBurntCountKey(classID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setBurntCount(ctx sdk.Context, classID string, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.CreateClassBurntCountKey(classID), sdk.Uint64ToBigEndian(count))
+}
+
+// GetClassSupply returns the number of existing and burnt NFTs of the class together with its max supply.
+func (k Keeper) GetClassSupply(ctx sdk.Context, classID string) (supply, burnt, maxSupply uint64, err error) {
+	definition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return k.nftKeeper.GetTotalSupply(ctx, classID), k.GetBurntCount(ctx, classID), definition.MaxSupply, nil
+}
+
 // GetBurntNFTs return paginated burnt NFTs.
 //
 //nolint:dupl
@@ -456,6 +675,10 @@ func (k Keeper) IsFrozen(ctx sdk.Context, classID, nftID string) (bool, error) {
 		return false, sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
 	}
 
+	return k.isFrozen(ctx, classID, nftID)
+}
+
+func (k Keeper) isFrozen(ctx sdk.Context, classID, nftID string) (bool, error) {
 	key, err := types.CreateFreezingKey(classID, nftID)
 	if err != nil {
 		return false, err
@@ -512,6 +735,10 @@ func (k Keeper) IsWhitelisted(ctx sdk.Context, classID, nftID string, account sd
 		return false, sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
 	}
 
+	return k.isWhitelisted(ctx, classID, nftID, account)
+}
+
+func (k Keeper) isWhitelisted(ctx sdk.Context, classID, nftID string, account sdk.AccAddress) (bool, error) {
 	key, err := types.CreateWhitelistingKey(classID, nftID, account)
 	if err != nil {
 		return false, err
@@ -682,25 +909,47 @@ func (k Keeper) isNFTSendable(ctx sdk.Context, classID, nftID string) error {
 		return err
 	}
 
+	return k.checkNFTSendable(ctx, classDefinition, nftID)
+}
+
+func (k Keeper) checkNFTSendable(ctx sdk.Context, classDefinition types.ClassDefinition, nftID string) error {
+	// soulbound NFTs are bound to the account they are minted to, even the issuer can't send them.
+	if classDefinition.IsFeatureEnabled(types.ClassFeature_soulbound) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s is soulbound", classDefinition.ID, nftID)
+	}
+
 	// always allow issuer to send NFTs issued by them.
-	owner := k.nftKeeper.GetOwner(ctx, classID, nftID)
+	owner := k.nftKeeper.GetOwner(ctx, classDefinition.ID, nftID)
 	if classDefinition.Issuer == owner.String() {
 		return nil
 	}
 
 	if classDefinition.IsFeatureEnabled(types.ClassFeature_disable_sending) {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s has sending disabled", classID, nftID)
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s has sending disabled", classDefinition.ID, nftID)
 	}
 
-	frozen, err := k.IsFrozen(ctx, classID, nftID)
+	if !classDefinition.IsFeatureEnabled(types.ClassFeature_freezing) {
+		return nil
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, classDefinition.ID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classDefinition.ID, nftID)
+	}
+
+	frozen, err := k.isFrozen(ctx, classDefinition.ID, nftID)
 	if err != nil {
-		if errors.Is(err, types.ErrFeatureDisabled) {
-			return nil
-		}
 		return err
 	}
 	if frozen {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s is frozen", classID, nftID)
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s is frozen", classDefinition.ID, nftID)
+	}
+
+	classFrozen, err := k.isClassFrozen(ctx, classDefinition.ID, owner)
+	if err != nil {
+		return err
+	}
+	if classFrozen {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft class with classID:%s is frozen for the owner %s", classDefinition.ID, owner)
 	}
 	return nil
 }
@@ -717,8 +966,17 @@ func (k Keeper) isNFTReceivable(ctx sdk.Context, classID, nftID string, receiver
 		return err
 	}
 
-	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
-		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	return k.checkNFTReceivable(ctx, classDefinition, nftID, receiver)
+}
+
+func (k Keeper) checkNFTReceivable(
+	ctx sdk.Context,
+	classDefinition types.ClassDefinition,
+	nftID string,
+	receiver sdk.AccAddress,
+) error {
+	if !k.nftKeeper.HasNFT(ctx, classDefinition.ID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classDefinition.ID, nftID)
 	}
 
 	// always allow issuer to receive NFTs issued by them.
@@ -726,15 +984,24 @@ func (k Keeper) isNFTReceivable(ctx sdk.Context, classID, nftID string, receiver
 		return nil
 	}
 
-	whitelisted, err := k.IsWhitelisted(ctx, classID, nftID, receiver)
+	if !classDefinition.IsFeatureEnabled(types.ClassFeature_whitelisting) {
+		return nil
+	}
+
+	classWhitelisted, err := k.isClassWhitelisted(ctx, classDefinition.ID, receiver)
+	if err != nil {
+		return err
+	}
+	if classWhitelisted {
+		return nil
+	}
+
+	whitelisted, err := k.isWhitelisted(ctx, classDefinition.ID, nftID, receiver)
 	if err != nil {
-		if errors.Is(err, types.ErrFeatureDisabled) {
-			return nil
-		}
 		return err
 	}
 	if !whitelisted {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s is not whitelisted for account %s", classID, nftID, receiver)
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft with classID:%s and ID:%s is not whitelisted for account %s", classDefinition.ID, nftID, receiver)
 	}
 	return nil
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -79,6 +80,90 @@ func TestKeeper_IssueClass(t *testing.T) {
 	requireT.ErrorIs(err, types.ErrClassNotFound)
 }
 
+func TestKeeper_GetClasses(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	issuer2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classSettings := []types.IssueClassSettings{
+		{
+			Issuer:   issuer1,
+			Symbol:   "symbol1",
+			Name:     "name1",
+			Features: []types.ClassFeature{types.ClassFeature_burning, types.ClassFeature_freezing},
+		},
+		{
+			Issuer:   issuer1,
+			Symbol:   "symbol2",
+			Name:     "name2",
+			Features: []types.ClassFeature{types.ClassFeature_freezing},
+		},
+		{
+			Issuer: issuer2,
+			Symbol: "symbol3",
+			Name:   "name3",
+		},
+	}
+	classIDs := make([]string, 0, len(classSettings))
+	for _, settings := range classSettings {
+		classID, err := assetNFTKeeper.IssueClass(ctx, settings)
+		requireT.NoError(err)
+		classIDs = append(classIDs, classID)
+	}
+
+	classIDsOf := func(classes []types.Class) []string {
+		ids := make([]string, 0, len(classes))
+		for _, class := range classes {
+			ids = append(ids, class.Id)
+		}
+		return ids
+	}
+
+	// all classes
+	classes, _, err := assetNFTKeeper.GetClasses(ctx, nil, nil, nil)
+	requireT.NoError(err)
+	requireT.ElementsMatch(classIDs, classIDsOf(classes))
+
+	// classes of the issuer
+	classes, _, err = assetNFTKeeper.GetClasses(ctx, issuer1, nil, nil)
+	requireT.NoError(err)
+	requireT.ElementsMatch(classIDs[:2], classIDsOf(classes))
+	class, err := assetNFTKeeper.GetClass(ctx, classIDs[0])
+	requireT.NoError(err)
+	requireT.Contains(classes, class)
+
+	// classes with the features
+	classes, _, err = assetNFTKeeper.GetClasses(ctx, nil, []types.ClassFeature{types.ClassFeature_freezing}, nil)
+	requireT.NoError(err)
+	requireT.ElementsMatch(classIDs[:2], classIDsOf(classes))
+
+	classes, _, err = assetNFTKeeper.GetClasses(ctx, issuer1, []types.ClassFeature{
+		types.ClassFeature_burning, types.ClassFeature_freezing,
+	}, nil)
+	requireT.NoError(err)
+	requireT.ElementsMatch(classIDs[:1], classIDsOf(classes))
+
+	classes, _, err = assetNFTKeeper.GetClasses(ctx, issuer2, []types.ClassFeature{types.ClassFeature_freezing}, nil)
+	requireT.NoError(err)
+	requireT.Empty(classes)
+
+	// pagination
+	classes, pageRes, err := assetNFTKeeper.GetClasses(ctx, issuer1, nil, &query.PageRequest{Limit: 1})
+	requireT.NoError(err)
+	requireT.Len(classes, 1)
+	queriedClassIDs := classIDsOf(classes)
+	classes, pageRes, err = assetNFTKeeper.GetClasses(ctx, issuer1, nil, &query.PageRequest{Key: pageRes.GetNextKey()})
+	requireT.NoError(err)
+	requireT.Len(classes, 1)
+	requireT.Nil(pageRes.GetNextKey())
+	queriedClassIDs = append(queriedClassIDs, classIDsOf(classes)...)
+	requireT.ElementsMatch(classIDs[:2], queriedClassIDs)
+}
+
 func TestKeeper_Mint(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
@@ -367,12 +452,11 @@ func TestKeeper_Mint_WithNoFundsCoveringFee(t *testing.T) {
 	requireT.ErrorIs(nftKeeper.Mint(ctx, settings), sdkerrors.ErrInsufficientFunds)
 }
 
-func TestKeeper_DisableSending(t *testing.T) {
+func TestKeeper_MaxSupply(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
 	ctx := testApp.NewContext(false, tmproto.Header{})
 	assetNFTKeeper := testApp.AssetNFTKeeper
-	nftKeeper := testApp.NFTKeeper
 
 	nftParams := types.Params{
 		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
@@ -380,48 +464,147 @@ func TestKeeper_DisableSending(t *testing.T) {
 	assetNFTKeeper.SetParams(ctx, nftParams)
 
 	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
-	classSettings := types.IssueClassSettings{
-		Issuer: issuer,
-		Symbol: "symbol",
-		Features: []types.ClassFeature{
-			types.ClassFeature_disable_sending,
-		},
-	}
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:    issuer,
+		Symbol:    "symbol",
+		Features:  []types.ClassFeature{types.ClassFeature_burning},
+		MaxSupply: 3,
+	})
+	requireT.NoError(err)
 
-	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	class, err := assetNFTKeeper.GetClass(ctx, classID)
 	requireT.NoError(err)
+	requireT.EqualValues(3, class.MaxSupply)
 
+	requireT.NoError(assetNFTKeeper.MintBatch(ctx, []types.MintSettings{
+		{Sender: issuer, ClassID: classID, ID: "my-id-1"},
+		{Sender: issuer, ClassID: classID, ID: "my-id-2"},
+	}))
+	requireT.NoError(assetNFTKeeper.Burn(ctx, issuer, classID, "my-id-2"))
+
+	supply, burnt, maxSupply, err := assetNFTKeeper.GetClassSupply(ctx, classID)
 	requireT.NoError(err)
-	settings := types.MintSettings{
-		Sender:  issuer,
-		ClassID: classID,
-		ID:      "my-id",
-		URI:     "https://my-nft-meta.invalid/1",
-		URIHash: "content-hash",
+	requireT.EqualValues(1, supply)
+	requireT.EqualValues(1, burnt)
+	requireT.EqualValues(3, maxSupply)
+
+	// try to mint the batch exceeding the max supply including the burnt nft, it should fail
+	err = assetNFTKeeper.MintBatch(ctx, []types.MintSettings{
+		{Sender: issuer, ClassID: classID, ID: "my-id-3"},
+		{Sender: issuer, ClassID: classID, ID: "my-id-4"},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// mint the last nft
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: "my-id-3"}))
+
+	// try to mint after the max supply is reached, it should fail
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: "my-id-4"})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
+
+func TestKeeper_ClassMinters(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
 	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
 
-	// mint NFT
-	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	mintFee := sdk.NewInt64Coin(constant.DenomDev, 10)
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:  issuer,
+		Symbol:  "symbol",
+		MintFee: &mintFee,
+	})
+	requireT.NoError(err)
 
-	// try to send from issuer, it should succeed
-	nftID := settings.ID
-	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
-	err = nftKeeper.Transfer(ctx, classID, nftID, recipient)
+	minter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, minter, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 100))))
+
+	// try to mint without the grant, it should fail
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: minter, ClassID: classID, ID: "my-id-1"})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to grant by non-issuer, it should fail
+	err = assetNFTKeeper.GrantMinter(ctx, classID, minter, minter, 3)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to grant to the issuer, it should fail
+	err = assetNFTKeeper.GrantMinter(ctx, classID, issuer, issuer, 3)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// grant the minter
+	requireT.NoError(assetNFTKeeper.GrantMinter(ctx, classID, issuer, minter, 3))
+	_, minters, err := assetNFTKeeper.GetClassMinters(ctx, classID, nil)
 	requireT.NoError(err)
+	requireT.Equal([]types.ClassMinter{{ClassId: classID, Minter: minter.String(), Quota: 3}}, minters)
 
-	// try to transfer from non-issuer, it should fail
-	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
-	err = nftKeeper.Transfer(ctx, classID, nftID, recipient2)
-	requireT.Error(err)
+	grantedEvents, err := event.FindTypedEvents[*types.EventMinterGranted](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventMinterGranted{
+		ClassId: classID,
+		Minter:  minter.String(),
+		Quota:   3,
+	}, grantedEvents[0])
+
+	// mint by the minter, the mint fee is paid to the issuer
+	requireT.NoError(assetNFTKeeper.MintBatch(ctx, []types.MintSettings{
+		{Sender: minter, ClassID: classID, ID: "my-id-1"},
+		{Sender: minter, ClassID: classID, ID: "my-id-2"},
+	}))
+	requireT.Equal(minter.String(), nftKeeper.GetOwner(ctx, classID, "my-id-1").String())
+	requireT.Equal("20"+constant.DenomDev, bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).String())
+	requireT.Equal("80"+constant.DenomDev, bankKeeper.GetBalance(ctx, minter, constant.DenomDev).String())
+	_, minters, err = assetNFTKeeper.GetClassMinters(ctx, classID, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.ClassMinter{{ClassId: classID, Minter: minter.String(), Quota: 1}}, minters)
+
+	// try to mint more than the quota, it should fail
+	err = assetNFTKeeper.MintBatch(ctx, []types.MintSettings{
+		{Sender: minter, ClassID: classID, ID: "my-id-3"},
+		{Sender: minter, ClassID: classID, ID: "my-id-4"},
+	})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the issuer mints without the quota and the fee
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: "my-id-3"}))
+	requireT.Equal("20"+constant.DenomDev, bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).String())
+
+	// use the rest of the quota, the minter is removed
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: minter, ClassID: classID, ID: "my-id-4"}))
+	_, minters, err = assetNFTKeeper.GetClassMinters(ctx, classID, nil)
+	requireT.NoError(err)
+	requireT.Empty(minters)
+
+	// grant and revoke
+	requireT.NoError(assetNFTKeeper.GrantMinter(ctx, classID, issuer, minter, 5))
+	requireT.NoError(assetNFTKeeper.RevokeMinter(ctx, classID, issuer, minter))
+	_, minters, err = assetNFTKeeper.GetClassMinters(ctx, classID, nil)
+	requireT.NoError(err)
+	requireT.Empty(minters)
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: minter, ClassID: classID, ID: "my-id-5"})
 	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	revokedEvents, err := event.FindTypedEvents[*types.EventMinterRevoked](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventMinterRevoked{
+		ClassId: classID,
+		Minter:  minter.String(),
+	}, revokedEvents[0])
 }
 
-func TestKeeper_Freeze(t *testing.T) {
+func TestKeeper_DataSchema(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
 	ctx := testApp.NewContext(false, tmproto.Header{})
 	assetNFTKeeper := testApp.AssetNFTKeeper
-	nftKeeper := testApp.NFTKeeper
 
 	nftParams := types.Params{
 		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
@@ -429,63 +612,139 @@ func TestKeeper_Freeze(t *testing.T) {
 	assetNFTKeeper.SetParams(ctx, nftParams)
 
 	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
-	classSettings := types.IssueClassSettings{
-		Issuer: issuer,
-		Symbol: "symbol",
-		Features: []types.ClassFeature{
-			types.ClassFeature_freezing,
-		},
-	}
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 
-	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	// try to issue the class with the unregistered data type, it should fail
+	_, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:     issuer,
+		Symbol:     "symbol",
+		DataSchema: &types.DataSchema{TypeURL: "/unknown.Type"},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// issue the class with the data type
+	typedClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:     issuer,
+		Symbol:     "typed",
+		Features:   []types.ClassFeature{types.ClassFeature_updatable_metadata},
+		DataSchema: &types.DataSchema{TypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{})},
+	})
 	requireT.NoError(err)
 
+	class, err := assetNFTKeeper.GetClass(ctx, typedClassID)
 	requireT.NoError(err)
-	settings := types.MintSettings{
-		Sender:  issuer,
-		ClassID: classID,
-		ID:      "my-id",
-		URI:     "https://my-nft-meta.invalid/1",
-		URIHash: "content-hash",
-	}
+	requireT.Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), class.DataSchema.TypeURL)
 
-	// mint NFT
-	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
+	msgSendData, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1)),
+	})
+	requireT.NoError(err)
+	dataBytes, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte("data")})
+	requireT.NoError(err)
 
-	// freeze NFT
-	nftID := settings.ID
-	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, nftID))
-	isFrozen, err := assetNFTKeeper.IsFrozen(ctx, classID, nftID)
+	// try to mint the nft with the data of the wrong type, it should fail
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: typedClassID, ID: "my-id-1", Data: dataBytes})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to mint the nft without the data, it should fail
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: typedClassID, ID: "my-id-1"})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to mint the nft with the data which can't be decoded to the type, it should fail
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: typedClassID, ID: "my-id-1", Data: &codectypes.Any{
+		TypeUrl: msgSendData.TypeUrl,
+		Value:   []byte{0xff, 0xff},
+	}})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: typedClassID, ID: "my-id-1", Data: msgSendData}))
+
+	typeURL, json, err := assetNFTKeeper.GetNFTData(ctx, typedClassID, "my-id-1")
 	requireT.NoError(err)
-	requireT.True(isFrozen)
+	requireT.Equal(msgSendData.TypeUrl, typeURL)
+	requireT.Contains(json, `"to_address":"`+recipient.String()+`"`)
 
-	// transfer from issuer (although it is frozen, the issuer can send)
-	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
-	err = nftKeeper.Transfer(ctx, classID, nftID, recipient)
+	// try to update the nft with the data of the wrong type, it should fail
+	err = assetNFTKeeper.UpdateNFT(ctx, types.UpdateNFTSettings{Sender: issuer, ClassID: typedClassID, ID: "my-id-1", Data: dataBytes})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to clear the data of the nft, it should fail
+	err = assetNFTKeeper.UpdateNFT(ctx, types.UpdateNFTSettings{Sender: issuer, ClassID: typedClassID, ID: "my-id-1"})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to issue the class with the JSON schema referencing the remote document, it should fail
+	_, err = assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:     issuer,
+		Symbol:     "remote",
+		DataSchema: &types.DataSchema{JSONSchema: `{"$ref":"https://my.invalid/schema.json"}`},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// issue the class with the JSON schema
+	jsonClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "json",
+		DataSchema: &types.DataSchema{
+			JSONSchema: `{"type":"object","properties":{"level":{"type":"integer","minimum":1}},"required":["level"]}`,
+		},
+	})
 	requireT.NoError(err)
 
-	// transfer from non-issuer (must fail)
-	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
-	err = nftKeeper.Transfer(ctx, classID, nftID, recipient2)
-	requireT.Error(err)
-	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+	// try to mint the nft with the data which is not JSON, it should fail
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: jsonClassID, ID: "my-id-1", Data: dataBytes})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
 
-	// unfreeze
-	requireT.NoError(assetNFTKeeper.Unfreeze(ctx, issuer, classID, nftID))
-	isFrozen, err = assetNFTKeeper.IsFrozen(ctx, classID, nftID)
+	// try to mint the nft without the data, it's validated as the empty object missing the required property
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: jsonClassID, ID: "my-id-1"})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to mint the nft with the data not satisfying the schema, it should fail
+	invalidJSONData, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte(`{"level":0}`)})
 	requireT.NoError(err)
-	requireT.False(isFrozen)
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: jsonClassID, ID: "my-id-1", Data: invalidJSONData})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
 
-	// transfer from non-issuer (must succeed)
-	err = nftKeeper.Transfer(ctx, classID, nftID, recipient2)
+	// try to mint the nft with the structured data, it should fail
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: jsonClassID, ID: "my-id-1", Data: msgSendData})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	jsonData, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte(`{"level":2}`)})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: jsonClassID, ID: "my-id-1", Data: jsonData}))
+
+	typeURL, json, err = assetNFTKeeper.GetNFTData(ctx, jsonClassID, "my-id-1")
+	requireT.NoError(err)
+	requireT.Equal(jsonData.TypeUrl, typeURL)
+	requireT.Equal(`{"level":2}`, json)
+
+	// the class with the JSON schema accepting the empty object accepts the missing data
+	optionalJSONClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:     issuer,
+		Symbol:     "optional",
+		DataSchema: &types.DataSchema{JSONSchema: `{"type":"object","properties":{"level":{"type":"integer"}}}`},
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: optionalJSONClassID, ID: "my-id-1"}))
+
+	// the class without the schema accepts the unstructured data only
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "unstructured",
+	})
 	requireT.NoError(err)
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: "my-id-1", Data: msgSendData})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: "my-id-1", Data: dataBytes}))
 }
 
-func TestKeeper_Freeze_Unfreezable(t *testing.T) {
+func TestKeeper_DisableSending(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
 	ctx := testApp.NewContext(false, tmproto.Header{})
 	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
 
 	nftParams := types.Params{
 		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
@@ -494,9 +753,11 @@ func TestKeeper_Freeze_Unfreezable(t *testing.T) {
 
 	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	classSettings := types.IssueClassSettings{
-		Issuer:   issuer,
-		Symbol:   "symbol",
-		Features: []types.ClassFeature{},
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_disable_sending,
+		},
 	}
 
 	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
@@ -514,81 +775,523 @@ func TestKeeper_Freeze_Unfreezable(t *testing.T) {
 	// mint NFT
 	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
 
-	// freeze NFT
+	// try to send from issuer, it should succeed
 	nftID := settings.ID
-	err = assetNFTKeeper.Freeze(ctx, issuer, classID, nftID)
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = nftKeeper.Transfer(ctx, classID, nftID, recipient)
+	requireT.NoError(err)
+
+	// try to transfer from non-issuer, it should fail
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = nftKeeper.Transfer(ctx, classID, nftID, recipient2)
 	requireT.Error(err)
-	requireT.True(types.ErrFeatureDisabled.Is(err))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
 }
 
-func TestKeeper_Freeze_Nonexistent(t *testing.T) {
+func TestKeeper_Soulbound(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
 	ctx := testApp.NewContext(false, tmproto.Header{})
 	assetNFTKeeper := testApp.AssetNFTKeeper
-	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	nftKeeper := testApp.NFTKeeper
 
 	nftParams := types.Params{
 		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
 	}
 	assetNFTKeeper.SetParams(ctx, nftParams)
 
-	// try to freeze NFT when Class does not exists
-	err := assetNFTKeeper.Freeze(ctx, issuer, types.BuildClassID("symbol", issuer), "random-id")
-	requireT.Error(err)
-	requireT.True(types.ErrClassNotFound.Is(err))
-
-	// issue class
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	classSettings := types.IssueClassSettings{
 		Issuer: issuer,
 		Symbol: "symbol",
 		Features: []types.ClassFeature{
-			types.ClassFeature_freezing,
+			types.ClassFeature_soulbound,
+			types.ClassFeature_whitelisting,
 		},
 	}
 
 	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
 	requireT.NoError(err)
 
-	// try to freeze when NFT does not exists
-	err = assetNFTKeeper.Freeze(ctx, issuer, classID, "random-id")
-	requireT.Error(err)
-	requireT.True(types.ErrNFTNotFound.Is(err))
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.MintSettings{
+		Sender:    issuer,
+		Recipient: holder,
+		ClassID:   classID,
+		ID:        "my-id",
+		URI:       "https://my-nft-meta.invalid/1",
+		URIHash:   "content-hash",
+	}
+
+	// mint NFT to the holder who is not whitelisted, it should fail
+	// the cached context is used since the failed transaction is reverted
+	cacheCtx, _ := ctx.CacheContext()
+	requireT.ErrorIs(assetNFTKeeper.Mint(cacheCtx, settings), sdkerrors.ErrUnauthorized)
+
+	// mint NFT to the whitelisted holder
+	requireT.NoError(assetNFTKeeper.AddToClassWhitelist(ctx, classID, issuer, holder))
+	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
+	requireT.Equal(holder.String(), nftKeeper.GetOwner(ctx, classID, settings.ID).String())
+
+	// try to transfer from the holder, it should fail
+	err = nftKeeper.Transfer(ctx, classID, settings.ID, issuer)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = assetNFTKeeper.SendBatch(ctx, holder, issuer, classID, []string{settings.ID})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// mint NFT to the issuer and try to send it, it should fail
+	issuerSettings := settings
+	issuerSettings.ID = "issuer-id"
+	issuerSettings.Recipient = nil
+	requireT.NoError(assetNFTKeeper.Mint(ctx, issuerSettings))
+	err = nftKeeper.Transfer(ctx, classID, issuerSettings.ID, holder)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the holder burns the token even if burning is disabled
+	requireT.NoError(assetNFTKeeper.Burn(ctx, holder, classID, settings.ID))
+	requireT.False(nftKeeper.HasNFT(ctx, classID, settings.ID))
 }
 
-func TestKeeper_Whitelist(t *testing.T) {
+func TestKeeper_SetUser(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
-	ctx := testApp.NewContext(false, tmproto.Header{})
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
 	assetNFTKeeper := testApp.AssetNFTKeeper
 	nftKeeper := testApp.NFTKeeper
 
 	nftParams := types.Params{
-		MintFee: sdk.NewInt64Coin(constant.DenomDev, 1000_000),
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
 	}
 	assetNFTKeeper.SetParams(ctx, nftParams)
 
 	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
-	requireT.NoError(testApp.FundAccount(ctx, issuer, sdk.NewCoins(nftParams.MintFee)))
-	classSettings := types.IssueClassSettings{
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
 		Issuer: issuer,
 		Symbol: "symbol",
-		Features: []types.ClassFeature{
-			types.ClassFeature_whitelisting,
-		},
-	}
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.MintBatch(ctx, []types.MintSettings{
+		{Sender: issuer, ClassID: classID, ID: "my-id"},
+		{Sender: issuer, ClassID: classID, ID: "my-id-2"},
+	}))
 
-	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	user := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// try to set the user by non-owner, it should fail
+	err = assetNFTKeeper.SetUser(ctx, user, classID, "my-id", user, 2000)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to set the user with the expiration time in the past, it should fail
+	err = assetNFTKeeper.SetUser(ctx, issuer, classID, "my-id", user, 1000)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to set the user of nonexistent token, it should fail
+	err = assetNFTKeeper.SetUser(ctx, issuer, classID, "nonexistent-id", user, 2000)
+	requireT.ErrorIs(err, types.ErrNFTNotFound)
+
+	// set the user
+	requireT.NoError(assetNFTKeeper.SetUser(ctx, issuer, classID, "my-id", user, 2000))
+	nftUser, found, err := assetNFTKeeper.GetUser(ctx, classID, "my-id")
+	requireT.NoError(err)
+	requireT.True(found)
+	requireT.Equal(types.NFTUser{
+		ClassId: classID,
+		Id:      "my-id",
+		User:    user.String(),
+		Expires: 2000,
+	}, nftUser)
+
+	userSetEvents, err := event.FindTypedEvents[*types.EventUserSet](ctx.EventManager().ABCIEvents())
 	requireT.NoError(err)
+	requireT.Equal(&types.EventUserSet{
+		ClassId: classID,
+		Id:      "my-id",
+		Owner:   issuer.String(),
+		User:    user.String(),
+		Expires: 2000,
+	}, userSetEvents[0])
+
+	// the user is ignored after the expiration
+	_, found, err = assetNFTKeeper.GetUser(ctx.WithBlockTime(time.Unix(2000, 0)), classID, "my-id")
+	requireT.NoError(err)
+	requireT.False(found)
 
+	// remove the user
+	requireT.NoError(assetNFTKeeper.SetUser(ctx, issuer, classID, "my-id", nil, 0))
+	_, found, err = assetNFTKeeper.GetUser(ctx, classID, "my-id")
 	requireT.NoError(err)
-	settings := types.MintSettings{
-		Sender:  issuer,
-		ClassID: classID,
-		ID:      "my-id",
-		URI:     "https://my-nft-meta.invalid/1",
-		URIHash: "content-hash",
-	}
+	requireT.False(found)
+
+	// the user is removed on transfer
+	requireT.NoError(assetNFTKeeper.SetUser(ctx, issuer, classID, "my-id", user, 2000))
+	requireT.NoError(assetNFTKeeper.SetUser(ctx, issuer, classID, "my-id-2", user, 2000))
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id", recipient))
+	requireT.NoError(assetNFTKeeper.SendBatch(ctx, issuer, recipient, classID, []string{"my-id-2"}))
+	for _, id := range []string{"my-id", "my-id-2"} {
+		_, found, err = assetNFTKeeper.GetUser(ctx, classID, id)
+		requireT.NoError(err)
+		requireT.False(found)
+	}
+
+	_, users, err := assetNFTKeeper.GetNFTUsers(ctx, nil)
+	requireT.NoError(err)
+	requireT.Empty(users)
+}
+
+func TestKeeper_Revoke(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// issue not revocable class
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_soulbound,
+		},
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: holder,
+		ClassID:   classID,
+		ID:        "my-id",
+	}))
+
+	// try to revoke, it should fail
+	err = assetNFTKeeper.Revoke(ctx, issuer, classID, "my-id")
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// issue revocable class
+	classID, err = assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+		Features: []types.ClassFeature{
+			types.ClassFeature_soulbound,
+			types.ClassFeature_revocable,
+		},
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: holder,
+		ClassID:   classID,
+		ID:        "my-id",
+	}))
+
+	// try to revoke by non-issuer, it should fail
+	err = assetNFTKeeper.Revoke(ctx, holder, classID, "my-id")
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to revoke nonexistent token, it should fail
+	err = assetNFTKeeper.Revoke(ctx, issuer, classID, "nonexistent-id")
+	requireT.ErrorIs(err, types.ErrNFTNotFound)
+
+	// revoke by the issuer
+	requireT.NoError(assetNFTKeeper.Revoke(ctx, issuer, classID, "my-id"))
+	requireT.False(nftKeeper.HasNFT(ctx, classID, "my-id"))
+	burnt, err := assetNFTKeeper.IsBurnt(ctx, classID, "my-id")
+	requireT.NoError(err)
+	requireT.True(burnt)
+
+	// try to mint the revoked token again, it should fail
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
+
+func TestKeeper_Freeze(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classSettings := types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+		},
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	requireT.NoError(err)
+	settings := types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+		URI:     "https://my-nft-meta.invalid/1",
+		URIHash: "content-hash",
+	}
+
+	// mint NFT
+	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
+
+	// freeze NFT
+	nftID := settings.ID
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, nftID))
+	isFrozen, err := assetNFTKeeper.IsFrozen(ctx, classID, nftID)
+	requireT.NoError(err)
+	requireT.True(isFrozen)
+
+	// transfer from issuer (although it is frozen, the issuer can send)
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = nftKeeper.Transfer(ctx, classID, nftID, recipient)
+	requireT.NoError(err)
+
+	// transfer from non-issuer (must fail)
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = nftKeeper.Transfer(ctx, classID, nftID, recipient2)
+	requireT.Error(err)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// unfreeze
+	requireT.NoError(assetNFTKeeper.Unfreeze(ctx, issuer, classID, nftID))
+	isFrozen, err = assetNFTKeeper.IsFrozen(ctx, classID, nftID)
+	requireT.NoError(err)
+	requireT.False(isFrozen)
+
+	// transfer from non-issuer (must succeed)
+	err = nftKeeper.Transfer(ctx, classID, nftID, recipient2)
+	requireT.NoError(err)
+}
+
+func TestKeeper_Freeze_Unfreezable(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classSettings := types.IssueClassSettings{
+		Issuer:   issuer,
+		Symbol:   "symbol",
+		Features: []types.ClassFeature{},
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	requireT.NoError(err)
+	settings := types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+		URI:     "https://my-nft-meta.invalid/1",
+		URIHash: "content-hash",
+	}
+
+	// mint NFT
+	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
+
+	// freeze NFT
+	nftID := settings.ID
+	err = assetNFTKeeper.Freeze(ctx, issuer, classID, nftID)
+	requireT.Error(err)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+}
+
+func TestKeeper_Freeze_Nonexistent(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	// try to freeze NFT when Class does not exists
+	err := assetNFTKeeper.Freeze(ctx, issuer, types.BuildClassID("symbol", issuer), "random-id")
+	requireT.Error(err)
+	requireT.True(types.ErrClassNotFound.Is(err))
+
+	// issue class
+	classSettings := types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+		},
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	// try to freeze when NFT does not exists
+	err = assetNFTKeeper.Freeze(ctx, issuer, classID, "random-id")
+	requireT.Error(err)
+	requireT.True(types.ErrNFTNotFound.Is(err))
+}
+
+//nolint:funlen // this is a complex test scenario and breaking it down is not beneficial
+func TestKeeper_ClassFreeze(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classSettings := types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_burning,
+			types.ClassFeature_freezing,
+		},
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	for _, id := range []string{"id1", "id2"} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      id,
+		}))
+	}
+
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "id1", holder))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "id2", holder))
+
+	// freezing the class for the issuer is forbidden
+	err = assetNFTKeeper.ClassFreeze(ctx, issuer, classID, issuer)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// freezing by non-issuer is forbidden
+	err = assetNFTKeeper.ClassFreeze(ctx, holder, classID, holder)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// freeze the class for the holder
+	requireT.NoError(assetNFTKeeper.ClassFreeze(ctx, issuer, classID, holder))
+	isFrozen, err := assetNFTKeeper.IsClassFrozen(ctx, classID, holder)
+	requireT.NoError(err)
+	requireT.True(isFrozen)
+	isFrozen, err = assetNFTKeeper.IsClassFrozen(ctx, classID, nil)
+	requireT.NoError(err)
+	requireT.False(isFrozen)
+
+	_, accounts, err := assetNFTKeeper.GetClassFrozenAccounts(ctx, classID, nil)
+	requireT.NoError(err)
+	requireT.Equal([]string{holder.String()}, accounts)
+
+	// the holder can neither send nor burn
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = nftKeeper.Transfer(ctx, classID, "id1", recipient)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = assetNFTKeeper.Burn(ctx, holder, classID, "id1")
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// unfreeze the class for the holder
+	requireT.NoError(assetNFTKeeper.ClassUnfreeze(ctx, issuer, classID, holder))
+	isFrozen, err = assetNFTKeeper.IsClassFrozen(ctx, classID, holder)
+	requireT.NoError(err)
+	requireT.False(isFrozen)
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "id1", recipient))
+
+	// freeze the whole class
+	requireT.NoError(assetNFTKeeper.ClassFreeze(ctx, issuer, classID, nil))
+	isFrozen, err = assetNFTKeeper.IsClassFrozen(ctx, classID, nil)
+	requireT.NoError(err)
+	requireT.True(isFrozen)
+	isFrozen, err = assetNFTKeeper.IsClassFrozen(ctx, classID, recipient)
+	requireT.NoError(err)
+	requireT.True(isFrozen)
+
+	err = nftKeeper.Transfer(ctx, classID, "id1", holder)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = assetNFTKeeper.Burn(ctx, holder, classID, "id2")
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// unfreeze the whole class
+	requireT.NoError(assetNFTKeeper.ClassUnfreeze(ctx, issuer, classID, nil))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "id1", holder))
+	requireT.NoError(assetNFTKeeper.Burn(ctx, holder, classID, "id2"))
+
+	// class without the freezing feature
+	classID, err = assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+	err = assetNFTKeeper.ClassFreeze(ctx, issuer, classID, holder)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+	_, err = assetNFTKeeper.IsClassFrozen(ctx, classID, holder)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+}
+
+func TestKeeper_Whitelist(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 1000_000),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, issuer, sdk.NewCoins(nftParams.MintFee)))
+	classSettings := types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_whitelisting,
+		},
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	requireT.NoError(err)
+	settings := types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+		URI:     "https://my-nft-meta.invalid/1",
+		URIHash: "content-hash",
+	}
 
 	// mint NFT
 	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
@@ -623,21 +1326,125 @@ func TestKeeper_Whitelist(t *testing.T) {
 	})
 
 	incrementallyQueriedAccounts := []string{}
-	pageRes, frozenAccounts, err := assetNFTKeeper.GetAllWhitelistedAccountsForNFT(ctx, classID, nftID, &query.PageRequest{Limit: 1})
+	pageRes, frozenAccounts, err := assetNFTKeeper.GetAllWhitelistedAccountsForNFT(ctx, classID, nftID, &query.PageRequest{Limit: 1})
+	requireT.NoError(err)
+	requireT.Len(frozenAccounts, 1)
+	incrementallyQueriedAccounts = append(incrementallyQueriedAccounts, frozenAccounts...)
+
+	pageRes, frozenAccounts, err = assetNFTKeeper.GetAllWhitelistedAccountsForNFT(ctx, classID, nftID, &query.PageRequest{Key: pageRes.GetNextKey()})
+	requireT.NoError(err)
+	requireT.Len(frozenAccounts, 1)
+	incrementallyQueriedAccounts = append(incrementallyQueriedAccounts, frozenAccounts...)
+	requireT.Nil(pageRes.GetNextKey())
+
+	requireT.ElementsMatch([]string{
+		recipient.String(),
+		recipient2.String(),
+	}, incrementallyQueriedAccounts)
+}
+
+//nolint:funlen // this is a complex test scenario and breaking it down is not beneficial
+func TestKeeper_ClassWhitelist(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_whitelisting,
+		},
+	})
+	requireT.NoError(err)
+
+	for _, id := range []string{"id1", "id2"} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      id,
+		}))
+	}
+
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// whitelisting the issuer is forbidden
+	err = assetNFTKeeper.AddToClassWhitelist(ctx, classID, issuer, issuer)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// whitelisting by non-issuer is forbidden
+	err = assetNFTKeeper.AddToClassWhitelist(ctx, classID, recipient, recipient)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// transfer to non whitelisted account, it should fail
+	err = nftKeeper.Transfer(ctx, classID, "id1", recipient)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// whitelist the account for the whole class
+	requireT.NoError(assetNFTKeeper.AddToClassWhitelist(ctx, classID, issuer, recipient))
+	isWhitelisted, err := assetNFTKeeper.IsClassWhitelisted(ctx, classID, recipient)
+	requireT.NoError(err)
+	requireT.True(isWhitelisted)
+
+	// the per-NFT whitelisting is not affected
+	isWhitelisted, err = assetNFTKeeper.IsWhitelisted(ctx, classID, "id1", recipient)
+	requireT.NoError(err)
+	requireT.False(isWhitelisted)
+
+	// both NFTs can be received now
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "id1", recipient))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "id2", recipient))
+
+	// test query accounts
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(assetNFTKeeper.AddToClassWhitelist(ctx, classID, issuer, recipient2))
+
+	incrementallyQueriedAccounts := []string{}
+	pageRes, accounts, err := assetNFTKeeper.GetClassWhitelistedAccounts(ctx, classID, &query.PageRequest{Limit: 1})
 	requireT.NoError(err)
-	requireT.Len(frozenAccounts, 1)
-	incrementallyQueriedAccounts = append(incrementallyQueriedAccounts, frozenAccounts...)
+	requireT.Len(accounts, 1)
+	incrementallyQueriedAccounts = append(incrementallyQueriedAccounts, accounts...)
 
-	pageRes, frozenAccounts, err = assetNFTKeeper.GetAllWhitelistedAccountsForNFT(ctx, classID, nftID, &query.PageRequest{Key: pageRes.GetNextKey()})
+	pageRes, accounts, err = assetNFTKeeper.GetClassWhitelistedAccounts(ctx, classID, &query.PageRequest{Key: pageRes.GetNextKey()})
 	requireT.NoError(err)
-	requireT.Len(frozenAccounts, 1)
-	incrementallyQueriedAccounts = append(incrementallyQueriedAccounts, frozenAccounts...)
+	requireT.Len(accounts, 1)
+	incrementallyQueriedAccounts = append(incrementallyQueriedAccounts, accounts...)
 	requireT.Nil(pageRes.GetNextKey())
 
 	requireT.ElementsMatch([]string{
 		recipient.String(),
 		recipient2.String(),
 	}, incrementallyQueriedAccounts)
+
+	// remove the account from the class whitelist, per-NFT whitelisting is still respected
+	requireT.NoError(assetNFTKeeper.RemoveFromClassWhitelist(ctx, classID, issuer, recipient2))
+	isWhitelisted, err = assetNFTKeeper.IsClassWhitelisted(ctx, classID, recipient2)
+	requireT.NoError(err)
+	requireT.False(isWhitelisted)
+
+	err = nftKeeper.Transfer(ctx, classID, "id1", recipient2)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, classID, "id1", issuer, recipient2))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "id1", recipient2))
+
+	// class without the whitelisting feature
+	classID, err = assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+	err = assetNFTKeeper.AddToClassWhitelist(ctx, classID, issuer, recipient)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+	_, err = assetNFTKeeper.IsClassWhitelisted(ctx, classID, recipient)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
 }
 
 func TestKeeper_Whitelist_Unwhitelistable(t *testing.T) {
@@ -726,3 +1533,469 @@ func TestKeeper_Whitelist_NonExistent(t *testing.T) {
 	requireT.Error(err)
 	requireT.True(types.ErrNFTNotFound.Is(err))
 }
+
+//nolint:funlen // this is a complex test scenario and breaking it down is not beneficial
+func TestKeeper_GetOwnerNFTs(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID1, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol1",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+			types.ClassFeature_whitelisting,
+		},
+	})
+	requireT.NoError(err)
+	classID2, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(assetNFTKeeper.AddToClassWhitelist(ctx, classID1, issuer, owner))
+	for _, token := range []struct {
+		classID string
+		id      string
+	}{
+		{classID: classID1, id: "id1"},
+		{classID: classID1, id: "id2"},
+		{classID: classID2, id: "id3"},
+	} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: token.classID,
+			ID:      token.id,
+			URI:     "https://my-nft-meta.invalid/" + token.id,
+			URIHash: "content-hash",
+		}))
+		requireT.NoError(nftKeeper.Transfer(ctx, token.classID, token.id, owner))
+	}
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID1, "id1"))
+
+	// query all the nfts of the owner
+	_, nfts, err := assetNFTKeeper.GetOwnerNFTs(ctx, owner, "", nil)
+	requireT.NoError(err)
+	requireT.ElementsMatch([]types.OwnerNFT{
+		{
+			ClassId:     classID1,
+			Id:          "id1",
+			URI:         "https://my-nft-meta.invalid/id1",
+			URIHash:     "content-hash",
+			Issuer:      issuer.String(),
+			Features:    []types.ClassFeature{types.ClassFeature_freezing, types.ClassFeature_whitelisting},
+			Frozen:      true,
+			Whitelisted: true,
+		},
+		{
+			ClassId:     classID1,
+			Id:          "id2",
+			URI:         "https://my-nft-meta.invalid/id2",
+			URIHash:     "content-hash",
+			Issuer:      issuer.String(),
+			Features:    []types.ClassFeature{types.ClassFeature_freezing, types.ClassFeature_whitelisting},
+			Frozen:      false,
+			Whitelisted: true,
+		},
+		{
+			ClassId: classID2,
+			Id:      "id3",
+			URI:     "https://my-nft-meta.invalid/id3",
+			URIHash: "content-hash",
+			Issuer:  issuer.String(),
+		},
+	}, nfts)
+
+	// freeze the class for the owner
+	requireT.NoError(assetNFTKeeper.ClassFreeze(ctx, issuer, classID1, owner))
+
+	// query the nfts of the class
+	_, nfts, err = assetNFTKeeper.GetOwnerNFTs(ctx, owner, classID1, nil)
+	requireT.NoError(err)
+	requireT.Len(nfts, 2)
+	for _, n := range nfts {
+		requireT.Equal(classID1, n.ClassId)
+		requireT.True(n.Frozen)
+	}
+
+	// query with pagination
+	pageRes, nfts, err := assetNFTKeeper.GetOwnerNFTs(ctx, owner, "", &query.PageRequest{Limit: 2})
+	requireT.NoError(err)
+	requireT.Len(nfts, 2)
+	pageRes, nfts, err = assetNFTKeeper.GetOwnerNFTs(ctx, owner, "", &query.PageRequest{Key: pageRes.GetNextKey()})
+	requireT.NoError(err)
+	requireT.Len(nfts, 1)
+	requireT.Nil(pageRes.GetNextKey())
+
+	// account without nfts
+	_, nfts, err = assetNFTKeeper.GetOwnerNFTs(ctx, issuer, "", nil)
+	requireT.NoError(err)
+	requireT.Empty(nfts)
+
+	// not existing class
+	_, _, err = assetNFTKeeper.GetOwnerNFTs(ctx, owner, "nonexistent-"+issuer.String(), nil)
+	requireT.ErrorIs(err, types.ErrClassNotFound)
+}
+
+func TestKeeper_TransferWithPrice(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	assetNFTKeeper.SetParams(ctx, nftParams)
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classSettings := types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+		},
+		RoyaltyRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	settings := types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+	}
+	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
+	nftID := settings.ID
+
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, nftID, owner))
+
+	buyer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	price := sdk.NewInt64Coin(constant.DenomDev, 1005)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(price)))
+
+	// try to transfer by non-owner
+	err = assetNFTKeeper.TransferWithPrice(ctx, buyer, owner, classID, nftID, price)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to transfer frozen NFT
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, nftID))
+	err = assetNFTKeeper.TransferWithPrice(ctx, owner, buyer, classID, nftID, price)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	requireT.NoError(assetNFTKeeper.Unfreeze(ctx, issuer, classID, nftID))
+
+	// try to transfer with the price exceeding the buyer balance
+	cacheCtx, _ := ctx.CacheContext()
+	err = assetNFTKeeper.TransferWithPrice(cacheCtx, owner, buyer, classID, nftID, price.AddAmount(sdk.OneInt()))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// transfer with price
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetNFTKeeper.TransferWithPrice(ctx, owner, buyer, classID, nftID, price))
+	requireT.Equal(buyer.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+
+	// royalty is truncated
+	expectedRoyalty := sdk.NewInt64Coin(constant.DenomDev, 100)
+	requireT.Equal(expectedRoyalty.String(), bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).String())
+	requireT.Equal(price.Sub(expectedRoyalty).String(), bankKeeper.GetBalance(ctx, owner, constant.DenomDev).String())
+	requireT.True(bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).IsZero())
+
+	royaltyEvents, err := event.FindTypedEvents[*types.EventRoyaltyPaid](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal(&types.EventRoyaltyPaid{
+		ClassId: classID,
+		Id:      nftID,
+		Payer:   buyer.String(),
+		Issuer:  issuer.String(),
+		Amount:  expectedRoyalty,
+	}, royaltyEvents[0])
+}
+
+func TestKeeper_MintBatch(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 10_000_000),
+	}
+	nftKeeper.SetParams(ctx, nftParams)
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, addr, sdk.NewCoins(sdk.NewCoin(constant.DenomDev, nftParams.MintFee.Amount.MulRaw(3)))))
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: addr,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+
+	settings := []types.MintSettings{
+		{Sender: addr, ClassID: classID, ID: "my-id-1", URI: "https://my-nft-meta.invalid/1"},
+		{Sender: addr, ClassID: classID, ID: "my-id-2", URI: "https://my-nft-meta.invalid/2"},
+	}
+
+	// try to mint the batch from not issuer account
+	notIssuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = nftKeeper.MintBatch(ctx, []types.MintSettings{
+		{Sender: notIssuer, ClassID: classID, ID: "my-id-1"},
+	})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to mint the batch with different senders
+	err = nftKeeper.MintBatch(ctx, append(settings, types.MintSettings{Sender: notIssuer, ClassID: classID, ID: "my-id-3"}))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to mint an empty batch
+	err = nftKeeper.MintBatch(ctx, nil)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// mint the batch
+	requireT.NoError(nftKeeper.MintBatch(ctx, settings))
+	for _, s := range settings {
+		nft, found := testApp.NFTKeeper.GetNFT(ctx, classID, s.ID)
+		requireT.True(found)
+		requireT.Equal(s.URI, nft.Uri)
+		requireT.Equal(addr, testApp.NFTKeeper.GetOwner(ctx, classID, s.ID))
+	}
+
+	// the mint fee is charged for each nft
+	balance := bankKeeper.GetBalance(ctx, addr, constant.DenomDev)
+	requireT.Equal(nftParams.MintFee.Amount.String(), balance.Amount.String())
+
+	// try to mint the batch containing already minted nft, the whole batch must fail
+	err = nftKeeper.MintBatch(ctx, []types.MintSettings{
+		{Sender: addr, ClassID: classID, ID: "my-id-3"},
+		{Sender: addr, ClassID: classID, ID: "my-id-1"},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	requireT.False(testApp.NFTKeeper.HasNFT(ctx, classID, "my-id-3"))
+}
+
+func TestKeeper_BurnBatch(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_burning,
+			types.ClassFeature_freezing,
+		},
+	})
+	requireT.NoError(err)
+
+	ids := []string{"my-id-1", "my-id-2", "my-id-3"}
+	for _, id := range ids {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      id,
+		}))
+		requireT.NoError(nftKeeper.Transfer(ctx, classID, id, recipient))
+	}
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, ids[2]))
+
+	// try to burn from not owner account
+	err = assetNFTKeeper.BurnBatch(ctx, issuer, classID, ids[:2])
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to burn the batch containing the frozen nft, the whole batch must fail
+	err = assetNFTKeeper.BurnBatch(ctx, recipient, classID, ids)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// burn the batch
+	requireT.NoError(assetNFTKeeper.BurnBatch(ctx, recipient, classID, ids[:2]))
+	for _, id := range ids[:2] {
+		requireT.False(nftKeeper.HasNFT(ctx, classID, id))
+		burnt, err := assetNFTKeeper.IsBurnt(ctx, classID, id)
+		requireT.NoError(err)
+		requireT.True(burnt)
+	}
+	requireT.True(nftKeeper.HasNFT(ctx, classID, ids[2]))
+
+	// try to burn non-existing nft
+	err = assetNFTKeeper.BurnBatch(ctx, recipient, classID, ids[:1])
+	requireT.ErrorIs(err, types.ErrNFTNotFound)
+}
+
+func TestKeeper_SendBatch(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+			types.ClassFeature_whitelisting,
+		},
+	})
+	requireT.NoError(err)
+
+	ids := []string{"my-id-1", "my-id-2", "my-id-3"}
+	for _, id := range ids {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      id,
+		}))
+	}
+
+	// try to send to not whitelisted account
+	err = assetNFTKeeper.SendBatch(ctx, issuer, recipient, classID, ids)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	for _, id := range ids {
+		requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, classID, id, issuer, recipient))
+		requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, classID, id, issuer, recipient2))
+	}
+
+	// send the batch
+	requireT.NoError(assetNFTKeeper.SendBatch(ctx, issuer, recipient, classID, ids))
+	for _, id := range ids {
+		requireT.Equal(recipient, nftKeeper.GetOwner(ctx, classID, id))
+	}
+
+	// try to send from not owner account
+	err = assetNFTKeeper.SendBatch(ctx, issuer, recipient2, classID, ids)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to send the batch containing the frozen nft, the whole batch must fail
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, ids[1]))
+	err = assetNFTKeeper.SendBatch(ctx, recipient, recipient2, classID, ids)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// try to send non-existing nft
+	err = assetNFTKeeper.SendBatch(ctx, recipient, recipient2, classID, []string{"my-id-4"})
+	requireT.ErrorIs(err, types.ErrNFTNotFound)
+}
+
+func TestKeeper_UpdateMetadata(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:  issuer,
+		Symbol:  "symbol",
+		URI:     "https://my-class-meta.invalid/1",
+		URIHash: "class-hash-1",
+		Features: []types.ClassFeature{
+			types.ClassFeature_updatable_metadata,
+		},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+		URI:     "https://my-nft-meta.invalid/1",
+		URIHash: "nft-hash-1",
+	}))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "my-id", recipient))
+
+	dataValue, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte("new metadata")})
+	requireT.NoError(err)
+	nftSettings := types.UpdateNFTSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+		URI:     "https://my-nft-meta.invalid/2",
+		URIHash: "nft-hash-2",
+		Data:    dataValue,
+	}
+
+	// try to update from not issuer account
+	nftSettings.Sender = recipient
+	requireT.ErrorIs(assetNFTKeeper.UpdateNFT(ctx, nftSettings), sdkerrors.ErrUnauthorized)
+
+	// try to update non-existing nft
+	nftSettings.Sender = issuer
+	nftSettings.ID = "my-id-2"
+	requireT.ErrorIs(assetNFTKeeper.UpdateNFT(ctx, nftSettings), types.ErrNFTNotFound)
+
+	// update the nft held by the recipient
+	nftSettings.ID = "my-id"
+	requireT.NoError(assetNFTKeeper.UpdateNFT(ctx, nftSettings))
+	nft, found := nftKeeper.GetNFT(ctx, classID, "my-id")
+	requireT.True(found)
+	requireT.Equal(nftSettings.URI, nft.Uri)
+	requireT.Equal(nftSettings.URIHash, nft.UriHash)
+	requireT.Equal(string(dataValue.Value), string(nft.Data.Value))
+	requireT.Equal(recipient, nftKeeper.GetOwner(ctx, classID, "my-id"))
+
+	// update the class
+	classSettings := types.UpdateClassSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		URI:     "https://my-class-meta.invalid/2",
+		URIHash: "class-hash-2",
+	}
+	requireT.NoError(assetNFTKeeper.UpdateClass(ctx, classSettings))
+	class, err := assetNFTKeeper.GetClass(ctx, classID)
+	requireT.NoError(err)
+	requireT.Equal(classSettings.URI, class.URI)
+	requireT.Equal(classSettings.URIHash, class.URIHash)
+	requireT.Equal("symbol", class.Symbol)
+
+	classUpdatedEvents, err := event.FindTypedEvents[*types.EventClassUpdated](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	classUpdated := classUpdatedEvents[0]
+	requireT.Equal("class-hash-1", classUpdated.OldUriHash)
+	requireT.Equal("class-hash-2", classUpdated.NewUriHash)
+
+	// issue class without the feature
+	classID, err = assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+	}))
+
+	nftSettings.ClassID = classID
+	requireT.ErrorIs(assetNFTKeeper.UpdateNFT(ctx, nftSettings), types.ErrFeatureDisabled)
+	classSettings.ClassID = classID
+	requireT.ErrorIs(assetNFTKeeper.UpdateClass(ctx, classSettings), types.ErrFeatureDisabled)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It builds the issuer index of the existing class definitions.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var definitions []types.ClassDefinition
+	m.keeper.IterateAllClassDefinitions(ctx, func(definition types.ClassDefinition) bool {
+		definitions = append(definitions, definition)
+		return false
+	})
+
+	for _, definition := range definitions {
+		m.keeper.SetClassDefinition(ctx, definition)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It counts the burnt NFTs of the existing classes.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	counts := map[string]uint64{}
+	iterator := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.NFTBurningKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		classID, _, err := types.ParseBurningKey(iterator.Key())
+		if err != nil {
+			return err
+		}
+		counts[classID]++
+	}
+
+	for classID, count := range counts {
+		m.keeper.setBurntCount(ctx, classID, count)
+	}
+
+	return nil
+}
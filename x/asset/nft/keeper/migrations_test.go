@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestMigrator_Migrate1to2(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	storeKey := testApp.GetKey(types.StoreKey)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+
+	// remove the index entry to simulate the class stored before the index was introduced
+	indexKey := types.CreateIssuerClassKey(issuer, classID)
+	ctx.KVStore(storeKey).Delete(indexKey)
+
+	classes, _, err := assetNFTKeeper.GetClasses(ctx, issuer, nil, nil)
+	requireT.NoError(err)
+	requireT.Empty(classes)
+
+	requireT.NoError(keeper.NewMigrator(assetNFTKeeper).Migrate1to2(ctx))
+
+	classes, _, err = assetNFTKeeper.GetClasses(ctx, issuer, nil, nil)
+	requireT.NoError(err)
+	requireT.Len(classes, 1)
+	requireT.Equal(classID, classes[0].Id)
+}
+
+func TestMigrator_Migrate2to3(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	storeKey := testApp.GetKey(types.StoreKey)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.SetBurnt(ctx, classID, "nft-id-1"))
+	requireT.NoError(assetNFTKeeper.SetBurnt(ctx, classID, "nft-id-2"))
+
+	// remove the counter to simulate the nfts burnt before the counter was introduced
+	ctx.KVStore(storeKey).Delete(types.CreateClassBurntCountKey(classID))
+	requireT.Zero(assetNFTKeeper.GetBurntCount(ctx, classID))
+
+	requireT.NoError(keeper.NewMigrator(assetNFTKeeper).Migrate2to3(ctx))
+	requireT.EqualValues(2, assetNFTKeeper.GetBurntCount(ctx, classID))
+}
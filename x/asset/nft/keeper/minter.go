@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/pkg/store"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// GrantMinter allows the minter to mint the quota of NFTs of the class on behalf of the issuer.
+// The quota replaces the quota granted to the minter before.
+func (k Keeper) GrantMinter(ctx sdk.Context, classID string, sender, minter sdk.AccAddress, quota uint64) error {
+	if err := k.checkClassMinterAllowed(ctx, classID, sender, minter); err != nil {
+		return err
+	}
+
+	if quota == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "quota must be positive")
+	}
+
+	if err := k.SetClassMinter(ctx, types.ClassMinter{
+		ClassId: classID,
+		Minter:  minter.String(),
+		Quota:   quota,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMinterGranted{
+		ClassId: classID,
+		Minter:  minter.String(),
+		Quota:   quota,
+	})
+}
+
+// RevokeMinter removes the right to mint NFTs of the class from the minter.
+func (k Keeper) RevokeMinter(ctx sdk.Context, classID string, sender, minter sdk.AccAddress) error {
+	if err := k.checkClassMinterAllowed(ctx, classID, sender, minter); err != nil {
+		return err
+	}
+
+	if err := k.SetClassMinter(ctx, types.ClassMinter{
+		ClassId: classID,
+		Minter:  minter.String(),
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMinterRevoked{
+		ClassId: classID,
+		Minter:  minter.String(),
+	})
+}
+
+// SetClassMinter stores the quota of the class minter, the minter is removed if the quota is zero.
+func (k Keeper) SetClassMinter(ctx sdk.Context, classMinter types.ClassMinter) error {
+	minter, err := sdk.AccAddressFromBech32(classMinter.Minter)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid minter %s", classMinter.Minter)
+	}
+
+	return k.setMinterQuota(ctx, classMinter.ClassId, minter, classMinter.Quota)
+}
+
+// GetClassMinters returns paginated minters of the class together with their quotas.
+func (k Keeper) GetClassMinters(ctx sdk.Context, classID string, q *query.PageRequest) (*query.PageResponse, []types.ClassMinter, error) {
+	if _, err := k.GetClassDefinition(ctx, classID); err != nil {
+		return nil, nil, err
+	}
+
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, nil, err
+	}
+	key := store.JoinKeys(types.NFTClassMinterKeyPrefix, compositeKey)
+	minters := []types.ClassMinter{}
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), key),
+		q, func(key, value []byte) error {
+			minters = append(minters, types.ClassMinter{
+				ClassId: classID,
+				Minter:  sdk.AccAddress(key[1:]).String(), // the first byte contains the length prefix
+				Quota:   sdk.BigEndianToUint64(value),
+			})
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pageRes, minters, nil
+}
+
+// GetAllClassMinters returns paginated minters of all the classes.
+func (k Keeper) GetAllClassMinters(ctx sdk.Context, q *query.PageRequest) (*query.PageResponse, []types.ClassMinter, error) {
+	minters := []types.ClassMinter{}
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTClassMinterKeyPrefix),
+		q, func(key, value []byte) error {
+			classID, minter, err := types.ParseClassMinterKey(key)
+			if err != nil {
+				return err
+			}
+
+			minters = append(minters, types.ClassMinter{
+				ClassId: classID,
+				Minter:  minter.String(),
+				Quota:   sdk.BigEndianToUint64(value),
+			})
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pageRes, minters, nil
+}
+
+func (k Keeper) payClassMintFee(ctx sdk.Context, definition types.ClassDefinition, minter sdk.AccAddress, count int) error {
+	if definition.MintFee == nil || !definition.MintFee.IsPositive() {
+		return nil
+	}
+
+	fee := sdk.NewCoins(sdk.NewCoin(definition.MintFee.Denom, definition.MintFee.Amount.MulRaw(int64(count))))
+	issuer := sdk.MustAccAddressFromBech32(definition.Issuer)
+	if err := k.bankKeeper.SendCoins(ctx, minter, issuer, fee); err != nil {
+		return sdkerrors.Wrapf(err, "can't pay the mint fee %s to the issuer %s", fee, issuer)
+	}
+
+	return nil
+}
+
+func (k Keeper) checkClassMinterAllowed(ctx sdk.Context, classID string, sender, minter sdk.AccAddress) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if !classDefinition.IsIssuer(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is unauthorized to manage the minters of the class", sender)
+	}
+
+	if classDefinition.IsIssuer(minter) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "setting the nft class issuer as the minter is forbidden")
+	}
+
+	return nil
+}
+
+func (k Keeper) useMinterQuota(ctx sdk.Context, classID string, minter sdk.AccAddress, amount uint64) error {
+	quota, err := k.getMinterQuota(ctx, classID, minter)
+	if err != nil {
+		return err
+	}
+
+	if quota == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to perform the mint operation", minter.String())
+	}
+
+	if quota < amount {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "minting %d nfts exceeds the quota %d of the minter %s", amount, quota, minter)
+	}
+
+	return k.setMinterQuota(ctx, classID, minter, quota-amount)
+}
+
+func (k Keeper) getMinterQuota(ctx sdk.Context, classID string, minter sdk.AccAddress) (uint64, error) {
+	key, err := types.CreateClassMinterKey(classID, minter)
+	if err != nil {
+		return 0, err
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0, nil
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+func (k Keeper) setMinterQuota(ctx sdk.Context, classID string, minter sdk.AccAddress, quota uint64) error {
+	key, err := types.CreateClassMinterKey(classID, minter)
+	if err != nil {
+		return err
+	}
+
+	s := ctx.KVStore(k.storeKey)
+	if quota == 0 {
+		s.Delete(key)
+	} else {
+		s.Set(key, sdk.Uint64ToBigEndian(quota))
+	}
+	return nil
+}
@@ -20,6 +20,20 @@ type MsgKeeper interface {
 	Unfreeze(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error
 	AddToWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress) error
 	RemoveFromWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress) error
+	TransferWithPrice(ctx sdk.Context, sender, receiver sdk.AccAddress, classID, nftID string, price sdk.Coin) error
+	MintBatch(ctx sdk.Context, settings []types.MintSettings) error
+	BurnBatch(ctx sdk.Context, owner sdk.AccAddress, classID string, ids []string) error
+	SendBatch(ctx sdk.Context, sender, receiver sdk.AccAddress, classID string, ids []string) error
+	UpdateNFT(ctx sdk.Context, settings types.UpdateNFTSettings) error
+	UpdateClass(ctx sdk.Context, settings types.UpdateClassSettings) error
+	ClassFreeze(ctx sdk.Context, sender sdk.AccAddress, classID string, account sdk.AccAddress) error
+	ClassUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID string, account sdk.AccAddress) error
+	AddToClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error
+	RemoveFromClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error
+	Revoke(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error
+	SetUser(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string, user sdk.AccAddress, expires int64) error
+	GrantMinter(ctx sdk.Context, classID string, sender, minter sdk.AccAddress, quota uint64) error
+	RevokeMinter(ctx sdk.Context, classID string, sender, minter sdk.AccAddress) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -53,6 +67,9 @@ func (ms MsgServer) IssueClass(ctx context.Context, req *types.MsgIssueClass) (*
 			Data:        req.Data,
 			Features:    req.Features,
 			RoyaltyRate: req.RoyaltyRate,
+			MaxSupply:   req.MaxSupply,
+			MintFee:     req.MintFee,
+			DataSchema:  req.DataSchema,
 		},
 	); err != nil {
 		return nil, err
@@ -67,15 +84,25 @@ func (ms MsgServer) Mint(ctx context.Context, req *types.MsgMint) (*types.EmptyR
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
 	}
+
+	var recipient sdk.AccAddress
+	if req.Recipient != "" {
+		recipient, err = sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid recipient")
+		}
+	}
+
 	if err := ms.keeper.Mint(
 		sdk.UnwrapSDKContext(ctx),
 		types.MintSettings{
-			Sender:  owner,
-			ClassID: req.ClassID,
-			ID:      req.ID,
-			URI:     req.URI,
-			URIHash: req.URIHash,
-			Data:    req.Data,
+			Sender:    owner,
+			Recipient: recipient,
+			ClassID:   req.ClassID,
+			ID:        req.ID,
+			URI:       req.URI,
+			URIHash:   req.URIHash,
+			Data:      req.Data,
 		},
 	); err != nil {
 		return nil, err
@@ -170,3 +197,286 @@ func (ms MsgServer) RemoveFromWhitelist(ctx context.Context, req *types.MsgRemov
 
 	return &types.EmptyResponse{}, nil
 }
+
+// TransferWithPrice transfers the non-fungible token in exchange for the price and pays the royalty to the issuer.
+func (ms MsgServer) TransferWithPrice(ctx context.Context, req *types.MsgTransferWithPrice) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(req.Receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid receiver")
+	}
+
+	if err := ms.keeper.TransferWithPrice(sdk.UnwrapSDKContext(ctx), sender, receiver, req.ClassID, req.ID, req.Price); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// MintBatch mints multiple non-fungible tokens.
+func (ms MsgServer) MintBatch(ctx context.Context, req *types.MsgMintBatch) (*types.EmptyResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	settings := make([]types.MintSettings, 0, len(req.Items))
+	for _, item := range req.Items {
+		settings = append(settings, types.MintSettings{
+			Sender:  owner,
+			ClassID: req.ClassID,
+			ID:      item.ID,
+			URI:     item.URI,
+			URIHash: item.URIHash,
+			Data:    item.Data,
+		})
+	}
+
+	if err := ms.keeper.MintBatch(sdk.UnwrapSDKContext(ctx), settings); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BurnBatch burns multiple non-fungible tokens.
+func (ms MsgServer) BurnBatch(ctx context.Context, req *types.MsgBurnBatch) (*types.EmptyResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.BurnBatch(sdk.UnwrapSDKContext(ctx), owner, req.ClassID, req.IDs); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SendBatch sends multiple non-fungible tokens to the receiver.
+func (ms MsgServer) SendBatch(ctx context.Context, req *types.MsgSendBatch) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(req.Receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid receiver")
+	}
+
+	if err := ms.keeper.SendBatch(sdk.UnwrapSDKContext(ctx), sender, receiver, req.ClassID, req.IDs); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateNFT updates the metadata of the non-fungible token.
+func (ms MsgServer) UpdateNFT(ctx context.Context, req *types.MsgUpdateNFT) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.UpdateNFT(
+		sdk.UnwrapSDKContext(ctx),
+		types.UpdateNFTSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			ID:      req.ID,
+			URI:     req.URI,
+			URIHash: req.URIHash,
+			Data:    req.Data,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateClass updates the metadata of the non-fungible token class.
+func (ms MsgServer) UpdateClass(ctx context.Context, req *types.MsgUpdateClass) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.UpdateClass(
+		sdk.UnwrapSDKContext(ctx),
+		types.UpdateClassSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			URI:     req.URI,
+			URIHash: req.URIHash,
+			Data:    req.Data,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClassFreeze freezes the non-fungible token class for the account or as a whole.
+func (ms MsgServer) ClassFreeze(ctx context.Context, req *types.MsgClassFreeze) (*types.EmptyResponse, error) {
+	sender, account, err := parseClassFreezingAddresses(req.Sender, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.keeper.ClassFreeze(sdk.UnwrapSDKContext(ctx), sender, req.ClassID, account); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClassUnfreeze removes the freezing of the non-fungible token class for the account or as a whole.
+func (ms MsgServer) ClassUnfreeze(ctx context.Context, req *types.MsgClassUnfreeze) (*types.EmptyResponse, error) {
+	sender, account, err := parseClassFreezingAddresses(req.Sender, req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.keeper.ClassUnfreeze(sdk.UnwrapSDKContext(ctx), sender, req.ClassID, account); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+func parseClassFreezingAddresses(senderString, accountString string) (sdk.AccAddress, sdk.AccAddress, error) {
+	sender, err := sdk.AccAddressFromBech32(senderString)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if accountString == "" {
+		return sender, nil, nil
+	}
+
+	account, err := sdk.AccAddressFromBech32(accountString)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+
+	return sender, account, nil
+}
+
+// AddToClassWhitelist adds an account to the whitelisted list of accounts for the whole class.
+func (ms MsgServer) AddToClassWhitelist(ctx context.Context, req *types.MsgAddToClassWhitelist) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+
+	if err := ms.keeper.AddToClassWhitelist(sdk.UnwrapSDKContext(ctx), req.ClassID, sender, account); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveFromClassWhitelist removes an account from the whitelisted list of accounts for the whole class.
+func (ms MsgServer) RemoveFromClassWhitelist(ctx context.Context, req *types.MsgRemoveFromClassWhitelist) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+
+	if err := ms.keeper.RemoveFromClassWhitelist(sdk.UnwrapSDKContext(ctx), req.ClassID, sender, account); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Revoke burns the non-fungible token held by any account of the revocable class.
+func (ms MsgServer) Revoke(ctx context.Context, req *types.MsgRevoke) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.Revoke(sdk.UnwrapSDKContext(ctx), sender, req.ClassID, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetUser sets the user of the non-fungible token.
+func (ms MsgServer) SetUser(ctx context.Context, req *types.MsgSetUser) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	var user sdk.AccAddress
+	if req.User != "" {
+		user, err = sdk.AccAddressFromBech32(req.User)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid user")
+		}
+	}
+
+	if err := ms.keeper.SetUser(sdk.UnwrapSDKContext(ctx), sender, req.ClassID, req.ID, user, req.Expires); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// GrantMinter allows the minter to mint the NFTs of the class.
+func (ms MsgServer) GrantMinter(ctx context.Context, req *types.MsgGrantMinter) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid minter")
+	}
+
+	if err := ms.keeper.GrantMinter(sdk.UnwrapSDKContext(ctx), req.ClassID, sender, minter, req.Quota); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RevokeMinter removes the right to mint the NFTs of the class from the minter.
+func (ms MsgServer) RevokeMinter(ctx context.Context, req *types.MsgRevokeMinter) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid minter")
+	}
+
+	if err := ms.keeper.RevokeMinter(sdk.UnwrapSDKContext(ctx), req.ClassID, sender, minter); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// GetOwnerNFTs returns paginated non-fungible tokens held by the owner together with their class settings and state.
+// If classID is not empty only the tokens of that class are returned.
+func (k Keeper) GetOwnerNFTs(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	classID string,
+	q *query.PageRequest,
+) (*query.PageResponse, []types.OwnerNFT, error) {
+	if classID != "" {
+		if _, err := k.GetClassDefinition(ctx, classID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	nfts, pageRes, err := k.nftKeeper.GetNFTsOfOwner(ctx, owner, classID, q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	definitions := make(map[string]types.ClassDefinition)
+	ownerNFTs := make([]types.OwnerNFT, 0, len(nfts))
+	for _, token := range nfts {
+		definition, ok := definitions[token.ClassId]
+		if !ok {
+			definition, err = k.GetClassDefinition(ctx, token.ClassId)
+			if err != nil {
+				return nil, nil, err
+			}
+			definitions[token.ClassId] = definition
+		}
+
+		ownerNFT := types.OwnerNFT{
+			ClassId:  token.ClassId,
+			Id:       token.Id,
+			URI:      token.Uri,
+			URIHash:  token.UriHash,
+			Data:     token.Data,
+			Issuer:   definition.Issuer,
+			Features: definition.Features,
+		}
+		if ownerNFT.Frozen, err = k.isOwnerNFTFrozen(ctx, definition, token.Id, owner); err != nil {
+			return nil, nil, err
+		}
+		if ownerNFT.Whitelisted, err = k.isOwnerNFTWhitelisted(ctx, definition, token.Id, owner); err != nil {
+			return nil, nil, err
+		}
+		ownerNFTs = append(ownerNFTs, ownerNFT)
+	}
+
+	return pageRes, ownerNFTs, nil
+}
+
+func (k Keeper) isOwnerNFTFrozen(
+	ctx sdk.Context,
+	definition types.ClassDefinition,
+	nftID string,
+	owner sdk.AccAddress,
+) (bool, error) {
+	if !definition.IsFeatureEnabled(types.ClassFeature_freezing) {
+		return false, nil
+	}
+
+	frozen, err := k.isFrozen(ctx, definition.ID, nftID)
+	if err != nil || frozen {
+		return frozen, err
+	}
+
+	return k.isClassFrozen(ctx, definition.ID, owner)
+}
+
+func (k Keeper) isOwnerNFTWhitelisted(
+	ctx sdk.Context,
+	definition types.ClassDefinition,
+	nftID string,
+	owner sdk.AccAddress,
+) (bool, error) {
+	if !definition.IsFeatureEnabled(types.ClassFeature_whitelisting) {
+		return false, nil
+	}
+
+	if definition.IsIssuer(owner) {
+		return true, nil
+	}
+
+	whitelisted, err := k.isClassWhitelisted(ctx, definition.ID, owner)
+	if err != nil || whitelisted {
+		return whitelisted, err
+	}
+
+	return k.isWhitelisted(ctx, definition.ID, nftID, owner)
+}
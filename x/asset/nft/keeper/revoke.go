@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// Revoke burns the non-fungible token held by any account. It is allowed for the issuer of the revocable class only.
+func (k Keeper) Revoke(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error {
+	definition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if err := definition.CheckFeatureAllowed(sender, types.ClassFeature_revocable); err != nil {
+		return err
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	}
+	owner := k.nftKeeper.GetOwner(ctx, classID, nftID)
+
+	if err := k.SetFrozen(ctx, classID, nftID, false); err != nil {
+		return err
+	}
+
+	if err := k.clearUser(ctx, classID, nftID); err != nil {
+		return err
+	}
+
+	if err := k.nftKeeper.Burn(ctx, classID, nftID); err != nil {
+		return err
+	}
+
+	if err := k.SetBurnt(ctx, classID, nftID); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRevoked{
+		ClassId: classID,
+		Id:      nftID,
+		Owner:   owner.String(),
+	})
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+)
+
+// TransferWithPrice transfers the non-fungible token from the sender to the receiver, the receiver pays the price
+// to the sender and the royalty part of the price is sent to the class issuer.
+func (k Keeper) TransferWithPrice(ctx sdk.Context, sender, receiver sdk.AccAddress, classID, nftID string, price sdk.Coin) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	}
+
+	if !k.nftKeeper.GetOwner(ctx, classID, nftID).Equals(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, nftID)
+	}
+
+	if err := k.BeforeTransfer(ctx, classID, nftID, receiver); err != nil {
+		return err
+	}
+
+	royalty := classDefinition.CalculateRoyalty(price)
+	if royalty.IsPositive() {
+		issuer := sdk.MustAccAddressFromBech32(classDefinition.Issuer)
+		if err := k.bankKeeper.SendCoins(ctx, receiver, issuer, sdk.NewCoins(royalty)); err != nil {
+			return sdkerrors.Wrapf(err, "can't send royalty %s to the issuer %s", royalty, issuer)
+		}
+	}
+
+	if remainder := price.Sub(royalty); remainder.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, receiver, sender, sdk.NewCoins(remainder)); err != nil {
+			return sdkerrors.Wrapf(err, "can't send price %s to the sender %s", remainder, sender)
+		}
+	}
+
+	if err := k.nftKeeper.Transfer(ctx, classID, nftID, receiver); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventSend{
+		ClassId:  classID,
+		Id:       nftID,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventSend: %s", err)
+	}
+
+	if !royalty.IsPositive() {
+		return nil
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRoyaltyPaid{
+		ClassId: classID,
+		Id:      nftID,
+		Payer:   receiver.String(),
+		Issuer:  classDefinition.Issuer,
+		Amount:  royalty,
+	})
+}
+
+// SendBatch sends non-fungible tokens of the same class from the sender to the receiver.
+// The class checks are done once for the whole batch and all the tokens are verified before any of them is sent.
+func (k Keeper) SendBatch(ctx sdk.Context, sender, receiver sdk.AccAddress, classID string, ids []string) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if !k.nftKeeper.HasNFT(ctx, classID, id) {
+			return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, id)
+		}
+
+		if !k.nftKeeper.GetOwner(ctx, classID, id).Equals(sender) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, id)
+		}
+
+		if err := k.checkNFTSendable(ctx, classDefinition, id); err != nil {
+			return err
+		}
+
+		if err := k.checkNFTReceivable(ctx, classDefinition, id, receiver); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range ids {
+		if err := k.clearUser(ctx, classID, id); err != nil {
+			return err
+		}
+
+		if err := k.nftKeeper.Transfer(ctx, classID, id, receiver); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&nft.EventSend{
+			ClassId:  classID,
+			Id:       id,
+			Sender:   sender.String(),
+			Receiver: receiver.String(),
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventSend: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// UpdateNFT updates the metadata of the non-fungible token.
+func (k Keeper) UpdateNFT(ctx sdk.Context, settings types.UpdateNFTSettings) error {
+	if err := types.ValidateNFTData(settings.Data); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	definition, err := k.GetClassDefinition(ctx, settings.ClassID)
+	if err != nil {
+		return err
+	}
+
+	jsonSchema, err := compileJSONSchema(definition)
+	if err != nil {
+		return err
+	}
+	if err := k.checkNFTData(definition, jsonSchema, settings.Data); err != nil {
+		return err
+	}
+
+	if err := definition.CheckFeatureAllowed(settings.Sender, types.ClassFeature_updatable_metadata); err != nil {
+		return err
+	}
+
+	token, found := k.nftKeeper.GetNFT(ctx, settings.ClassID, settings.ID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", settings.ClassID, settings.ID)
+	}
+
+	oldURIHash := token.UriHash
+	token.Uri = settings.URI
+	token.UriHash = settings.URIHash
+	token.Data = settings.Data
+	if err := k.nftKeeper.Update(ctx, token); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't update non-fungible token: %s", err)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventNFTUpdated{
+		ClassId:    settings.ClassID,
+		Id:         settings.ID,
+		OldUriHash: oldURIHash,
+		NewUriHash: settings.URIHash,
+	})
+}
+
+// UpdateClass updates the metadata of the non-fungible token class.
+func (k Keeper) UpdateClass(ctx sdk.Context, settings types.UpdateClassSettings) error {
+	if err := types.ValidateData(settings.Data); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	definition, err := k.GetClassDefinition(ctx, settings.ClassID)
+	if err != nil {
+		return err
+	}
+
+	if err := definition.CheckFeatureAllowed(settings.Sender, types.ClassFeature_updatable_metadata); err != nil {
+		return err
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, settings.ClassID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClassNotFound, "nft class with ID:%s not found", settings.ClassID)
+	}
+
+	oldURIHash := class.UriHash
+	class.Uri = settings.URI
+	class.UriHash = settings.URIHash
+	class.Data = settings.Data
+	if err := k.nftKeeper.UpdateClass(ctx, class); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't update non-fungible token class: %s", err)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventClassUpdated{
+		ClassId:    settings.ClassID,
+		OldUriHash: oldURIHash,
+		NewUriHash: settings.URIHash,
+	})
+}
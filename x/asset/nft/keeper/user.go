@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// SetUser sets the user of the non-fungible token until the expiration time. It is allowed for the owner only.
+// If the user is nil the current user of the token is removed.
+func (k Keeper) SetUser(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string, user sdk.AccAddress, expires int64) error {
+	if _, err := k.GetClassDefinition(ctx, classID); err != nil {
+		return err
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	}
+
+	if !k.nftKeeper.GetOwner(ctx, classID, nftID).Equals(sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, nftID)
+	}
+
+	if user.Empty() {
+		expires = 0
+		if err := k.clearUser(ctx, classID, nftID); err != nil {
+			return err
+		}
+	} else {
+		if expires <= ctx.BlockTime().Unix() {
+			return sdkerrors.Wrap(types.ErrInvalidInput, "expiration time must be in the future")
+		}
+		if err := k.SetNFTUser(ctx, types.NFTUser{
+			ClassId: classID,
+			Id:      nftID,
+			User:    user.String(),
+			Expires: expires,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventUserSet{
+		ClassId: classID,
+		Id:      nftID,
+		Owner:   sender.String(),
+		User:    user.String(),
+		Expires: expires,
+	})
+}
+
+// SetNFTUser stores the user of the non-fungible token.
+func (k Keeper) SetNFTUser(ctx sdk.Context, nftUser types.NFTUser) error {
+	key, err := types.CreateUserKey(nftUser.ClassId, nftUser.Id)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&nftUser))
+	return nil
+}
+
+// GetUser returns the user of the non-fungible token, false is returned if the user is not set or expired.
+func (k Keeper) GetUser(ctx sdk.Context, classID, nftID string) (types.NFTUser, bool, error) {
+	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
+		return types.NFTUser{}, false, sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	}
+
+	key, err := types.CreateUserKey(classID, nftID)
+	if err != nil {
+		return types.NFTUser{}, false, err
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return types.NFTUser{}, false, nil
+	}
+
+	var nftUser types.NFTUser
+	k.cdc.MustUnmarshal(bz, &nftUser)
+
+	// the expired user is ignored, it is removed lazily on the next update or transfer of the token
+	if nftUser.Expires <= ctx.BlockTime().Unix() {
+		return types.NFTUser{}, false, nil
+	}
+
+	return nftUser, true, nil
+}
+
+// GetNFTUsers returns all the stored users of the non-fungible tokens including the expired ones.
+func (k Keeper) GetNFTUsers(ctx sdk.Context, q *query.PageRequest) (*query.PageResponse, []types.NFTUser, error) {
+	users := make([]types.NFTUser, 0)
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTUserKeyPrefix),
+		q, func(_, value []byte) error {
+			var nftUser types.NFTUser
+			if err := k.cdc.Unmarshal(value, &nftUser); err != nil {
+				return err
+			}
+
+			users = append(users, nftUser)
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pageRes, users, nil
+}
+
+func (k Keeper) clearUser(ctx sdk.Context, classID, nftID string) error {
+	key, err := types.CreateUserKey(classID, nftID)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(key)
+	return nil
+}
@@ -18,6 +18,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUnfreeze{},
 		&MsgAddToWhitelist{},
 		&MsgRemoveFromWhitelist{},
+		&MsgTransferWithPrice{},
+		&MsgMintBatch{},
+		&MsgBurnBatch{},
+		&MsgSendBatch{},
+		&MsgUpdateNFT{},
+		&MsgUpdateClass{},
+		&MsgClassFreeze{},
+		&MsgClassUnfreeze{},
+		&MsgAddToClassWhitelist{},
+		&MsgRemoveFromClassWhitelist{},
+		&MsgRevoke{},
+		&MsgSetUser{},
+		&MsgGrantMinter{},
+		&MsgRevokeMinter{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CoreumFoundation/coreum/x/nft"
 )
@@ -10,15 +11,22 @@ import (
 type NFTKeeper interface {
 	SaveClass(ctx sdk.Context, class nft.Class) error
 	GetClass(ctx sdk.Context, classID string) (nft.Class, bool)
+	UpdateClass(ctx sdk.Context, class nft.Class) error
 	HasClass(ctx sdk.Context, classID string) bool
 	HasNFT(ctx sdk.Context, classID, id string) bool
+	GetNFT(ctx sdk.Context, classID, nftID string) (nft.NFT, bool)
 	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Update(ctx sdk.Context, token nft.NFT) error
 	Burn(ctx sdk.Context, classID, nftID string) error
+	Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error
 	GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress
+	GetNFTsOfOwner(ctx sdk.Context, owner sdk.AccAddress, classID string, pagination *query.PageRequest) ([]nft.NFT, *query.PageResponse, error)
+	GetTotalSupply(ctx sdk.Context, classID string) uint64
 }
 
 // BankKeeper defines the expected bank interface.
 type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
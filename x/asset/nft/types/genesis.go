@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // DefaultGenesis returns the default NFT genesis state.
@@ -37,16 +38,59 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, classFrozen := range gs.ClassFrozenAccounts {
+		if err := classFrozen.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, classID := range gs.FrozenClasses {
+		if _, err := DeconstructClassID(classID); err != nil {
+			return err
+		}
+	}
+
+	for _, classWhitelisted := range gs.ClassWhitelistedAccounts {
+		if err := classWhitelisted.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, user := range gs.NFTUsers {
+		if err := user.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, minter := range gs.ClassMinters {
+		if err := minter.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
 // Validate performs basic validation on the fields of ClassDefinition.
 func (nftd ClassDefinition) Validate() error {
-	if _, err := DeconstructClassID(nftd.ID); err != nil {
+	issuer, err := DeconstructClassID(nftd.ID)
+	if err != nil {
+		return err
+	}
+
+	if issuer.String() != nftd.Issuer {
+		return sdkerrors.Wrapf(ErrInvalidInput, "issuer %s does not match the class ID %s", nftd.Issuer, nftd.ID)
+	}
+
+	if err := ValidateRoyaltyRate(nftd.RoyaltyRate); err != nil {
+		return err
+	}
+
+	if err := ValidateMintFee(nftd.MintFee); err != nil {
 		return err
 	}
 
-	return ValidateRoyaltyRate(nftd.RoyaltyRate)
+	return ValidateDataSchema(nftd.DataSchema)
 }
 
 // Validate performs basic validation on the fields of FrozenNFT.
@@ -96,3 +140,67 @@ func (b BurntNFT) Validate() error {
 
 	return nil
 }
+
+// Validate performs basic validation on the fields of ClassFrozenAccounts.
+func (c ClassFrozenAccounts) Validate() error {
+	if _, err := DeconstructClassID(c.ClassID); err != nil {
+		return err
+	}
+
+	for _, acc := range c.Accounts {
+		if _, err := sdk.AccAddressFromBech32(acc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation on the fields of ClassWhitelistedAccounts.
+func (c ClassWhitelistedAccounts) Validate() error {
+	if _, err := DeconstructClassID(c.ClassID); err != nil {
+		return err
+	}
+
+	for _, acc := range c.Accounts {
+		if _, err := sdk.AccAddressFromBech32(acc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation on the fields of NFTUser.
+func (u NFTUser) Validate() error {
+	if _, err := DeconstructClassID(u.ClassId); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenID(u.Id); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(u.User); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Validate performs basic validation on the fields of ClassMinter.
+func (m ClassMinter) Validate() error {
+	if _, err := DeconstructClassID(m.ClassId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Minter); err != nil {
+		return err
+	}
+
+	if m.Quota == 0 {
+		return sdkerrors.Wrapf(ErrInvalidInput, "quota of the minter %s must be positive", m.Minter)
+	}
+
+	return nil
+}
@@ -28,10 +28,15 @@ type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// class_definitions keep the non-fungible token class definitions state
-	ClassDefinitions       []ClassDefinition        `protobuf:"bytes,2,rep,name=class_definitions,json=classDefinitions,proto3" json:"class_definitions"`
-	FrozenNFTs             []FrozenNFT              `protobuf:"bytes,3,rep,name=frozen_nfts,json=frozenNfts,proto3" json:"frozen_nfts"`
-	WhitelistedNFTAccounts []WhitelistedNFTAccounts `protobuf:"bytes,4,rep,name=whitelisted_nft_accounts,json=whitelistedNftAccounts,proto3" json:"whitelisted_nft_accounts"`
-	BurntNFTs              []BurntNFT               `protobuf:"bytes,5,rep,name=burnt_nfts,json=burntNfts,proto3" json:"burnt_nfts"`
+	ClassDefinitions         []ClassDefinition          `protobuf:"bytes,2,rep,name=class_definitions,json=classDefinitions,proto3" json:"class_definitions"`
+	FrozenNFTs               []FrozenNFT                `protobuf:"bytes,3,rep,name=frozen_nfts,json=frozenNfts,proto3" json:"frozen_nfts"`
+	WhitelistedNFTAccounts   []WhitelistedNFTAccounts   `protobuf:"bytes,4,rep,name=whitelisted_nft_accounts,json=whitelistedNftAccounts,proto3" json:"whitelisted_nft_accounts"`
+	BurntNFTs                []BurntNFT                 `protobuf:"bytes,5,rep,name=burnt_nfts,json=burntNfts,proto3" json:"burnt_nfts"`
+	ClassFrozenAccounts      []ClassFrozenAccounts      `protobuf:"bytes,6,rep,name=class_frozen_accounts,json=classFrozenAccounts,proto3" json:"class_frozen_accounts"`
+	FrozenClasses            []string                   `protobuf:"bytes,7,rep,name=frozen_classes,json=frozenClasses,proto3" json:"frozen_classes,omitempty"`
+	ClassWhitelistedAccounts []ClassWhitelistedAccounts `protobuf:"bytes,8,rep,name=class_whitelisted_accounts,json=classWhitelistedAccounts,proto3" json:"class_whitelisted_accounts"`
+	NFTUsers                 []NFTUser                  `protobuf:"bytes,9,rep,name=nft_users,json=nftUsers,proto3" json:"nft_users"`
+	ClassMinters             []ClassMinter              `protobuf:"bytes,10,rep,name=class_minters,json=classMinters,proto3" json:"class_minters"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +107,41 @@ func (m *GenesisState) GetBurntNFTs() []BurntNFT {
 	return nil
 }
 
+func (m *GenesisState) GetClassFrozenAccounts() []ClassFrozenAccounts {
+	if m != nil {
+		return m.ClassFrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenClasses() []string {
+	if m != nil {
+		return m.FrozenClasses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassWhitelistedAccounts() []ClassWhitelistedAccounts {
+	if m != nil {
+		return m.ClassWhitelistedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNFTUsers() []NFTUser {
+	if m != nil {
+		return m.NFTUsers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassMinters() []ClassMinter {
+	if m != nil {
+		return m.ClassMinters
+	}
+	return nil
+}
+
 type FrozenNFT struct {
 	ClassID string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
 	NftIDs  []string `protobuf:"bytes,2,rep,name=nftIDs,proto3" json:"nftIDs,omitempty"`
@@ -214,6 +254,110 @@ func (m *WhitelistedNFTAccounts) GetAccounts() []string {
 	return nil
 }
 
+type ClassFrozenAccounts struct {
+	ClassID  string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *ClassFrozenAccounts) Reset()         { *m = ClassFrozenAccounts{} }
+func (m *ClassFrozenAccounts) String() string { return proto.CompactTextString(m) }
+func (*ClassFrozenAccounts) ProtoMessage()    {}
+func (*ClassFrozenAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3abcf08d60f6fbfd, []int{3}
+}
+func (m *ClassFrozenAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassFrozenAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassFrozenAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassFrozenAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassFrozenAccounts.Merge(m, src)
+}
+func (m *ClassFrozenAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassFrozenAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassFrozenAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassFrozenAccounts proto.InternalMessageInfo
+
+func (m *ClassFrozenAccounts) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *ClassFrozenAccounts) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type ClassWhitelistedAccounts struct {
+	ClassID  string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *ClassWhitelistedAccounts) Reset()         { *m = ClassWhitelistedAccounts{} }
+func (m *ClassWhitelistedAccounts) String() string { return proto.CompactTextString(m) }
+func (*ClassWhitelistedAccounts) ProtoMessage()    {}
+func (*ClassWhitelistedAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3abcf08d60f6fbfd, []int{4}
+}
+func (m *ClassWhitelistedAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassWhitelistedAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassWhitelistedAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassWhitelistedAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassWhitelistedAccounts.Merge(m, src)
+}
+func (m *ClassWhitelistedAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassWhitelistedAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassWhitelistedAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassWhitelistedAccounts proto.InternalMessageInfo
+
+func (m *ClassWhitelistedAccounts) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *ClassWhitelistedAccounts) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
 type BurntNFT struct {
 	ClassID string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
 	NftIDs  []string `protobuf:"bytes,2,rep,name=nftIDs,proto3" json:"nftIDs,omitempty"`
@@ -223,7 +367,7 @@ func (m *BurntNFT) Reset()         { *m = BurntNFT{} }
 func (m *BurntNFT) String() string { return proto.CompactTextString(m) }
 func (*BurntNFT) ProtoMessage()    {}
 func (*BurntNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3abcf08d60f6fbfd, []int{3}
+	return fileDescriptor_3abcf08d60f6fbfd, []int{5}
 }
 func (m *BurntNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,43 +414,54 @@ func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.asset.nft.v1.GenesisState")
 	proto.RegisterType((*FrozenNFT)(nil), "coreum.asset.nft.v1.FrozenNFT")
 	proto.RegisterType((*WhitelistedNFTAccounts)(nil), "coreum.asset.nft.v1.WhitelistedNFTAccounts")
+	proto.RegisterType((*ClassFrozenAccounts)(nil), "coreum.asset.nft.v1.ClassFrozenAccounts")
+	proto.RegisterType((*ClassWhitelistedAccounts)(nil), "coreum.asset.nft.v1.ClassWhitelistedAccounts")
 	proto.RegisterType((*BurntNFT)(nil), "coreum.asset.nft.v1.BurntNFT")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/genesis.proto", fileDescriptor_3abcf08d60f6fbfd) }
 
 var fileDescriptor_3abcf08d60f6fbfd = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6b, 0xdb, 0x40,
-	0x10, 0x86, 0xad, 0x38, 0x71, 0xa3, 0x71, 0x0f, 0xcd, 0x36, 0x18, 0xe1, 0x12, 0xc5, 0x35, 0x3d,
-	0x04, 0x0a, 0x12, 0x49, 0x7b, 0x29, 0xb4, 0x87, 0x2a, 0xc6, 0x25, 0x17, 0xb5, 0x28, 0x81, 0x40,
-	0x2f, 0x46, 0x96, 0x57, 0x8e, 0x20, 0xde, 0x35, 0xda, 0x51, 0xfa, 0x71, 0xef, 0xbd, 0x3f, 0x2b,
-	0x47, 0x1f, 0x7b, 0x0a, 0xc5, 0xfe, 0x23, 0x65, 0x3f, 0xac, 0xc6, 0xb0, 0x2d, 0xe4, 0xa6, 0x99,
-	0x7d, 0xe7, 0x79, 0x77, 0x66, 0x35, 0xf0, 0x3c, 0xe3, 0x25, 0xad, 0x66, 0x61, 0x2a, 0x04, 0xc5,
-	0x90, 0xe5, 0x18, 0xde, 0x1c, 0x87, 0x53, 0xca, 0xa8, 0x28, 0x44, 0x30, 0x2f, 0x39, 0x72, 0xf2,
-	0x54, 0x4b, 0x02, 0x25, 0x09, 0x58, 0x8e, 0xc1, 0xcd, 0x71, 0x77, 0x7f, 0xca, 0xa7, 0x5c, 0x9d,
-	0x87, 0xf2, 0x4b, 0x4b, 0xbb, 0x3d, 0x1b, 0x6d, 0x9e, 0x96, 0xe9, 0xcc, 0xc0, 0xba, 0x07, 0x36,
-	0x85, 0x64, 0xaa, 0xe3, 0xfe, 0xa2, 0x09, 0x8f, 0x3f, 0x68, 0xf7, 0x73, 0x4c, 0x91, 0x92, 0x37,
-	0xd0, 0xd2, 0xf5, 0x9e, 0xd3, 0x73, 0x8e, 0xda, 0x27, 0xcf, 0x02, 0xcb, 0x6d, 0x82, 0x4f, 0x4a,
-	0x12, 0x6d, 0xdf, 0xde, 0x1d, 0x36, 0x12, 0x53, 0x40, 0x2e, 0x61, 0x2f, 0xbb, 0x4e, 0x85, 0x18,
-	0x4d, 0x68, 0x5e, 0xb0, 0x02, 0x0b, 0xce, 0x84, 0xb7, 0xd5, 0x6b, 0x1e, 0xb5, 0x4f, 0x5e, 0x58,
-	0x29, 0xa7, 0x52, 0x3d, 0xa8, 0xc5, 0x06, 0xf7, 0x24, 0xdb, 0x4c, 0x0b, 0x72, 0x0e, 0xed, 0xbc,
-	0xe4, 0xdf, 0x29, 0x1b, 0xb1, 0x1c, 0x85, 0xd7, 0x54, 0x48, 0xdf, 0x8a, 0x1c, 0x2a, 0x5d, 0x3c,
-	0xbc, 0x88, 0x88, 0x84, 0x2d, 0xef, 0x0e, 0xa1, 0x4e, 0x89, 0x04, 0x34, 0x26, 0xce, 0x51, 0x90,
-	0x1f, 0x0e, 0x78, 0x5f, 0xae, 0x0a, 0xa4, 0xd7, 0x85, 0x40, 0x3a, 0x91, 0xe8, 0x51, 0x9a, 0x65,
-	0xbc, 0x62, 0x28, 0xbc, 0x6d, 0x65, 0xf1, 0xd2, 0x6a, 0x71, 0xf9, 0xb7, 0x28, 0x1e, 0x5e, 0xbc,
-	0x37, 0x25, 0x91, 0x6f, 0xfc, 0x3a, 0xf6, 0xf3, 0xa4, 0x73, 0xcf, 0x2c, 0xce, 0x71, 0x9d, 0x27,
-	0x1f, 0x01, 0xc6, 0x55, 0xc9, 0x50, 0xf7, 0xb6, 0xa3, 0x8c, 0x0f, 0xac, 0xc6, 0x91, 0x94, 0xc9,
-	0xd6, 0xf6, 0x8c, 0x95, 0xbb, 0xce, 0x88, 0xc4, 0x55, 0x0c, 0xd9, 0x58, 0xff, 0x1d, 0xb8, 0x75,
-	0xcb, 0xc4, 0x83, 0x47, 0x6a, 0x9c, 0x67, 0x03, 0xf5, 0x9e, 0x6e, 0xb2, 0x0e, 0x49, 0x07, 0x5a,
-	0x2c, 0xc7, 0xb3, 0x81, 0x7e, 0x22, 0x37, 0x31, 0x51, 0x7f, 0x02, 0xff, 0xe8, 0xe0, 0x3f, 0xac,
-	0x7d, 0xd8, 0x51, 0xd5, 0xde, 0x96, 0xca, 0xeb, 0x80, 0x74, 0x61, 0x77, 0x63, 0xa0, 0x6e, 0x52,
-	0xc7, 0xfd, 0xb7, 0xb0, 0xbb, 0xbe, 0xfc, 0xc3, 0xef, 0x18, 0xc5, 0xb7, 0x4b, 0xdf, 0x59, 0x2c,
-	0x7d, 0xe7, 0xf7, 0xd2, 0x77, 0x7e, 0xae, 0xfc, 0xc6, 0x62, 0xe5, 0x37, 0x7e, 0xad, 0xfc, 0xc6,
-	0xe7, 0xd7, 0xd3, 0x02, 0xaf, 0xaa, 0x71, 0x90, 0xf1, 0x59, 0x78, 0xaa, 0x66, 0x38, 0xe4, 0x15,
-	0x9b, 0xa4, 0xf2, 0x47, 0x0a, 0xcd, 0x2a, 0x7c, 0xbd, 0xb7, 0x0c, 0xf8, 0x6d, 0x4e, 0xc5, 0xb8,
-	0xa5, 0x96, 0xe1, 0xd5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69, 0x9e, 0x92, 0x56, 0x9d, 0x03,
-	0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x75, 0xeb, 0x9a, 0xd7, 0x0d, 0x6d, 0xee, 0xa8, 0xa2, 0xc2, 0xb2, 0x52, 0x81,
+	0x54, 0x09, 0x91, 0x68, 0x83, 0x0b, 0x12, 0x1c, 0x48, 0xab, 0xa2, 0xa9, 0x22, 0x4c, 0x59, 0xd1,
+	0x24, 0x2e, 0x55, 0x9a, 0x3a, 0x5d, 0xa4, 0xd5, 0x29, 0xb1, 0x33, 0x7e, 0xdc, 0xb9, 0xf3, 0x67,
+	0xed, 0xb8, 0x23, 0xa7, 0x09, 0xb5, 0x17, 0xfe, 0x0c, 0x14, 0xdb, 0xcd, 0xba, 0x91, 0x4c, 0x82,
+	0x5b, 0xfc, 0xde, 0xd7, 0x9f, 0xef, 0xf3, 0x7b, 0x8e, 0xe1, 0x91, 0x17, 0x46, 0x38, 0x9e, 0x98,
+	0x2e, 0xa5, 0x98, 0x99, 0xc4, 0x67, 0xe6, 0xf9, 0xbe, 0x39, 0xc6, 0x04, 0xd3, 0x80, 0x1a, 0xd3,
+	0x28, 0x64, 0x21, 0xaa, 0x0a, 0x89, 0xc1, 0x25, 0x06, 0xf1, 0x99, 0x71, 0xbe, 0x5f, 0xdf, 0x19,
+	0x87, 0xe3, 0x90, 0xe7, 0xcd, 0xe4, 0x4b, 0x48, 0xeb, 0x8d, 0x2c, 0xda, 0xd4, 0x8d, 0xdc, 0x89,
+	0x84, 0xd5, 0x77, 0xb3, 0x14, 0x09, 0x93, 0xa7, 0x9b, 0xbf, 0x4b, 0xb0, 0xf1, 0x56, 0xb8, 0x1f,
+	0x33, 0x97, 0x61, 0xf4, 0x12, 0x4a, 0x62, 0xbf, 0xa6, 0x34, 0x94, 0x56, 0xe5, 0xe0, 0x81, 0x91,
+	0x51, 0x8d, 0x71, 0xc4, 0x25, 0xd6, 0xea, 0xc5, 0xd5, 0x5e, 0xc1, 0x91, 0x1b, 0xd0, 0x09, 0x6c,
+	0x7b, 0x67, 0x2e, 0xa5, 0x83, 0x11, 0xf6, 0x03, 0x12, 0xb0, 0x20, 0x24, 0x54, 0x5b, 0x69, 0x14,
+	0x5b, 0x95, 0x83, 0xc7, 0x99, 0x94, 0x76, 0xa2, 0xee, 0xa4, 0x62, 0x89, 0xdb, 0xf2, 0x6e, 0x86,
+	0x29, 0x3a, 0x86, 0x8a, 0x1f, 0x85, 0xdf, 0x30, 0x19, 0x10, 0x9f, 0x51, 0xad, 0xc8, 0x91, 0x7a,
+	0x26, 0xb2, 0xcb, 0x75, 0x76, 0xb7, 0x6f, 0xa1, 0x04, 0x36, 0xbb, 0xda, 0x83, 0x34, 0x44, 0x1d,
+	0x10, 0x18, 0xdb, 0x67, 0x14, 0x7d, 0x57, 0x40, 0xfb, 0x7c, 0x1a, 0x30, 0x7c, 0x16, 0x50, 0x86,
+	0x47, 0x09, 0x7a, 0xe0, 0x7a, 0x5e, 0x18, 0x13, 0x46, 0xb5, 0x55, 0x6e, 0xf1, 0x34, 0xd3, 0xe2,
+	0xe4, 0x7a, 0x93, 0xdd, 0xed, 0xbf, 0x91, 0x5b, 0x2c, 0x5d, 0xfa, 0xd5, 0xb2, 0xf3, 0x4e, 0x6d,
+	0xc9, 0xcc, 0xf6, 0xd9, 0x22, 0x8e, 0xde, 0x03, 0x0c, 0xe3, 0x88, 0x30, 0x71, 0xb6, 0x35, 0x6e,
+	0xbc, 0x9b, 0x69, 0x6c, 0x25, 0xb2, 0xe4, 0x68, 0xdb, 0xd2, 0x4a, 0x5d, 0x44, 0xa8, 0xa3, 0x72,
+	0x06, 0x3f, 0xd8, 0x10, 0xee, 0x8b, 0x31, 0xc8, 0x9e, 0xa5, 0x87, 0x2a, 0x71, 0x76, 0x2b, 0x7f,
+	0x14, 0xa2, 0x53, 0xe9, 0x89, 0xc4, 0x38, 0xaa, 0xde, 0xdf, 0x29, 0xf4, 0x04, 0xee, 0x49, 0x3a,
+	0xcf, 0x62, 0xaa, 0xad, 0x37, 0x8a, 0x2d, 0xd5, 0xd9, 0x14, 0xd1, 0xb6, 0x08, 0xa2, 0x4f, 0x50,
+	0x17, 0xa5, 0x2c, 0x37, 0x3a, 0xad, 0xa7, 0xcc, 0xeb, 0x79, 0x96, 0x5f, 0xcf, 0x52, 0x27, 0x6f,
+	0x15, 0xa5, 0x79, 0x39, 0x79, 0xd4, 0x03, 0x35, 0x99, 0x64, 0x4c, 0x71, 0x44, 0x35, 0x95, 0x3b,
+	0x3c, 0xcc, 0x74, 0xb0, 0xbb, 0xfd, 0x0f, 0x14, 0x47, 0xd6, 0x96, 0x6c, 0x66, 0x59, 0x06, 0xa8,
+	0x53, 0x26, 0x3e, 0xe3, 0x5f, 0xa8, 0x07, 0x9b, 0xa2, 0xfe, 0x49, 0x40, 0x58, 0x02, 0x04, 0x0e,
+	0x6c, 0xe4, 0x97, 0xfc, 0x8e, 0x0b, 0x65, 0x95, 0x1b, 0xde, 0x75, 0x88, 0x36, 0x5f, 0x83, 0x9a,
+	0x5e, 0x45, 0xa4, 0xc1, 0x3a, 0x4f, 0x1e, 0x76, 0xf8, 0x7f, 0xa6, 0x3a, 0x8b, 0x25, 0xaa, 0x41,
+	0x89, 0xf8, 0xec, 0xb0, 0x23, 0x7e, 0x1d, 0xd5, 0x91, 0xab, 0xe6, 0x08, 0x72, 0x6e, 0xd6, 0x1d,
+	0xac, 0x1d, 0x58, 0xe3, 0xbb, 0xb5, 0x15, 0x1e, 0x17, 0x0b, 0x54, 0x87, 0xf2, 0x8d, 0x8b, 0xae,
+	0x3a, 0xe9, 0xba, 0xd9, 0x83, 0x6a, 0xc6, 0x55, 0xb8, 0xc3, 0x62, 0x19, 0xb6, 0x72, 0x0b, 0x76,
+	0x04, 0x5a, 0xde, 0x1c, 0xff, 0x93, 0xf8, 0x0a, 0xca, 0x8b, 0x3b, 0xff, 0xef, 0x2d, 0xb4, 0xec,
+	0x8b, 0x99, 0xae, 0x5c, 0xce, 0x74, 0xe5, 0xd7, 0x4c, 0x57, 0x7e, 0xcc, 0xf5, 0xc2, 0xe5, 0x5c,
+	0x2f, 0xfc, 0x9c, 0xeb, 0x85, 0x8f, 0x2f, 0xc6, 0x01, 0x3b, 0x8d, 0x87, 0x86, 0x17, 0x4e, 0xcc,
+	0x36, 0x9f, 0x6d, 0x37, 0x8c, 0xc9, 0xc8, 0x4d, 0xde, 0x1f, 0x53, 0xbe, 0xa0, 0x5f, 0x96, 0xde,
+	0x50, 0xf6, 0x75, 0x8a, 0xe9, 0xb0, 0xc4, 0xdf, 0xd0, 0xe7, 0x7f, 0x06, 0x00, 0x42, 0x02, 0xb4,
+	0x94, 0xd4, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -329,6 +484,71 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClassMinters) > 0 {
+		for iNdEx := len(m.ClassMinters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassMinters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.NFTUsers) > 0 {
+		for iNdEx := len(m.NFTUsers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NFTUsers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ClassWhitelistedAccounts) > 0 {
+		for iNdEx := len(m.ClassWhitelistedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassWhitelistedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FrozenClasses) > 0 {
+		for iNdEx := len(m.FrozenClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenClasses[iNdEx])
+			copy(dAtA[i:], m.FrozenClasses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ClassFrozenAccounts) > 0 {
+		for iNdEx := len(m.ClassFrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassFrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.BurntNFTs) > 0 {
 		for iNdEx := len(m.BurntNFTs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -483,6 +703,84 @@ func (m *WhitelistedNFTAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassFrozenAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassFrozenAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassFrozenAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassWhitelistedAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassWhitelistedAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassWhitelistedAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BurntNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -565,6 +863,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClassFrozenAccounts) > 0 {
+		for _, e := range m.ClassFrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenClasses) > 0 {
+		for _, s := range m.FrozenClasses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassWhitelistedAccounts) > 0 {
+		for _, e := range m.ClassWhitelistedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NFTUsers) > 0 {
+		for _, e := range m.NFTUsers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassMinters) > 0 {
+		for _, e := range m.ClassMinters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -610,7 +938,7 @@ func (m *WhitelistedNFTAccounts) Size() (n int) {
 	return n
 }
 
-func (m *BurntNFT) Size() (n int) {
+func (m *ClassFrozenAccounts) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -620,8 +948,8 @@ func (m *BurntNFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if len(m.NftIDs) > 0 {
-		for _, s := range m.NftIDs {
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
 			l = len(s)
 			n += 1 + l + sovGenesis(uint64(l))
 		}
@@ -629,11 +957,49 @@ func (m *BurntNFT) Size() (n int) {
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ClassWhitelistedAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BurntNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.NftIDs) > 0 {
+		for _, s := range m.NftIDs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -833,6 +1199,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassFrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassFrozenAccounts = append(m.ClassFrozenAccounts, ClassFrozenAccounts{})
+			if err := m.ClassFrozenAccounts[len(m.ClassFrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenClasses = append(m.FrozenClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassWhitelistedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassWhitelistedAccounts = append(m.ClassWhitelistedAccounts, ClassWhitelistedAccounts{})
+			if err := m.ClassWhitelistedAccounts[len(m.ClassWhitelistedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTUsers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTUsers = append(m.NFTUsers, NFTUser{})
+			if err := m.NFTUsers[len(m.NFTUsers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassMinters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassMinters = append(m.ClassMinters, ClassMinter{})
+			if err := m.ClassMinters[len(m.ClassMinters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1114,6 +1648,234 @@ func (m *WhitelistedNFTAccounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassFrozenAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassFrozenAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassFrozenAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassWhitelistedAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassWhitelistedAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassWhitelistedAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BurntNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
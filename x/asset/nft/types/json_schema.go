@@ -0,0 +1,423 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"unicode/utf8"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+const (
+	// MaxJSONSchemaDepth is the maximum nesting depth of the JSON schema.
+	MaxJSONSchemaDepth = 16
+
+	// jsonNumberPrecision is the precision (in bits) used to compare the JSON numbers.
+	jsonNumberPrecision = 256
+)
+
+const (
+	jsonTypeObject  = "object"
+	jsonTypeArray   = "array"
+	jsonTypeString  = "string"
+	jsonTypeNumber  = "number"
+	jsonTypeInteger = "integer"
+	jsonTypeBoolean = "boolean"
+	jsonTypeNull    = "null"
+)
+
+// JSONSchema is the compiled JSON schema of the NFT data.
+//
+// Only the subset of the JSON schema keywords is supported to keep the validation deterministic and its cost linear
+// to the size of the validated document: type, enum (scalar values only), properties, required,
+// additionalProperties (boolean only), minProperties, maxProperties, items (single schema only), minItems, maxItems,
+// minLength, maxLength, minimum, maximum and the annotations $schema, $comment, title and description.
+// Any other keyword, including references and patterns, makes the schema invalid.
+type JSONSchema struct {
+	types                map[string]bool
+	enum                 map[string]bool
+	properties           map[string]*JSONSchema
+	required             []string
+	additionalProperties bool
+	minProperties        *int
+	maxProperties        *int
+	items                *JSONSchema
+	minItems             *int
+	maxItems             *int
+	minLength            *int
+	maxLength            *int
+	minimum              *big.Float
+	maximum              *big.Float
+}
+
+// CompileJSONSchema compiles the JSON schema of the data schema.
+func (s DataSchema) CompileJSONSchema() (*JSONSchema, error) {
+	doc, err := decodeJSON([]byte(s.JSONSchema))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "invalid JSON schema: %s", err)
+	}
+
+	schema, err := compileJSONSchema(doc, 1)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "invalid JSON schema: %s", err)
+	}
+
+	return schema, nil
+}
+
+// Validate checks the provided JSON document satisfies the schema.
+func (s *JSONSchema) Validate(doc []byte) error {
+	value, err := decodeJSON(doc)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid JSON data: %s", err)
+	}
+
+	if err := s.validate(value, "$"); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "data does not satisfy the JSON schema: %s", err)
+	}
+
+	return nil
+}
+
+//nolint:funlen,gocyclo // the switch over the supported keywords is the simplest form
+func compileJSONSchema(node interface{}, depth int) (*JSONSchema, error) {
+	if depth > MaxJSONSchemaDepth {
+		return nil, errors.Errorf("schema is nested deeper than %d levels", MaxJSONSchemaDepth)
+	}
+
+	keywords, ok := node.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("schema must be an object")
+	}
+
+	schema := &JSONSchema{
+		additionalProperties: true,
+	}
+
+	// the keywords are sorted to report the same error regardless of the map iteration order
+	for _, keyword := range sortedKeys(keywords) {
+		value := keywords[keyword]
+
+		var err error
+		switch keyword {
+		case "$schema", "$comment", "title", "description":
+			if _, ok := value.(string); !ok {
+				err = errors.New("must be a string")
+			}
+		case "type":
+			schema.types, err = compileJSONTypes(value)
+		case "enum":
+			schema.enum, err = compileJSONEnum(value)
+		case "properties":
+			schema.properties, err = compileJSONProperties(value, depth)
+		case "required":
+			schema.required, err = compileJSONRequired(value)
+		case "additionalProperties":
+			schema.additionalProperties, ok = value.(bool)
+			if !ok {
+				err = errors.New("must be a boolean")
+			}
+		case "minProperties":
+			schema.minProperties, err = compileJSONCount(value)
+		case "maxProperties":
+			schema.maxProperties, err = compileJSONCount(value)
+		case "items":
+			schema.items, err = compileJSONSchema(value, depth+1)
+		case "minItems":
+			schema.minItems, err = compileJSONCount(value)
+		case "maxItems":
+			schema.maxItems, err = compileJSONCount(value)
+		case "minLength":
+			schema.minLength, err = compileJSONCount(value)
+		case "maxLength":
+			schema.maxLength, err = compileJSONCount(value)
+		case "minimum":
+			schema.minimum, err = compileJSONNumber(value)
+		case "maximum":
+			schema.maximum, err = compileJSONNumber(value)
+		default:
+			return nil, errors.Errorf("keyword %q is not supported", keyword)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid keyword %q", keyword)
+		}
+	}
+
+	return schema, nil
+}
+
+func compileJSONTypes(value interface{}) (map[string]bool, error) {
+	var typeNames []interface{}
+	switch v := value.(type) {
+	case string:
+		typeNames = []interface{}{v}
+	case []interface{}:
+		typeNames = v
+	default:
+		return nil, errors.New("must be a string or an array of strings")
+	}
+	if len(typeNames) == 0 {
+		return nil, errors.New("at least one type must be provided")
+	}
+
+	types := map[string]bool{}
+	for _, typeName := range typeNames {
+		name, ok := typeName.(string)
+		if !ok {
+			return nil, errors.New("must be a string or an array of strings")
+		}
+		switch name {
+		case jsonTypeObject, jsonTypeArray, jsonTypeString, jsonTypeNumber, jsonTypeInteger, jsonTypeBoolean, jsonTypeNull:
+			types[name] = true
+		default:
+			return nil, errors.Errorf("unknown type %q", name)
+		}
+	}
+
+	return types, nil
+}
+
+func compileJSONEnum(value interface{}) (map[string]bool, error) {
+	values, ok := value.([]interface{})
+	if !ok || len(values) == 0 {
+		return nil, errors.New("must be a non-empty array")
+	}
+
+	enum := map[string]bool{}
+	for _, v := range values {
+		key, ok := jsonEnumKey(v)
+		if !ok {
+			return nil, errors.New("only scalar values are supported")
+		}
+		enum[key] = true
+	}
+
+	return enum, nil
+}
+
+func compileJSONProperties(value interface{}, depth int) (map[string]*JSONSchema, error) {
+	properties, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("must be an object")
+	}
+
+	schemas := make(map[string]*JSONSchema, len(properties))
+	for _, name := range sortedKeys(properties) {
+		schema, err := compileJSONSchema(properties[name], depth+1)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid property %q", name)
+		}
+		schemas[name] = schema
+	}
+
+	return schemas, nil
+}
+
+func compileJSONRequired(value interface{}) ([]string, error) {
+	names, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.New("must be an array of strings")
+	}
+
+	required := make([]string, 0, len(names))
+	for _, n := range names {
+		name, ok := n.(string)
+		if !ok {
+			return nil, errors.New("must be an array of strings")
+		}
+		required = append(required, name)
+	}
+
+	return required, nil
+}
+
+func compileJSONCount(value interface{}) (*int, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return nil, errors.New("must be a non-negative integer")
+	}
+	count, err := number.Int64()
+	if err != nil || count < 0 || count > MaxDataSize {
+		return nil, errors.Errorf("must be an integer between 0 and %d", MaxDataSize)
+	}
+
+	c := int(count)
+	return &c, nil
+}
+
+func compileJSONNumber(value interface{}) (*big.Float, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return nil, errors.New("must be a number")
+	}
+
+	return parseJSONNumber(number)
+}
+
+func (s *JSONSchema) validate(value interface{}, path string) error {
+	valueType := jsonType(value)
+	if len(s.types) > 0 && !s.types[valueType] && !(valueType == jsonTypeInteger && s.types[jsonTypeNumber]) {
+		return errors.Errorf("%s: %s is not allowed", path, valueType)
+	}
+
+	if s.enum != nil {
+		if key, ok := jsonEnumKey(value); !ok || !s.enum[key] {
+			return errors.Errorf("%s: value is not one of the enum values", path)
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return s.validateObject(v, path)
+	case []interface{}:
+		return s.validateArray(v, path)
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.minLength != nil && length < *s.minLength {
+			return errors.Errorf("%s: string must be at least %d characters long", path, *s.minLength)
+		}
+		if s.maxLength != nil && length > *s.maxLength {
+			return errors.Errorf("%s: string must be at most %d characters long", path, *s.maxLength)
+		}
+	case json.Number:
+		number, err := parseJSONNumber(v)
+		if err != nil {
+			return errors.Wrapf(err, "%s", path)
+		}
+		if s.minimum != nil && number.Cmp(s.minimum) < 0 {
+			return errors.Errorf("%s: number must be greater than or equal to %s", path, s.minimum.Text('g', -1))
+		}
+		if s.maximum != nil && number.Cmp(s.maximum) > 0 {
+			return errors.Errorf("%s: number must be less than or equal to %s", path, s.maximum.Text('g', -1))
+		}
+	}
+
+	return nil
+}
+
+func (s *JSONSchema) validateObject(object map[string]interface{}, path string) error {
+	if s.minProperties != nil && len(object) < *s.minProperties {
+		return errors.Errorf("%s: object must have at least %d properties", path, *s.minProperties)
+	}
+	if s.maxProperties != nil && len(object) > *s.maxProperties {
+		return errors.Errorf("%s: object must have at most %d properties", path, *s.maxProperties)
+	}
+
+	for _, name := range s.required {
+		if _, ok := object[name]; !ok {
+			return errors.Errorf("%s: property %q is required", path, name)
+		}
+	}
+
+	// the properties are sorted to report the same error regardless of the map iteration order
+	for _, name := range sortedKeys(object) {
+		schema, ok := s.properties[name]
+		if !ok {
+			if !s.additionalProperties {
+				return errors.Errorf("%s: property %q is not allowed", path, name)
+			}
+			continue
+		}
+		if err := schema.validate(object[name], path+"."+name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *JSONSchema) validateArray(array []interface{}, path string) error {
+	if s.minItems != nil && len(array) < *s.minItems {
+		return errors.Errorf("%s: array must have at least %d items", path, *s.minItems)
+	}
+	if s.maxItems != nil && len(array) > *s.maxItems {
+		return errors.Errorf("%s: array must have at most %d items", path, *s.maxItems)
+	}
+
+	if s.items == nil {
+		return nil
+	}
+	for i, item := range array {
+		if err := s.items.validate(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeJSON decodes the JSON document keeping the numbers in their textual form.
+func decodeJSON(doc []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the JSON document")
+	}
+
+	return value, nil
+}
+
+func jsonType(value interface{}) string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return jsonTypeObject
+	case []interface{}:
+		return jsonTypeArray
+	case string:
+		return jsonTypeString
+	case bool:
+		return jsonTypeBoolean
+	case json.Number:
+		if number, err := parseJSONNumber(v); err == nil && number.IsInt() {
+			return jsonTypeInteger
+		}
+		return jsonTypeNumber
+	default:
+		return jsonTypeNull
+	}
+}
+
+// jsonEnumKey returns the key identifying the scalar value, so the numbers which are equal have the same key.
+func jsonEnumKey(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return "s:" + v, true
+	case bool:
+		return fmt.Sprintf("b:%t", v), true
+	case json.Number:
+		number, err := parseJSONNumber(v)
+		if err != nil {
+			return "", false
+		}
+		return "n:" + number.Text('g', -1), true
+	case nil:
+		return jsonTypeNull, true
+	default:
+		return "", false
+	}
+}
+
+func parseJSONNumber(number json.Number) (*big.Float, error) {
+	f, ok := new(big.Float).SetPrec(jsonNumberPrecision).SetString(number.String())
+	if !ok || f.IsInf() {
+		return nil, errors.Errorf("invalid number %s", number)
+	}
+	return f, nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestDataSchema_CompileJSONSchema(t *testing.T) {
+	testCases := []struct {
+		schema  string
+		isValid bool
+	}{
+		{schema: `{}`, isValid: true},
+		{schema: `{"type":"object","properties":{"level":{"type":"integer","minimum":1}},"required":["level"]}`, isValid: true},
+		{schema: `{"$schema":"http://json-schema.org/draft-07/schema#","title":"t","description":"d","$comment":"c"}`, isValid: true},
+		{schema: `{"type":["string","null"],"enum":["a","b",null],"minLength":1,"maxLength":5}`, isValid: true},
+		{schema: `{"type":"array","items":{"type":"number","maximum":1.5},"minItems":1,"maxItems":10}`, isValid: true},
+		{schema: `{"type":"object","additionalProperties":false,"minProperties":1,"maxProperties":2}`, isValid: true},
+		{schema: ``},
+		{schema: `[]`},
+		{schema: `{} {}`},
+		{schema: `{"type":"unknown"}`},
+		{schema: `{"type":[]}`},
+		{schema: `{"type":1}`},
+		{schema: `{"enum":[]}`},
+		{schema: `{"enum":[{"a":1}]}`},
+		{schema: `{"properties":[]}`},
+		{schema: `{"properties":{"level":{"type":"unknown"}}}`},
+		{schema: `{"required":[1]}`},
+		{schema: `{"additionalProperties":{}}`},
+		{schema: `{"items":[{}]}`},
+		{schema: `{"minLength":-1}`},
+		{schema: `{"maxItems":1.5}`},
+		{schema: `{"minimum":"1"}`},
+		{schema: `{"minimum":1e9999999999}`},
+		{schema: `{"title":1}`},
+		{schema: `{"pattern":"^a+$"}`},
+		{schema: `{"$ref":"#/definitions/level"}`},
+		{schema: `{"properties":{"level":{"$ref":"https://my.invalid/schema.json"}}}`},
+		{schema: strings.Repeat(`{"items":`, types.MaxJSONSchemaDepth) + `{}` + strings.Repeat(`}`, types.MaxJSONSchemaDepth)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.schema, func(t *testing.T) {
+			_, err := types.DataSchema{JSONSchema: tc.schema}.CompileJSONSchema()
+			if tc.isValid {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidInput)
+		})
+	}
+}
+
+func TestJSONSchema_Validate(t *testing.T) {
+	schema, err := types.DataSchema{JSONSchema: `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 2, "maxLength": 4},
+			"level": {"type": "integer", "minimum": 1, "maximum": 10},
+			"ratio": {"type": "number", "minimum": -0.5},
+			"rarity": {"enum": ["common", "rare", 1, true, null]},
+			"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2},
+			"attributes": {"type": "object", "additionalProperties": false, "properties": {"color": {}}, "minProperties": 1}
+		},
+		"required": ["name"],
+		"maxProperties": 6
+	}`}.CompileJSONSchema()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		doc     string
+		isValid bool
+	}{
+		{doc: `{"name":"abc"}`, isValid: true},
+		{doc: `{"name":"żółw","level":10.0,"ratio":-0.5,"rarity":1.0,"tags":["a","b"],"attributes":{"color":1}}`, isValid: true},
+		{doc: `{"name":"abc","rarity":null,"extra":{"any":["thing"]}}`, isValid: true},
+		{doc: `{"name":"abc","rarity":"rare"}`, isValid: true},
+		{doc: ``},
+		{doc: `{"name":"abc"} {}`},
+		{doc: `["name"]`},
+		{doc: `{}`},
+		{doc: `{"name":"a"}`},
+		{doc: `{"name":"abcde"}`},
+		{doc: `{"name":1}`},
+		{doc: `{"name":"abc","level":1.5}`},
+		{doc: `{"name":"abc","level":0}`},
+		{doc: `{"name":"abc","level":11}`},
+		{doc: `{"name":"abc","level":1e99999999999}`},
+		{doc: `{"name":"abc","ratio":-0.6}`},
+		{doc: `{"name":"abc","rarity":"epic"}`},
+		{doc: `{"name":"abc","rarity":false}`},
+		{doc: `{"name":"abc","rarity":["rare"]}`},
+		{doc: `{"name":"abc","tags":["a",1]}`},
+		{doc: `{"name":"abc","tags":["a","b","c"]}`},
+		{doc: `{"name":"abc","attributes":{}}`},
+		{doc: `{"name":"abc","attributes":{"size":1}}`},
+		{doc: `{"name":"abc","a":1,"b":2,"c":3,"d":4,"e":5,"f":6}`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.doc, func(t *testing.T) {
+			err := schema.Validate([]byte(tc.doc))
+			if tc.isValid {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidInput)
+		})
+	}
+}
+
+func TestJSONSchema_ValidateDeterministicError(t *testing.T) {
+	schema, err := types.DataSchema{
+		JSONSchema: `{"type":"object","additionalProperties":false,"properties":{"a":{},"b":{}}}`,
+	}.CompileJSONSchema()
+	require.NoError(t, err)
+
+	// the first property not allowed in the sorted order is reported regardless of the map iteration order
+	for i := 0; i < 10; i++ {
+		err := schema.Validate([]byte(`{"z":1,"y":2,"x":3,"a":4}`))
+		assert.ErrorContains(t, err, fmt.Sprintf("property %q is not allowed", "x"))
+	}
+}
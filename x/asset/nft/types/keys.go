@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/CoreumFoundation/coreum/pkg/store"
@@ -31,6 +32,20 @@ var (
 	NFTWhitelistingKeyPrefix = []byte{0x03}
 	// NFTBurningKeyPrefix defines the key prefix to track burnt NFTs.
 	NFTBurningKeyPrefix = []byte{0x04}
+	// NFTClassFreezingKeyPrefix defines the key prefix to track accounts for which the whole class is frozen.
+	NFTClassFreezingKeyPrefix = []byte{0x05}
+	// NFTFrozenClassKeyPrefix defines the key prefix to track frozen classes.
+	NFTFrozenClassKeyPrefix = []byte{0x06}
+	// NFTClassWhitelistingKeyPrefix defines the key prefix to track accounts whitelisted for the whole class.
+	NFTClassWhitelistingKeyPrefix = []byte{0x07}
+	// NFTIssuerClassKeyPrefix defines the key prefix of the issuer index of the non-fungible token classes.
+	NFTIssuerClassKeyPrefix = []byte{0x08}
+	// NFTUserKeyPrefix defines the key prefix to track users of NFTs.
+	NFTUserKeyPrefix = []byte{0x09}
+	// NFTClassBurntCountKeyPrefix defines the key prefix to track the number of burnt NFTs of the class.
+	NFTClassBurntCountKeyPrefix = []byte{0x0a}
+	// NFTClassMinterKeyPrefix defines the key prefix to track the accounts allowed to mint NFTs of the class.
+	NFTClassMinterKeyPrefix = []byte{0x0b}
 )
 
 // CreateClassKey constructs the key for the non-fungible token class.
@@ -38,6 +53,16 @@ func CreateClassKey(classID string) []byte {
 	return store.JoinKeys(NFTClassKeyPrefix, []byte(classID))
 }
 
+// CreateIssuerClassKey constructs the key of the issuer index for the non-fungible token class.
+func CreateIssuerClassKey(issuer sdk.AccAddress, classID string) []byte {
+	return store.JoinKeys(CreateIssuerClassesPrefix(issuer), []byte(classID))
+}
+
+// CreateIssuerClassesPrefix constructs the prefix for the non-fungible token classes issued by account.
+func CreateIssuerClassesPrefix(issuer sdk.AccAddress) []byte {
+	return store.JoinKeys(NFTIssuerClassKeyPrefix, address.MustLengthPrefix(issuer))
+}
+
 // CreateFreezingKey constructs the key for the freezing of non-fungible token.
 func CreateFreezingKey(classID, nftID string) ([]byte, error) {
 	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID))
@@ -61,6 +86,34 @@ func ParseFreezingKey(key []byte) (string, string, error) {
 	return string(parsedKeys[0]), string(parsedKeys[1]), nil
 }
 
+// CreateClassFreezingKey constructs the key for the freezing of the non-fungible token class for the account.
+func CreateClassFreezingKey(classID string, account sdk.AccAddress) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), account)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(NFTClassFreezingKeyPrefix, compositeKey), nil
+}
+
+// ParseClassFreezingKey parses class freezing key back to class id and account.
+func ParseClassFreezingKey(key []byte) (string, sdk.AccAddress, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(parsedKeys) != 2 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "class freezing key must be composed to 2 length prefixed keys")
+		return "", nil, err
+	}
+	return string(parsedKeys[0]), parsedKeys[1], nil
+}
+
+// CreateFrozenClassKey constructs the key for the freezing of the whole non-fungible token class.
+func CreateFrozenClassKey(classID string) []byte {
+	return store.JoinKeys(NFTFrozenClassKeyPrefix, []byte(classID))
+}
+
 // CreateWhitelistingKey constructs the key for the whitelisting of non-fungible token.
 func CreateWhitelistingKey(classID, nftID string, account sdk.AccAddress) ([]byte, error) {
 	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID), account)
@@ -84,6 +137,29 @@ func ParseWhitelistingKey(key []byte) (string, string, sdk.AccAddress, error) {
 	return string(parsedKeys[0]), string(parsedKeys[1]), parsedKeys[2], nil
 }
 
+// CreateClassWhitelistingKey constructs the key for the whitelisting of the account for the non-fungible token class.
+func CreateClassWhitelistingKey(classID string, account sdk.AccAddress) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), account)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(NFTClassWhitelistingKeyPrefix, compositeKey), nil
+}
+
+// ParseClassWhitelistingKey parses class whitelisting key back to class id and account.
+func ParseClassWhitelistingKey(key []byte) (string, sdk.AccAddress, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(parsedKeys) != 2 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "class whitelisting key must be composed of 2 length prefixed keys")
+		return "", nil, err
+	}
+	return string(parsedKeys[0]), parsedKeys[1], nil
+}
+
 // CreateBurningKey constructs the key for the burning of non-fungible token.
 func CreateBurningKey(classID, nftID string) ([]byte, error) {
 	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID))
@@ -106,3 +182,41 @@ func ParseBurningKey(key []byte) (string, string, error) {
 	}
 	return string(parsedKeys[0]), string(parsedKeys[1]), nil
 }
+
+// CreateUserKey constructs the key for the user of non-fungible token.
+func CreateUserKey(classID, nftID string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID))
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(NFTUserKeyPrefix, compositeKey), nil
+}
+
+// CreateClassBurntCountKey constructs the key for the number of burnt NFTs of the class.
+func CreateClassBurntCountKey(classID string) []byte {
+	return store.JoinKeys(NFTClassBurntCountKeyPrefix, []byte(classID))
+}
+
+// CreateClassMinterKey constructs the key for the minter of the non-fungible token class.
+func CreateClassMinterKey(classID string, minter sdk.AccAddress) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), minter)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(NFTClassMinterKeyPrefix, compositeKey), nil
+}
+
+// ParseClassMinterKey parses class minter key back to class id and minter.
+func ParseClassMinterKey(key []byte) (string, sdk.AccAddress, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(parsedKeys) != 2 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "class minter key must be composed of 2 length prefixed keys")
+		return "", nil, err
+	}
+	return string(parsedKeys[0]), parsedKeys[1], nil
+}
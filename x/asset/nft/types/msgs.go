@@ -1,6 +1,7 @@
 package types
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -13,6 +14,20 @@ var (
 	_ sdk.Msg = &MsgUnfreeze{}
 	_ sdk.Msg = &MsgAddToWhitelist{}
 	_ sdk.Msg = &MsgRemoveFromWhitelist{}
+	_ sdk.Msg = &MsgTransferWithPrice{}
+	_ sdk.Msg = &MsgMintBatch{}
+	_ sdk.Msg = &MsgBurnBatch{}
+	_ sdk.Msg = &MsgSendBatch{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+	_ sdk.Msg = &MsgUpdateClass{}
+	_ sdk.Msg = &MsgClassFreeze{}
+	_ sdk.Msg = &MsgClassUnfreeze{}
+	_ sdk.Msg = &MsgAddToClassWhitelist{}
+	_ sdk.Msg = &MsgRemoveFromClassWhitelist{}
+	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgSetUser{}
+	_ sdk.Msg = &MsgGrantMinter{}
+	_ sdk.Msg = &MsgRevokeMinter{}
 )
 
 // Constraints.
@@ -22,6 +37,7 @@ const (
 	MaxURILength              = 256
 	MaxURIHashLength          = 128
 	MaxDataSize               = 5 * 1024 // 5KB
+	MaxBatchSize              = 100
 )
 
 // ValidateBasic checks that message fields are valid.
@@ -54,6 +70,14 @@ func (msg *MsgIssueClass) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateMintFee(msg.MintFee); err != nil {
+		return err
+	}
+
+	if err := ValidateDataSchema(msg.DataSchema); err != nil {
+		return err
+	}
+
 	if len(msg.URIHash) > MaxURIHashLength {
 		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", len(msg.URIHash), MaxURIHashLength)
 	}
@@ -74,27 +98,17 @@ func (msg *MsgMint) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
 	}
 
-	if err := ValidateTokenID(msg.ID); err != nil {
-		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
-	}
-
-	if err := ValidateData(msg.Data); err != nil {
-		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
-	}
-
 	if _, err := DeconstructClassID(msg.ClassID); err != nil {
 		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
 	}
 
-	if len(msg.URI) > MaxURILength {
-		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", len(msg.URI), MaxURILength)
-	}
-
-	if len(msg.URIHash) > MaxURIHashLength {
-		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", len(msg.URIHash), MaxURIHashLength)
+	if msg.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient account %s", msg.Recipient)
+		}
 	}
 
-	return nil
+	return validateMintFields(msg.ID, msg.URI, msg.URIHash, msg.Data)
 }
 
 // GetSigners returns the required signers of this message type.
@@ -231,3 +245,397 @@ func (msg *MsgRemoveFromWhitelist) GetSigners() []sdk.AccAddress {
 		sdk.MustAccAddressFromBech32(msg.Sender),
 	}
 }
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgTransferWithPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver account %s", msg.Receiver)
+	}
+
+	if msg.Sender == msg.Receiver {
+		return sdkerrors.Wrap(ErrInvalidInput, "sender and receiver must be different accounts")
+	}
+
+	if err := ValidateTokenID(msg.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := msg.Price.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid price: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgTransferWithPrice) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		sdk.MustAccAddressFromBech32(msg.Receiver),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgMintBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	ids := make([]string, 0, len(msg.Items))
+	for _, item := range msg.Items {
+		if err := validateMintFields(item.ID, item.URI, item.URIHash, item.Data); err != nil {
+			return err
+		}
+		ids = append(ids, item.ID)
+	}
+
+	return validateBatchIDs(ids)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgMintBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgBurnBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return validateBatchIDs(msg.IDs)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgBurnBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgSendBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver account %s", msg.Receiver)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return validateBatchIDs(msg.IDs)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgSendBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgUpdateNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return validateMintFields(msg.ID, msg.URI, msg.URIHash, msg.Data)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgUpdateClass) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := ValidateData(msg.Data); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if len(msg.URI) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", len(msg.URI), MaxURILength)
+	}
+
+	if len(msg.URIHash) > MaxURIHashLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", len(msg.URIHash), MaxURIHashLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgUpdateClass) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgClassFreeze) ValidateBasic() error {
+	return validateClassFreezing(msg.Sender, msg.ClassID, msg.Account)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgClassFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgClassUnfreeze) ValidateBasic() error {
+	return validateClassFreezing(msg.Sender, msg.ClassID, msg.Account)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgClassUnfreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgAddToClassWhitelist) ValidateBasic() error {
+	return validateClassWhitelisting(msg.Sender, msg.ClassID, msg.Account)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgAddToClassWhitelist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgRemoveFromClassWhitelist) ValidateBasic() error {
+	return validateClassWhitelisting(msg.Sender, msg.ClassID, msg.Account)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgRemoveFromClassWhitelist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgRevoke) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if err := ValidateTokenID(msg.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgSetUser) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if err := ValidateTokenID(msg.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if msg.User == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.User); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid user account %s", msg.User)
+	}
+
+	if msg.Expires <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "expiration time must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgSetUser) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgGrantMinter) ValidateBasic() error {
+	if err := validateClassMinter(msg.Sender, msg.ClassID, msg.Minter); err != nil {
+		return err
+	}
+
+	if msg.Quota == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "quota must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgGrantMinter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgRevokeMinter) ValidateBasic() error {
+	return validateClassMinter(msg.Sender, msg.ClassID, msg.Minter)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgRevokeMinter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+func validateClassMinter(sender, classID, minter string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(minter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter account %s", minter)
+	}
+
+	if _, err := DeconstructClassID(classID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return nil
+}
+
+func validateClassWhitelisting(sender, classID, account string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", account)
+	}
+
+	if _, err := DeconstructClassID(classID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return nil
+}
+
+func validateClassFreezing(sender, classID, account string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", sender)
+	}
+
+	if account != "" {
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", account)
+		}
+	}
+
+	if _, err := DeconstructClassID(classID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return nil
+}
+
+func validateMintFields(id, uri, uriHash string, data *codectypes.Any) error {
+	if err := ValidateTokenID(id); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := ValidateNFTData(data); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if len(uri) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", len(uri), MaxURILength)
+	}
+
+	if len(uriHash) > MaxURIHashLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", len(uriHash), MaxURIHashLength)
+	}
+
+	return nil
+}
+
+func validateBatchIDs(ids []string) error {
+	if len(ids) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "at least one nft ID must be provided")
+	}
+
+	if len(ids) > MaxBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidInput, "the number of nfts in the batch must be less than or equal %d", MaxBatchSize)
+	}
+
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if err := ValidateTokenID(id); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+		}
+		if _, ok := seen[id]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated nft ID %q in the batch", id)
+		}
+		seen[id] = struct{}{}
+	}
+
+	return nil
+}
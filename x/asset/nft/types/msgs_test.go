@@ -2,10 +2,12 @@ package types_test
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"testing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -153,6 +155,79 @@ func TestMsgIssueClass_ValidateBasic(t *testing.T) {
 			},
 			expectedError: types.ErrInvalidInput,
 		},
+		{
+			name: "valid msg with max supply and mint fee",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.MaxSupply = 100
+				msg.MintFee = &sdk.Coin{Denom: "ucore", Amount: sdk.NewInt(10)}
+				return &msg
+			},
+		},
+		{
+			name: "invalid mint fee",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.MintFee = &sdk.Coin{Denom: "ucore", Amount: sdk.NewInt(-1)}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "valid msg with data type URL",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.DataSchema = &types.DataSchema{TypeURL: "/" + proto.MessageName((*types.DataBytes)(nil))}
+				return &msg
+			},
+		},
+		{
+			name: "valid msg with data JSON schema",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.DataSchema = &types.DataSchema{JSONSchema: `{"type":"object","properties":{"level":{"type":"integer"}}}`}
+				return &msg
+			},
+		},
+		{
+			name: "invalid data schema - empty",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.DataSchema = &types.DataSchema{}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid data schema - both type URL and JSON schema",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.DataSchema = &types.DataSchema{
+					TypeURL:    "/" + proto.MessageName((*types.DataBytes)(nil)),
+					JSONSchema: `{"type":"object"}`,
+				}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid data schema - malformed JSON schema",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.DataSchema = &types.DataSchema{JSONSchema: `{"type":"unknown"}`}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid data schema - remote reference",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.DataSchema = &types.DataSchema{JSONSchema: `{"properties":{"level":{"$ref":"https://my.invalid/schema.json"}}}`}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 		{
 			name: "invalid data - wrong type",
 			messageFunc: func() *types.MsgIssueClass {
@@ -227,6 +302,23 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 				return &msg
 			},
 		},
+		{
+			name: "valid msg with recipient",
+			messageFunc: func() *types.MsgMint {
+				msg := validMessage
+				msg.Recipient = "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq"
+				return &msg
+			},
+		},
+		{
+			name: "invalid recipient",
+			messageFunc: func() *types.MsgMint {
+				msg := validMessage
+				msg.Recipient = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
 		{
 			name: "invalid id",
 			messageFunc: func() *types.MsgMint {
@@ -285,7 +377,7 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 			expectedError: types.ErrInvalidInput,
 		},
 		{
-			name: "invalid data - wrong type",
+			name: "valid msg with structured data",
 			messageFunc: func() *types.MsgMint {
 				dataValue, err := codectypes.NewAnyWithValue(&types.MsgIssueClass{})
 				requireT.NoError(err)
@@ -293,6 +385,16 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 				msg.Data = dataValue
 				return &msg
 			},
+		},
+		{
+			name: "invalid data - empty type",
+			messageFunc: func() *types.MsgMint {
+				msg := validMessage
+				msg.Data = &codectypes.Any{
+					Value: []byte{0x01},
+				}
+				return &msg
+			},
 			expectedError: types.ErrInvalidInput,
 		},
 	}
@@ -640,3 +742,1055 @@ func TestMsgRemoveFromWhitelist_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgTransferWithPrice_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgTransferWithPrice{
+		Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Receiver: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+		ClassID:  "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:       "my-id",
+		Price:    sdk.NewInt64Coin(constant.DenomDev, 100),
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgTransferWithPrice
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgTransferWithPrice {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "valid msg with zero price",
+			messageFunc: func() *types.MsgTransferWithPrice {
+				msg := validMessage
+				msg.Price = sdk.NewInt64Coin(constant.DenomDev, 0)
+				return &msg
+			},
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgTransferWithPrice {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgTransferWithPrice {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid receiver",
+			messageFunc: func() *types.MsgTransferWithPrice {
+				msg := validMessage
+				msg.Receiver = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "sender is receiver",
+			messageFunc: func() *types.MsgTransferWithPrice {
+				msg := validMessage
+				msg.Receiver = msg.Sender
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgTransferWithPrice {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid price",
+			messageFunc: func() *types.MsgTransferWithPrice {
+				msg := validMessage
+				msg.Price = sdk.Coin{Denom: "1", Amount: sdk.OneInt()}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+//nolint:funlen // many test cases
+func TestMsgMintBatch_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgMintBatch{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Items: []types.MintBatchItem{
+			{ID: "my-id-1", URI: "https://my-nft-meta.invalid/1"},
+			{ID: "my-id-2", URI: "https://my-nft-meta.invalid/2"},
+		},
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgMintBatch
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "empty items",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.Items = nil
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.Items = []types.MintBatchItem{{ID: invalidNFTID}}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.Items = []types.MintBatchItem{{ID: "my-id-1", URI: strings.Repeat("x", 257)}}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated id",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.Items = []types.MintBatchItem{{ID: "my-id-1"}, {ID: "my-id-1"}}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too many items",
+			messageFunc: func() *types.MsgMintBatch {
+				msg := validMessage
+				msg.Items = make([]types.MintBatchItem, 0, types.MaxBatchSize+1)
+				for i := 0; i <= types.MaxBatchSize; i++ {
+					msg.Items = append(msg.Items, types.MintBatchItem{ID: fmt.Sprintf("my-id-%d", i)})
+				}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgBurnBatch_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgBurnBatch{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		IDs:     []string{"my-id-1", "my-id-2"},
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgBurnBatch
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "empty ids",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.IDs = nil
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.IDs = []string{"my-id-1", invalidNFTID}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated id",
+			messageFunc: func() *types.MsgBurnBatch {
+				msg := validMessage
+				msg.IDs = []string{"my-id-1", "my-id-1"}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSendBatch_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgSendBatch{
+		Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Receiver: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+		ClassID:  "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		IDs:      []string{"my-id-1", "my-id-2"},
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgSendBatch
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgSendBatch {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgSendBatch {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid receiver",
+			messageFunc: func() *types.MsgSendBatch {
+				msg := validMessage
+				msg.Receiver = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgSendBatch {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated id",
+			messageFunc: func() *types.MsgSendBatch {
+				msg := validMessage
+				msg.IDs = []string{"my-id-1", "my-id-1"}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateNFT_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgUpdateNFT{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:      "my-id",
+		URI:     "https://my-nft-meta.invalid/1",
+		URIHash: "content-hash",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgUpdateNFT
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgUpdateNFT {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgUpdateNFT {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgUpdateNFT {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgUpdateNFT {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri hash",
+			messageFunc: func() *types.MsgUpdateNFT {
+				msg := validMessage
+				msg.URIHash = strings.Repeat("x", 129)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateClass_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgUpdateClass{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		URI:     "https://my-class-meta.invalid/1",
+		URIHash: "content-hash",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgUpdateClass
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri",
+			messageFunc: func() *types.MsgUpdateClass {
+				msg := validMessage
+				msg.URI = strings.Repeat("x", 257)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+//nolint:dupl // test case duplicates are ok
+func TestMsgClassFreeze_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgClassFreeze{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgClassFreeze
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "valid msg without account",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				msg.Account = ""
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				msg.Account = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+//nolint:dupl // test case duplicates are ok
+func TestMsgClassUnfreeze_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgClassUnfreeze{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgClassUnfreeze
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgClassUnfreeze {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "valid msg without account",
+			messageFunc: func() *types.MsgClassUnfreeze {
+				msg := validMessage
+				msg.Account = ""
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgClassUnfreeze {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			messageFunc: func() *types.MsgClassUnfreeze {
+				msg := validMessage
+				msg.Account = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgClassUnfreeze {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+//nolint:dupl // test case duplicates are ok
+func TestMsgAddToClassWhitelist_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgAddToClassWhitelist{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgAddToClassWhitelist
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgAddToClassWhitelist {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgAddToClassWhitelist {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			messageFunc: func() *types.MsgAddToClassWhitelist {
+				msg := validMessage
+				msg.Account = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty account",
+			messageFunc: func() *types.MsgAddToClassWhitelist {
+				msg := validMessage
+				msg.Account = ""
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgAddToClassWhitelist {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+//nolint:dupl // test case duplicates are ok
+func TestMsgRemoveFromClassWhitelist_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgRemoveFromClassWhitelist{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgRemoveFromClassWhitelist
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgRemoveFromClassWhitelist {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgRemoveFromClassWhitelist {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			messageFunc: func() *types.MsgRemoveFromClassWhitelist {
+				msg := validMessage
+				msg.Account = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty account",
+			messageFunc: func() *types.MsgRemoveFromClassWhitelist {
+				msg := validMessage
+				msg.Account = ""
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgRemoveFromClassWhitelist {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+//nolint:dupl // test case duplicates are ok
+func TestMsgRevoke_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgRevoke{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:      "my-id",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgRevoke
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSetUser_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgSetUser{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:      "my-id",
+		User:    "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+		Expires: 1700000000,
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgSetUser
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgSetUser {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "valid msg removing the user",
+			messageFunc: func() *types.MsgSetUser {
+				msg := validMessage
+				msg.User = ""
+				msg.Expires = 0
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgSetUser {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgSetUser {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgSetUser {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid user",
+			messageFunc: func() *types.MsgSetUser {
+				msg := validMessage
+				msg.User = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid expiration time",
+			messageFunc: func() *types.MsgSetUser {
+				msg := validMessage
+				msg.Expires = 0
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgGrantMinter_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgGrantMinter{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Minter:  "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+		Quota:   10,
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgGrantMinter
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgGrantMinter {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgGrantMinter {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid minter",
+			messageFunc: func() *types.MsgGrantMinter {
+				msg := validMessage
+				msg.Minter = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgGrantMinter {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero quota",
+			messageFunc: func() *types.MsgGrantMinter {
+				msg := validMessage
+				msg.Quota = 0
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgRevokeMinter_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgRevokeMinter{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Minter:  "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgRevokeMinter
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgRevokeMinter {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgRevokeMinter {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid minter",
+			messageFunc: func() *types.MsgRevokeMinter {
+				msg := validMessage
+				msg.Minter = invalidAccount
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgRevokeMinter {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
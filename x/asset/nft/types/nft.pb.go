@@ -5,8 +5,9 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,10 +30,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type ClassFeature int32
 
 const (
-	ClassFeature_burning         ClassFeature = 0
-	ClassFeature_freezing        ClassFeature = 1
-	ClassFeature_whitelisting    ClassFeature = 2
-	ClassFeature_disable_sending ClassFeature = 3
+	ClassFeature_burning            ClassFeature = 0
+	ClassFeature_freezing           ClassFeature = 1
+	ClassFeature_whitelisting       ClassFeature = 2
+	ClassFeature_disable_sending    ClassFeature = 3
+	ClassFeature_updatable_metadata ClassFeature = 4
+	ClassFeature_soulbound          ClassFeature = 5
+	ClassFeature_revocable          ClassFeature = 6
 )
 
 var ClassFeature_name = map[int32]string{
@@ -40,13 +44,19 @@ var ClassFeature_name = map[int32]string{
 	1: "freezing",
 	2: "whitelisting",
 	3: "disable_sending",
+	4: "updatable_metadata",
+	5: "soulbound",
+	6: "revocable",
 }
 
 var ClassFeature_value = map[string]int32{
-	"burning":         0,
-	"freezing":        1,
-	"whitelisting":    2,
-	"disable_sending": 3,
+	"burning":            0,
+	"freezing":           1,
+	"whitelisting":       2,
+	"disable_sending":    3,
+	"updatable_metadata": 4,
+	"soulbound":          5,
+	"revocable":          6,
 }
 
 func (x ClassFeature) String() string {
@@ -66,6 +76,14 @@ type ClassDefinition struct {
 	// whenever an NFT this class is traded on the Dex, the traded amount will be multiplied by this value
 	// that will be transferred to the issuer of the NFT.
 	RoyaltyRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=royalty_rate,json=royaltyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_rate"`
+	// max_supply is the maximum number of NFTs which can be minted in the class including the burnt ones,
+	// 0 means the supply is unlimited.
+	MaxSupply uint64 `protobuf:"varint,5,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// mint_fee is the fee paid to the issuer by the minter for every minted NFT, the issuer mints without the fee.
+	MintFee *types.Coin `protobuf:"bytes,6,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee,omitempty"`
+	// data_schema is the schema the data of the NFTs minted in the class must follow,
+	// if it is not set the data must be of the DataBytes type.
+	DataSchema *DataSchema `protobuf:"bytes,7,opt,name=data_schema,json=dataSchema,proto3" json:"data_schema,omitempty"`
 }
 
 func (m *ClassDefinition) Reset()         { *m = ClassDefinition{} }
@@ -122,6 +140,27 @@ func (m *ClassDefinition) GetFeatures() []ClassFeature {
 	return nil
 }
 
+func (m *ClassDefinition) GetMaxSupply() uint64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
+func (m *ClassDefinition) GetMintFee() *types.Coin {
+	if m != nil {
+		return m.MintFee
+	}
+	return nil
+}
+
+func (m *ClassDefinition) GetDataSchema() *DataSchema {
+	if m != nil {
+		return m.DataSchema
+	}
+	return nil
+}
+
 // Class is a full representation of the non-fungible token class.
 type Class struct {
 	Id          string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -131,12 +170,20 @@ type Class struct {
 	Description string         `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	URI         string         `protobuf:"bytes,6,opt,name=uri,proto3" json:"uri,omitempty"`
 	URIHash     string         `protobuf:"bytes,7,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
-	Data        *types.Any     `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	Data        *types1.Any    `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
 	Features    []ClassFeature `protobuf:"varint,9,rep,packed,name=features,proto3,enum=coreum.asset.nft.v1.ClassFeature" json:"features,omitempty"`
 	// royalty_rate is a number between 0 and 1,which will be used in coreum native Dex.
 	// whenever an NFT this class is traded on the Dex, the traded amount will be multiplied by this value
 	// that will be transferred to the issuer of the NFT.
 	RoyaltyRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=royalty_rate,json=royaltyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_rate"`
+	// max_supply is the maximum number of NFTs which can be minted in the class including the burnt ones,
+	// 0 means the supply is unlimited.
+	MaxSupply uint64 `protobuf:"varint,11,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// mint_fee is the fee paid to the issuer by the minter for every minted NFT, the issuer mints without the fee.
+	MintFee *types.Coin `protobuf:"bytes,12,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee,omitempty"`
+	// data_schema is the schema the data of the NFTs minted in the class must follow,
+	// if it is not set the data must be of the DataBytes type.
+	DataSchema *DataSchema `protobuf:"bytes,13,opt,name=data_schema,json=dataSchema,proto3" json:"data_schema,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -221,7 +268,7 @@ func (m *Class) GetURIHash() string {
 	return ""
 }
 
-func (m *Class) GetData() *types.Any {
+func (m *Class) GetData() *types1.Any {
 	if m != nil {
 		return m.Data
 	}
@@ -235,48 +282,393 @@ func (m *Class) GetFeatures() []ClassFeature {
 	return nil
 }
 
+func (m *Class) GetMaxSupply() uint64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
+func (m *Class) GetMintFee() *types.Coin {
+	if m != nil {
+		return m.MintFee
+	}
+	return nil
+}
+
+func (m *Class) GetDataSchema() *DataSchema {
+	if m != nil {
+		return m.DataSchema
+	}
+	return nil
+}
+
+// DataSchema defines the structure of the NFT data, only one of the fields may be set.
+type DataSchema struct {
+	// type_url is the protobuf type URL of the message the NFT data must contain.
+	TypeURL string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// json_schema is the JSON schema the NFT data must satisfy, the data must be of the DataBytes type holding the JSON document.
+	JSONSchema string `protobuf:"bytes,2,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+}
+
+func (m *DataSchema) Reset()         { *m = DataSchema{} }
+func (m *DataSchema) String() string { return proto.CompactTextString(m) }
+func (*DataSchema) ProtoMessage()    {}
+func (*DataSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b9231d6a69d6d06, []int{2}
+}
+func (m *DataSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSchema.Merge(m, src)
+}
+func (m *DataSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSchema proto.InternalMessageInfo
+
+func (m *DataSchema) GetTypeURL() string {
+	if m != nil {
+		return m.TypeURL
+	}
+	return ""
+}
+
+func (m *DataSchema) GetJSONSchema() string {
+	if m != nil {
+		return m.JSONSchema
+	}
+	return ""
+}
+
+// OwnerNFT is a non-fungible token held by an owner together with its class settings and state.
+type OwnerNFT struct {
+	ClassId  string         `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Id       string         `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	URI      string         `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash  string         `protobuf:"bytes,4,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	Data     *types1.Any    `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Issuer   string         `protobuf:"bytes,6,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Features []ClassFeature `protobuf:"varint,7,rep,packed,name=features,proto3,enum=coreum.asset.nft.v1.ClassFeature" json:"features,omitempty"`
+	// frozen is true if the token is frozen or the class is frozen for the owner.
+	Frozen bool `protobuf:"varint,8,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// whitelisted is true if the owner is whitelisted for the token or the whole class.
+	Whitelisted bool `protobuf:"varint,9,opt,name=whitelisted,proto3" json:"whitelisted,omitempty"`
+}
+
+func (m *OwnerNFT) Reset()         { *m = OwnerNFT{} }
+func (m *OwnerNFT) String() string { return proto.CompactTextString(m) }
+func (*OwnerNFT) ProtoMessage()    {}
+func (*OwnerNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b9231d6a69d6d06, []int{3}
+}
+func (m *OwnerNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerNFT.Merge(m, src)
+}
+func (m *OwnerNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerNFT proto.InternalMessageInfo
+
+func (m *OwnerNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *OwnerNFT) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OwnerNFT) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
+func (m *OwnerNFT) GetURIHash() string {
+	if m != nil {
+		return m.URIHash
+	}
+	return ""
+}
+
+func (m *OwnerNFT) GetData() *types1.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *OwnerNFT) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *OwnerNFT) GetFeatures() []ClassFeature {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *OwnerNFT) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *OwnerNFT) GetWhitelisted() bool {
+	if m != nil {
+		return m.Whitelisted
+	}
+	return false
+}
+
+// NFTUser is the account allowed to use the non-fungible token on behalf of its owner until the expiration time.
+type NFTUser struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	User    string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// expires is the unix time in seconds starting from which the user is not valid anymore.
+	Expires int64 `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (m *NFTUser) Reset()         { *m = NFTUser{} }
+func (m *NFTUser) String() string { return proto.CompactTextString(m) }
+func (*NFTUser) ProtoMessage()    {}
+func (*NFTUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b9231d6a69d6d06, []int{4}
+}
+func (m *NFTUser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTUser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTUser.Merge(m, src)
+}
+func (m *NFTUser) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTUser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTUser proto.InternalMessageInfo
+
+func (m *NFTUser) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NFTUser) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NFTUser) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *NFTUser) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+// ClassMinter is the account allowed to mint the NFTs of the class on behalf of its issuer.
+type ClassMinter struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Minter  string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	// quota is the number of NFTs the minter is still allowed to mint.
+	Quota uint64 `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (m *ClassMinter) Reset()         { *m = ClassMinter{} }
+func (m *ClassMinter) String() string { return proto.CompactTextString(m) }
+func (*ClassMinter) ProtoMessage()    {}
+func (*ClassMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b9231d6a69d6d06, []int{5}
+}
+func (m *ClassMinter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassMinter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassMinter.Merge(m, src)
+}
+func (m *ClassMinter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassMinter proto.InternalMessageInfo
+
+func (m *ClassMinter) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassMinter) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *ClassMinter) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("coreum.asset.nft.v1.ClassFeature", ClassFeature_name, ClassFeature_value)
 	proto.RegisterType((*ClassDefinition)(nil), "coreum.asset.nft.v1.ClassDefinition")
 	proto.RegisterType((*Class)(nil), "coreum.asset.nft.v1.Class")
+	proto.RegisterType((*DataSchema)(nil), "coreum.asset.nft.v1.DataSchema")
+	proto.RegisterType((*OwnerNFT)(nil), "coreum.asset.nft.v1.OwnerNFT")
+	proto.RegisterType((*NFTUser)(nil), "coreum.asset.nft.v1.NFTUser")
+	proto.RegisterType((*ClassMinter)(nil), "coreum.asset.nft.v1.ClassMinter")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/nft.proto", fileDescriptor_5b9231d6a69d6d06) }
 
 var fileDescriptor_5b9231d6a69d6d06 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xed, 0x36, 0x49, 0x37, 0x51, 0x5b, 0x6d, 0xab, 0xca, 0xad, 0x84, 0x13, 0x7a, 0xa8,
-	0x22, 0x24, 0xd6, 0x6a, 0xe1, 0xca, 0x81, 0x36, 0xaa, 0xc8, 0x05, 0xc1, 0x4a, 0xbd, 0x70, 0x89,
-	0xd6, 0xf1, 0xc6, 0x59, 0xe1, 0xec, 0x46, 0xfb, 0x51, 0x30, 0xbf, 0x82, 0x9f, 0xd5, 0x63, 0x25,
-	0x2e, 0x88, 0x83, 0x85, 0x9c, 0xbf, 0xc1, 0x01, 0xed, 0xda, 0x40, 0x90, 0x10, 0x97, 0x9e, 0x76,
-	0xe6, 0xbd, 0xb1, 0x67, 0xde, 0x1b, 0x0d, 0x78, 0x34, 0x13, 0x92, 0x9a, 0x65, 0x4c, 0x94, 0xa2,
-	0x3a, 0xe6, 0x73, 0x1d, 0xdf, 0x9e, 0xdb, 0x07, 0xad, 0xa4, 0xd0, 0x02, 0x1e, 0xd4, 0x34, 0x72,
-	0x34, 0xb2, 0xf8, 0xed, 0xf9, 0xc9, 0x61, 0x26, 0x32, 0xe1, 0xf8, 0xd8, 0x46, 0x75, 0xe9, 0xc9,
-	0x71, 0x26, 0x44, 0x96, 0xd3, 0xd8, 0x65, 0x89, 0x99, 0xc7, 0x84, 0x17, 0x35, 0x75, 0xfa, 0xc5,
-	0x03, 0x7b, 0x57, 0x39, 0x51, 0x6a, 0x4c, 0xe7, 0x8c, 0x33, 0xcd, 0x04, 0x87, 0x47, 0xc0, 0x67,
-	0x69, 0xe8, 0x0d, 0xbd, 0xd1, 0xce, 0x65, 0xbb, 0x2a, 0x07, 0xfe, 0x64, 0x8c, 0x7d, 0x96, 0xc2,
-	0x23, 0xd0, 0x66, 0x4a, 0x19, 0x2a, 0x43, 0xdf, 0x72, 0xb8, 0xc9, 0xe0, 0x0b, 0xd0, 0x9d, 0x53,
-	0xa2, 0x8d, 0xa4, 0x2a, 0x0c, 0x86, 0xc1, 0x68, 0xf7, 0xe2, 0x31, 0xfa, 0xc7, 0x70, 0xc8, 0xf5,
-	0xb9, 0xae, 0x2b, 0xf1, 0xef, 0x4f, 0xe0, 0x5b, 0xd0, 0x97, 0xa2, 0x20, 0xb9, 0x2e, 0xa6, 0x92,
-	0x68, 0x1a, 0x6e, 0xb9, 0xc6, 0xe8, 0xae, 0x1c, 0xb4, 0xbe, 0x95, 0x83, 0xb3, 0x8c, 0xe9, 0x85,
-	0x49, 0xd0, 0x4c, 0x2c, 0xe3, 0x99, 0x50, 0x4b, 0xa1, 0x9a, 0xe7, 0xa9, 0x4a, 0xdf, 0xc7, 0xba,
-	0x58, 0x51, 0x85, 0xc6, 0x74, 0x86, 0x7b, 0xcd, 0x3f, 0x30, 0xd1, 0xf4, 0xf4, 0x87, 0x0f, 0xb6,
-	0x5d, 0x37, 0xb8, 0xfb, 0x47, 0xcb, 0x7f, 0x35, 0x40, 0xb0, 0xc5, 0xc9, 0x92, 0x86, 0x81, 0x43,
-	0x5d, 0x6c, 0x6b, 0x55, 0xb1, 0x4c, 0x44, 0x5e, 0x8f, 0x84, 0x9b, 0x0c, 0x0e, 0x41, 0x2f, 0xa5,
-	0x6a, 0x26, 0xd9, 0xca, 0xda, 0x15, 0x6e, 0x3b, 0x72, 0x13, 0x82, 0xc7, 0x20, 0x30, 0x92, 0x85,
-	0x6d, 0xa7, 0xa4, 0x53, 0x95, 0x83, 0xe0, 0x06, 0x4f, 0xb0, 0xc5, 0xe0, 0x19, 0xe8, 0x1a, 0xc9,
-	0xa6, 0x0b, 0xa2, 0x16, 0x61, 0xc7, 0xf1, 0xbd, 0xaa, 0x1c, 0x74, 0x6e, 0xf0, 0xe4, 0x15, 0x51,
-	0x0b, 0xdc, 0x31, 0x92, 0xd9, 0x00, 0x8e, 0xc0, 0x56, 0x4a, 0x34, 0x09, 0xbb, 0x43, 0x6f, 0xd4,
-	0xbb, 0x38, 0x44, 0xf5, 0x0a, 0xd1, 0xaf, 0x15, 0xa2, 0x97, 0xbc, 0xc0, 0xae, 0xe2, 0x2f, 0xfb,
-	0x77, 0x1e, 0x6e, 0x3f, 0x78, 0xb0, 0xfd, 0x4f, 0xde, 0x80, 0xfe, 0x66, 0x33, 0xd8, 0x03, 0x9d,
-	0xc4, 0x48, 0xce, 0x78, 0xb6, 0xdf, 0x82, 0x7d, 0xd0, 0x9d, 0x4b, 0x4a, 0x3f, 0xd9, 0xcc, 0x83,
-	0xfb, 0xa0, 0xff, 0x61, 0xc1, 0x34, 0xcd, 0x99, 0xd2, 0x16, 0xf1, 0xe1, 0x01, 0xd8, 0x4b, 0x99,
-	0x22, 0x49, 0x4e, 0xa7, 0x8a, 0xf2, 0xd4, 0x82, 0xc1, 0xe5, 0xeb, 0xbb, 0x2a, 0xf2, 0xee, 0xab,
-	0xc8, 0xfb, 0x5e, 0x45, 0xde, 0xe7, 0x75, 0xd4, 0xba, 0x5f, 0x47, 0xad, 0xaf, 0xeb, 0xa8, 0xf5,
-	0xee, 0xf9, 0xc6, 0x80, 0x57, 0x4e, 0xf5, 0xb5, 0x30, 0x3c, 0x25, 0x76, 0x0f, 0x71, 0x73, 0x41,
-	0x1f, 0x37, 0x6e, 0xc8, 0x8d, 0x9c, 0xb4, 0x9d, 0x8f, 0xcf, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0xf1, 0x29, 0xa3, 0x31, 0x64, 0x03, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x13, 0x27, 0x4e, 0x9e, 0xd3, 0x36, 0x9a, 0xae, 0x22, 0x6f, 0xa5, 0x26, 0x61, 0x0f,
+	0x55, 0x84, 0x84, 0xad, 0x2d, 0xbd, 0x22, 0x41, 0x76, 0x15, 0xb1, 0x08, 0xb6, 0x62, 0x76, 0xc3,
+	0x81, 0x4b, 0x34, 0xb6, 0x27, 0xc9, 0x80, 0xed, 0x09, 0x33, 0xe3, 0xed, 0xa6, 0x3f, 0x80, 0x33,
+	0x12, 0x3f, 0x85, 0x3f, 0xd1, 0x63, 0x8f, 0x88, 0x43, 0x84, 0xb2, 0x37, 0x7e, 0x05, 0x9a, 0xb1,
+	0x93, 0x0d, 0x52, 0xd9, 0xb2, 0xc0, 0xc9, 0xef, 0x7b, 0xef, 0xd9, 0x6f, 0xde, 0xf7, 0xbe, 0x37,
+	0x86, 0xa7, 0x11, 0x17, 0x34, 0x4f, 0x03, 0x22, 0x25, 0x55, 0x41, 0x36, 0x53, 0xc1, 0xd5, 0xb1,
+	0x7e, 0xf8, 0x4b, 0xc1, 0x15, 0x47, 0x8f, 0x8b, 0xb0, 0x6f, 0xc2, 0xbe, 0xf6, 0x5f, 0x1d, 0x3f,
+	0x39, 0x98, 0xf3, 0x39, 0x37, 0xf1, 0x40, 0x5b, 0x45, 0xea, 0x93, 0xc3, 0x39, 0xe7, 0xf3, 0x84,
+	0x06, 0x06, 0x85, 0xf9, 0x2c, 0x20, 0xd9, 0xaa, 0x0c, 0xf5, 0x22, 0x2e, 0x53, 0x2e, 0x83, 0x90,
+	0x48, 0x1a, 0x5c, 0x1d, 0x87, 0x54, 0x91, 0xe3, 0x20, 0xe2, 0x2c, 0x2b, 0xe2, 0x47, 0x7f, 0x54,
+	0xe1, 0xd1, 0x49, 0x42, 0xa4, 0x3c, 0xa5, 0x33, 0x96, 0x31, 0xc5, 0x78, 0x86, 0xba, 0x50, 0x65,
+	0xb1, 0x67, 0x0d, 0xac, 0x61, 0x6b, 0xd4, 0xd8, 0xac, 0xfb, 0xd5, 0xb3, 0x53, 0x5c, 0x65, 0x31,
+	0xea, 0x42, 0x83, 0x49, 0x99, 0x53, 0xe1, 0x55, 0x75, 0x0c, 0x97, 0x08, 0x7d, 0x02, 0xcd, 0x19,
+	0x25, 0x2a, 0x17, 0x54, 0x7a, 0xb5, 0x41, 0x6d, 0xf8, 0xf0, 0xf9, 0x07, 0xfe, 0x3b, 0x0e, 0xef,
+	0x9b, 0x3a, 0xe3, 0x22, 0x13, 0xef, 0x5e, 0x41, 0x5f, 0x43, 0x5b, 0xf0, 0x15, 0x49, 0xd4, 0x6a,
+	0x2a, 0x88, 0xa2, 0x9e, 0x6d, 0x0a, 0xfb, 0x6f, 0xd6, 0xfd, 0xca, 0x6f, 0xeb, 0xfe, 0xb3, 0x39,
+	0x53, 0x8b, 0x3c, 0xf4, 0x23, 0x9e, 0x06, 0x65, 0x2f, 0xc5, 0xe3, 0x23, 0x19, 0x7f, 0x1f, 0xa8,
+	0xd5, 0x92, 0x4a, 0xff, 0x94, 0x46, 0xd8, 0x2d, 0xbf, 0x81, 0x89, 0xa2, 0xe8, 0x29, 0x40, 0x4a,
+	0xae, 0xa7, 0x32, 0x5f, 0x2e, 0x93, 0x95, 0x57, 0x1f, 0x58, 0x43, 0x1b, 0xb7, 0x52, 0x72, 0x7d,
+	0x61, 0x1c, 0xe8, 0x05, 0x34, 0x53, 0x96, 0xa9, 0xe9, 0x8c, 0x52, 0xaf, 0x31, 0xb0, 0x86, 0xee,
+	0xf3, 0x43, 0xbf, 0xf8, 0xa8, 0xaf, 0x79, 0xf2, 0x4b, 0x9e, 0xfc, 0x13, 0xce, 0x32, 0xec, 0xe8,
+	0xd4, 0x31, 0xa5, 0xe8, 0x53, 0x70, 0x63, 0xa2, 0xc8, 0x54, 0x46, 0x0b, 0x9a, 0x12, 0xcf, 0x31,
+	0x2f, 0xf6, 0xdf, 0xd9, 0xe9, 0x29, 0x51, 0xe4, 0xc2, 0xa4, 0x61, 0x88, 0x77, 0xf6, 0xd1, 0xcf,
+	0x36, 0xd4, 0x0d, 0x09, 0xe8, 0xe1, 0x2d, 0xc5, 0x77, 0x52, 0x8b, 0xc0, 0xce, 0x48, 0x4a, 0xbd,
+	0x9a, 0xf1, 0x1a, 0x5b, 0xe7, 0xca, 0x55, 0x1a, 0xf2, 0xa4, 0x60, 0x0a, 0x97, 0x08, 0x0d, 0xc0,
+	0x8d, 0xa9, 0x8c, 0x04, 0x5b, 0xea, 0x29, 0x9a, 0xae, 0x5b, 0x78, 0xdf, 0x85, 0x0e, 0xa1, 0x96,
+	0x0b, 0x66, 0x5a, 0x6e, 0x8d, 0x9c, 0xcd, 0xba, 0x5f, 0x9b, 0xe0, 0x33, 0xac, 0x7d, 0xe8, 0x19,
+	0x34, 0x73, 0xc1, 0xa6, 0x0b, 0x22, 0x17, 0xa6, 0xb3, 0xd6, 0xc8, 0xdd, 0xac, 0xfb, 0xce, 0x04,
+	0x9f, 0x7d, 0x4e, 0xe4, 0x02, 0x3b, 0xb9, 0x60, 0xda, 0x40, 0x43, 0xb0, 0x75, 0x43, 0x5e, 0xd3,
+	0x74, 0x7f, 0xe0, 0x17, 0xca, 0xf3, 0xb7, 0xca, 0xf3, 0x3f, 0xcb, 0x56, 0xd8, 0x64, 0xfc, 0x45,
+	0x15, 0xad, 0xff, 0xae, 0x0a, 0xf8, 0xbf, 0x55, 0xe1, 0xde, 0xa5, 0x8a, 0xf6, 0xbf, 0x55, 0xc5,
+	0x83, 0xfb, 0xab, 0x82, 0x02, 0xdc, 0x46, 0xf4, 0x20, 0xf4, 0xf1, 0xa7, 0xb9, 0x48, 0x3c, 0xeb,
+	0x76, 0x10, 0x97, 0xab, 0x25, 0x9d, 0xe0, 0x2f, 0xb1, 0xa3, 0x83, 0x13, 0x91, 0xa0, 0x00, 0xdc,
+	0xef, 0x24, 0xcf, 0xb6, 0x75, 0x8d, 0x6c, 0x46, 0x0f, 0x37, 0xeb, 0x3e, 0x7c, 0x71, 0xf1, 0xf2,
+	0x7c, 0x5b, 0x46, 0xa7, 0x94, 0x65, 0x7e, 0xa9, 0x42, 0xf3, 0xe5, 0xab, 0x8c, 0x8a, 0xf3, 0xf1,
+	0x25, 0x3a, 0x84, 0x66, 0xa4, 0x79, 0x9f, 0xee, 0x54, 0xe8, 0x18, 0x7c, 0x16, 0x97, 0xd2, 0xac,
+	0xee, 0xa4, 0x59, 0x8a, 0xa6, 0xf6, 0x1e, 0xd1, 0xd8, 0xff, 0x40, 0x34, 0xf5, 0xf7, 0x8a, 0xe6,
+	0x76, 0x0f, 0x1a, 0x7f, 0x7b, 0xc5, 0x38, 0xf7, 0x17, 0x53, 0x17, 0x1a, 0x33, 0xc1, 0x5f, 0xd3,
+	0xcc, 0xe8, 0xb6, 0x89, 0x4b, 0xa4, 0x57, 0xe6, 0xd5, 0x82, 0x29, 0x9a, 0x30, 0xa9, 0x68, 0xec,
+	0xb5, 0x4c, 0x70, 0xdf, 0x75, 0x14, 0x82, 0x73, 0x3e, 0xbe, 0x9c, 0x48, 0x2a, 0xee, 0xc3, 0x19,
+	0x02, 0x3b, 0x97, 0x54, 0x6c, 0xd7, 0x56, 0xdb, 0xc8, 0x03, 0x87, 0x5e, 0x2f, 0x99, 0xee, 0x40,
+	0x73, 0x55, 0xc3, 0x5b, 0x78, 0xf4, 0x0d, 0xb8, 0xe6, 0xdc, 0x5f, 0xb1, 0x4c, 0xdd, 0x5d, 0xa7,
+	0x0b, 0x8d, 0xd4, 0x24, 0x6d, 0xaf, 0x89, 0x02, 0xa1, 0x03, 0xa8, 0xff, 0x90, 0x73, 0x45, 0x4c,
+	0x41, 0x1b, 0x17, 0xe0, 0xc3, 0x1f, 0x2d, 0x68, 0xef, 0x13, 0x82, 0x5c, 0x70, 0xc2, 0x5c, 0x64,
+	0x2c, 0x9b, 0x77, 0x2a, 0xa8, 0x0d, 0xcd, 0x99, 0xa0, 0xf4, 0xb5, 0x46, 0x16, 0xea, 0x40, 0x7b,
+	0xd7, 0xb6, 0xf6, 0x54, 0xd1, 0x63, 0x78, 0x14, 0x33, 0x49, 0xc2, 0x84, 0x4e, 0x25, 0xcd, 0x62,
+	0xed, 0xac, 0xa1, 0x2e, 0xa0, 0x7c, 0xa9, 0x27, 0xa5, 0xdd, 0x29, 0x55, 0x44, 0xdb, 0x1d, 0x1b,
+	0x3d, 0x80, 0x96, 0xe4, 0x79, 0x12, 0xf2, 0x3c, 0x8b, 0x3b, 0x75, 0x0d, 0x05, 0xbd, 0xe2, 0x91,
+	0x4e, 0xeb, 0x34, 0x46, 0xe7, 0x6f, 0x36, 0x3d, 0xeb, 0xed, 0xa6, 0x67, 0xfd, 0xbe, 0xe9, 0x59,
+	0x3f, 0xdd, 0xf4, 0x2a, 0x6f, 0x6f, 0x7a, 0x95, 0x5f, 0x6f, 0x7a, 0x95, 0x6f, 0x5f, 0xec, 0xed,
+	0xf1, 0x89, 0x99, 0xe7, 0x58, 0x7f, 0x82, 0xe8, 0xeb, 0x2a, 0x28, 0xff, 0x8f, 0xd7, 0x7b, 0x7f,
+	0x48, 0xb3, 0xd9, 0x61, 0xc3, 0x28, 0xe7, 0xe3, 0x3f, 0x07, 0x00, 0x48, 0xa4, 0x06, 0xa1, 0x42,
+	0x07, 0x00, 0x00,
 }
 
 func (m *ClassDefinition) Marshal() (dAtA []byte, err error) {
@@ -299,6 +691,35 @@ func (m *ClassDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DataSchema != nil {
+		{
+			size, err := m.DataSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MintFee != nil {
+		{
+			size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.RoyaltyRate.Size()
 		i -= size
@@ -310,20 +731,20 @@ func (m *ClassDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	if len(m.Features) > 0 {
-		dAtA2 := make([]byte, len(m.Features)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.Features)*10)
+		var j3 int
 		for _, num := range m.Features {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintNft(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintNft(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -364,6 +785,35 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DataSchema != nil {
+		{
+			size, err := m.DataSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MintFee != nil {
+		{
+			size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size := m.RoyaltyRate.Size()
 		i -= size
@@ -375,20 +825,20 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x52
 	if len(m.Features) > 0 {
-		dAtA4 := make([]byte, len(m.Features)*10)
-		var j3 int
+		dAtA8 := make([]byte, len(m.Features)*10)
+		var j7 int
 		for _, num := range m.Features {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j7++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintNft(dAtA, i, uint64(j3))
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintNft(dAtA, i, uint64(j7))
 		i--
 		dAtA[i] = 0x4a
 	}
@@ -456,6 +906,242 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DataSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JSONSchema) > 0 {
+		i -= len(m.JSONSchema)
+		copy(dAtA[i:], m.JSONSchema)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.JSONSchema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeURL) > 0 {
+		i -= len(m.TypeURL)
+		copy(dAtA[i:], m.TypeURL)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.TypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Whitelisted {
+		i--
+		if m.Whitelisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Features) > 0 {
+		dAtA11 := make([]byte, len(m.Features)*10)
+		var j10 int
+		for _, num := range m.Features {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintNft(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTUser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTUser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expires != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.Expires))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quota != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.Quota))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -490,6 +1176,17 @@ func (m *ClassDefinition) Size() (n int) {
 	}
 	l = m.RoyaltyRate.Size()
 	n += 1 + l + sovNft(uint64(l))
+	if m.MaxSupply != 0 {
+		n += 1 + sovNft(uint64(m.MaxSupply))
+	}
+	if m.MintFee != nil {
+		l = m.MintFee.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.DataSchema != nil {
+		l = m.DataSchema.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
 	return n
 }
 
@@ -540,28 +1237,146 @@ func (m *Class) Size() (n int) {
 	}
 	l = m.RoyaltyRate.Size()
 	n += 1 + l + sovNft(uint64(l))
+	if m.MaxSupply != 0 {
+		n += 1 + sovNft(uint64(m.MaxSupply))
+	}
+	if m.MintFee != nil {
+		l = m.MintFee.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.DataSchema != nil {
+		l = m.DataSchema.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
 	return n
 }
 
-func sovNft(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DataSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeURL)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.JSONSchema)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
 }
-func sozNft(x uint64) (n int) {
-	return sovNft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *OwnerNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovNft(uint64(e))
+		}
+		n += 1 + sovNft(uint64(l)) + l
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.Whitelisted {
+		n += 2
+	}
+	return n
 }
-func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNft
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+
+func (m *NFTUser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovNft(uint64(m.Expires))
+	}
+	return n
+}
+
+func (m *ClassMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Quota != 0 {
+		n += 1 + sovNft(uint64(m.Quota))
+	}
+	return n
+}
+
+func sovNft(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNft(x uint64) (n int) {
+	return sovNft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
@@ -711,11 +1526,1069 @@ func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintFee == nil {
+				m.MintFee = &types.Coin{}
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataSchema == nil {
+				m.DataSchema = &DataSchema{}
+			}
+			if err := m.DataSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Class) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types1.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v ClassFeature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClassFeature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNft
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNft
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]ClassFeature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClassFeature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNft
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClassFeature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintFee == nil {
+				m.MintFee = &types.Coin{}
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataSchema == nil {
+				m.DataSchema = &DataSchema{}
+			}
+			if err := m.DataSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types1.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v ClassFeature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClassFeature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNft
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNft
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]ClassFeature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClassFeature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNft
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClassFeature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -725,26 +2598,32 @@ func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Frozen = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
 			}
-			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Whitelisted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -766,7 +2645,7 @@ func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Class) Unmarshal(dAtA []byte) error {
+func (m *NFTUser) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -789,15 +2668,15 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+			return fmt.Errorf("proto: NFTUser: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NFTUser: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -825,11 +2704,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -857,11 +2736,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -889,13 +2768,13 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
 			}
-			var stringLen uint64
+			m.Expires = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -905,59 +2784,64 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Expires |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNft
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNft
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -985,11 +2869,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1017,118 +2901,13 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNft
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType == 0 {
-				var v ClassFeature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowNft
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= ClassFeature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowNft
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthNft
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthNft
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]ClassFeature, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v ClassFeature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowNft
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ClassFeature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
-			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
-			var stringLen uint64
+			m.Quota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1138,26 +2917,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Quota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -38,10 +38,25 @@ type IssueClassSettings struct {
 	Data        *codectypes.Any
 	Features    []ClassFeature
 	RoyaltyRate sdk.Dec
+	MaxSupply   uint64
+	MintFee     *sdk.Coin
+	DataSchema  *DataSchema
 }
 
 // MintSettings is the model which represents the params for the non-fungible token minting.
 type MintSettings struct {
+	Sender sdk.AccAddress
+	// Recipient receives the minted token, the Sender is used if it is empty.
+	Recipient sdk.AccAddress
+	ClassID   string
+	ID        string
+	URI       string
+	URIHash   string
+	Data      *codectypes.Any
+}
+
+// UpdateNFTSettings is the model which represents the params for the non-fungible token metadata update.
+type UpdateNFTSettings struct {
 	Sender  sdk.AccAddress
 	ClassID string
 	ID      string
@@ -50,6 +65,15 @@ type MintSettings struct {
 	Data    *codectypes.Any
 }
 
+// UpdateClassSettings is the model which represents the params for the non-fungible token class metadata update.
+type UpdateClassSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	URI     string
+	URIHash string
+	Data    *codectypes.Any
+}
+
 // BuildClassID builds the non-fungible token id string from the symbol and issuer address.
 func BuildClassID(symbol string, issuer sdk.AccAddress) string {
 	return strings.ToLower(symbol) + nftClassIDSeparator + issuer.String()
@@ -102,7 +126,7 @@ func ValidateTokenID(id string) error {
 	return nil
 }
 
-// ValidateData checks the provided data field is valid for NFT class or token.
+// ValidateData checks the provided data field is valid for NFT class or token with unstructured data.
 func ValidateData(data *codectypes.Any) error {
 	if data != nil {
 		if len(data.Value) > MaxDataSize {
@@ -116,6 +140,46 @@ func ValidateData(data *codectypes.Any) error {
 	return nil
 }
 
+// ValidateNFTData checks the provided data field is valid for NFT, the structure of the data is checked against
+// the data schema of the class when the token is minted or updated.
+func ValidateNFTData(data *codectypes.Any) error {
+	if data != nil {
+		if len(data.Value) > MaxDataSize {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid data, it's allowed to use %d bytes", MaxDataSize)
+		}
+		if data.TypeUrl == "" {
+			return sdkerrors.Wrap(ErrInvalidInput, "data type URL must be set")
+		}
+	}
+
+	return nil
+}
+
+// ValidateDataSchema checks the provided class data schema is valid, the nil schema means the data is not structured.
+func ValidateDataSchema(schema *DataSchema) error {
+	if schema == nil {
+		return nil
+	}
+
+	if (schema.TypeURL == "") == (schema.JSONSchema == "") {
+		return sdkerrors.Wrap(ErrInvalidInput, "exactly one of type URL and JSON schema must be set in the data schema")
+	}
+
+	if len(schema.TypeURL) > MaxDataSize || len(schema.JSONSchema) > MaxDataSize {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid data schema, it's allowed to use %d bytes", MaxDataSize)
+	}
+
+	if schema.TypeURL != "" {
+		if !strings.HasPrefix(schema.TypeURL, "/") {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid data type URL %q, it must start with /", schema.TypeURL)
+		}
+		return nil
+	}
+
+	_, err := schema.CompileJSONSchema()
+	return err
+}
+
 // ValidateRoyaltyRate checks the provided non-fungible token royalty rate is valid.
 func ValidateRoyaltyRate(rate sdk.Dec) error {
 	if rate.IsNil() {
@@ -129,6 +193,28 @@ func ValidateRoyaltyRate(rate sdk.Dec) error {
 	return nil
 }
 
+// ValidateMintFee checks the provided class mint fee is valid, the nil fee means there is no fee.
+func ValidateMintFee(mintFee *sdk.Coin) error {
+	if mintFee == nil {
+		return nil
+	}
+
+	if err := mintFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid mint fee: %s", err)
+	}
+
+	return nil
+}
+
+// CalculateRoyalty returns the part of the price which must be paid to the issuer as royalty.
+func (nftd ClassDefinition) CalculateRoyalty(price sdk.Coin) sdk.Coin {
+	if nftd.RoyaltyRate.IsNil() || !nftd.RoyaltyRate.IsPositive() {
+		return sdk.NewCoin(price.Denom, sdk.ZeroInt())
+	}
+
+	return sdk.NewCoin(price.Denom, nftd.RoyaltyRate.MulInt(price.Amount).TruncateInt())
+}
+
 // CheckFeatureAllowed returns error if feature isn't allowed for the address.
 func (nftd ClassDefinition) CheckFeatureAllowed(addr sdk.AccAddress, feature ClassFeature) error {
 	// Issuer is allowed to burn even if burning is disabled
@@ -12,6 +12,8 @@ import (
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	ibcante "github.com/cosmos/ibc-go/v4/modules/core/ante"
+	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
 
 	"github.com/CoreumFoundation/coreum/x/auth/keeper"
 	"github.com/CoreumFoundation/coreum/x/deterministicgas"
@@ -21,14 +23,15 @@ import (
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
-	DeterministicGasConfig deterministicgas.Config
-	AccountKeeper          authante.AccountKeeper
-	BankKeeper             authtypes.BankKeeper
-	FeegrantKeeper         authante.FeegrantKeeper
-	FeeModelKeeper         feemodelante.Keeper
-	SignModeHandler        authsigning.SignModeHandler
-	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
-	WasmTXCounterStoreKey  sdk.StoreKey
+	DeterministicGasConfigProvider deterministicgas.ConfigProvider
+	AccountKeeper                  authante.AccountKeeper
+	BankKeeper                     authtypes.BankKeeper
+	FeegrantKeeper                 authante.FeegrantKeeper
+	FeeModelKeeper                 feemodelante.Keeper
+	SignModeHandler                authsigning.SignModeHandler
+	SigGasConsumer                 func(meter sdk.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
+	WasmTXCounterStoreKey          sdk.StoreKey
+	IBCKeeper                      *ibckeeper.Keeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -59,6 +62,10 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "tx counter key is required for ante builder")
 	}
 
+	if options.IBCKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "ibc keeper is required for ante builder")
+	}
+
 	infiniteAccountKeeper := keeper.NewInfiniteAccountKeeper(options.AccountKeeper)
 
 	anteDecorators := []sdk.AnteDecorator{
@@ -93,7 +100,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		//   IMPORTANT: If they consumed less, the rest **IS NOT** given to the message handlers for free.
 
 		authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		deterministicgasante.NewSetInfiniteGasMeterDecorator(options.DeterministicGasConfig),
+		deterministicgasante.NewSetInfiniteGasMeterDecorator(options.DeterministicGasConfigProvider),
 		authante.NewRejectExtensionOptionsDecorator(),
 		NewDenyMessagesDecorator(&crisistypes.MsgVerifyInvariant{}),
 		authante.NewValidateBasicDecorator(),
@@ -106,10 +113,11 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		authante.NewValidateSigCountDecorator(options.AccountKeeper),
 		authante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		authante.NewIncrementSequenceDecorator(options.AccountKeeper),
-		deterministicgasante.NewAddBaseGasDecorator(infiniteAccountKeeper, options.DeterministicGasConfig),
+		deterministicgasante.NewAddBaseGasDecorator(infiniteAccountKeeper, options.DeterministicGasConfigProvider),
 		authante.NewConsumeGasForTxSizeDecorator(infiniteAccountKeeper),
 		authante.NewSigGasConsumeDecorator(infiniteAccountKeeper, options.SigGasConsumer),
-		deterministicgasante.NewChargeFixedGasDecorator(infiniteAccountKeeper, options.DeterministicGasConfig),
+		deterministicgasante.NewChargeFixedGasDecorator(infiniteAccountKeeper, options.DeterministicGasConfigProvider),
+		ibcante.NewAnteDecorator(options.IBCKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -11,21 +11,24 @@ import (
 // CONTRACT: Must be the first decorator in the chain.
 // CONTRACT: Tx must implement GasTx interface.
 type SetInfiniteGasMeterDecorator struct {
-	deterministicGasConfig deterministicgas.Config
+	deterministicGasConfigProvider deterministicgas.ConfigProvider
 }
 
 // NewSetInfiniteGasMeterDecorator creates new SetInfiniteGasMeterDecorator.
-func NewSetInfiniteGasMeterDecorator(deterministicGasConfig deterministicgas.Config) SetInfiniteGasMeterDecorator {
+func NewSetInfiniteGasMeterDecorator(deterministicGasConfigProvider deterministicgas.ConfigProvider) SetInfiniteGasMeterDecorator {
 	return SetInfiniteGasMeterDecorator{
-		deterministicGasConfig: deterministicGasConfig,
+		deterministicGasConfigProvider: deterministicGasConfigProvider,
 	}
 }
 
 // AnteHandle resets the gas limit inside GasMeter.
 func (sigmd SetInfiniteGasMeterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// The config is built once and cached in the context used by the next decorators and the message handlers.
+	ctx, cfg := sigmd.deterministicGasConfigProvider.ContextWithConfig(ctx)
+
 	// This is done to return an error early if user provided gas amount which can't even cover the constant fee charged on the real
 	// gas meter in `ChargeFixedGasDecorator`. This will save resources on running preliminary ante decorators.
-	ctx.GasMeter().ConsumeGas(sigmd.deterministicGasConfig.FixedGas, "Fixed")
+	ctx.GasMeter().ConsumeGas(cfg.FixedGas, "Fixed")
 
 	// Set infinite gas meter for ante handler
 	return next(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), tx, simulate)
@@ -34,15 +37,15 @@ func (sigmd SetInfiniteGasMeterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 // AddBaseGasDecorator adds free gas to gas meter.
 // CONTRACT: Tx must implement GasTx interface.
 type AddBaseGasDecorator struct {
-	ak                     authante.AccountKeeper
-	deterministicGasConfig deterministicgas.Config
+	ak                             authante.AccountKeeper
+	deterministicGasConfigProvider deterministicgas.ConfigProvider
 }
 
 // NewAddBaseGasDecorator creates new AddBaseGasDecorator.
-func NewAddBaseGasDecorator(ak authante.AccountKeeper, deterministicGasConfig deterministicgas.Config) AddBaseGasDecorator {
+func NewAddBaseGasDecorator(ak authante.AccountKeeper, deterministicGasConfigProvider deterministicgas.ConfigProvider) AddBaseGasDecorator {
 	return AddBaseGasDecorator{
-		ak:                     ak,
-		deterministicGasConfig: deterministicGasConfig,
+		ak:                             ak,
+		deterministicGasConfigProvider: deterministicGasConfigProvider,
 	}
 }
 
@@ -59,7 +62,7 @@ func (abgd AddBaseGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		// It is not needed to verify that tx really implements `GasTx` interface because it has been already done by
 		// `SetUpContextDecorator`
 		gasTx := tx.(authante.GasTx)
-		gasMeter = sdk.NewGasMeter(gasTx.GetGas() + abgd.deterministicGasConfig.TxBaseGas(params))
+		gasMeter = sdk.NewGasMeter(gasTx.GetGas() + abgd.deterministicGasConfigProvider.GetConfig(ctx).TxBaseGas(params))
 	}
 	return next(ctx.WithGasMeter(gasMeter), tx, simulate)
 }
@@ -67,15 +70,15 @@ func (abgd AddBaseGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 // ChargeFixedGasDecorator sets gas meter for message handlers.
 // CONTRACT: Tx must implement GasTx interface.
 type ChargeFixedGasDecorator struct {
-	ak                     authante.AccountKeeper
-	deterministicGasConfig deterministicgas.Config
+	ak                             authante.AccountKeeper
+	deterministicGasConfigProvider deterministicgas.ConfigProvider
 }
 
 // NewChargeFixedGasDecorator creates new ChargeFixedGasDecorator.
-func NewChargeFixedGasDecorator(ak authante.AccountKeeper, deterministicGasConfig deterministicgas.Config) ChargeFixedGasDecorator {
+func NewChargeFixedGasDecorator(ak authante.AccountKeeper, deterministicGasConfigProvider deterministicgas.ConfigProvider) ChargeFixedGasDecorator {
 	return ChargeFixedGasDecorator{
-		ak:                     ak,
-		deterministicGasConfig: deterministicGasConfig,
+		ak:                             ak,
+		deterministicGasConfigProvider: deterministicGasConfigProvider,
 	}
 }
 
@@ -86,6 +89,7 @@ func (cfgd ChargeFixedGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	gasTx := tx.(authante.GasTx)
 
 	params := cfgd.ak.GetParams(ctx)
+	deterministicGasConfig := cfgd.deterministicGasConfigProvider.GetConfig(ctx)
 
 	var gasMeter sdk.GasMeter
 	if simulate || ctx.BlockHeight() == 0 {
@@ -97,11 +101,11 @@ func (cfgd ChargeFixedGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	}
 
 	gasConsumed := ctx.GasMeter().GasConsumed()
-	bonus := cfgd.deterministicGasConfig.TxBaseGas(params)
+	bonus := deterministicGasConfig.TxBaseGas(params)
 	if gasConsumed > bonus {
 		gasMeter.ConsumeGas(gasConsumed-bonus, "OverBonus")
 	}
-	gasMeter.ConsumeGas(cfgd.deterministicGasConfig.FixedGas, "Fixed")
+	gasMeter.ConsumeGas(deterministicGasConfig.FixedGas, "Fixed")
 
 	return next(ctx.WithGasMeter(gasMeter), tx, simulate)
 }
@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// GetQueryCmd returns the cli query commands for the module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryTxGas())
+	cmd.AddCommand(CmdQueryContractGas())
+	return cmd
+}
+
+// CmdQueryParams implements a command to fetch deterministic gas parameters.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: fmt.Sprintf("Query the current %s parameters", types.ModuleName),
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query parameters containing the full deterministic gas table.
+
+Example:
+$ %[1]s query %[2]s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryTxGas implements a command to fetch the deterministic gas of the transaction.
+func CmdQueryTxGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas [tx-file]",
+		Short: "Query the deterministic gas required by the transaction",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deterministic gas required by the messages of the JSON encoded transaction.
+
+Example:
+$ %[1]s tx bank send [from] [to] [amount] --generate-only > tx.json
+$ %[1]s query %[2]s gas tx.json
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := QueryDeterministicGas(cmd, tx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryContractGas implements a command to fetch the deterministic gas declared for the contract executions.
+func CmdQueryContractGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-gas [contract_address]",
+		Short: "Query the deterministic gas declared for the executions of the smart contract",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deterministic gas declared by the admin for the executions of the smart contract.
+
+Example:
+$ %[1]s query %[2]s contract-gas [contract_address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractGas(cmd.Context(), &types.QueryContractGasRequest{
+				Pagination: pageReq,
+				Contract:   args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-gas")
+
+	return cmd
+}
+
+// QueryDeterministicGas queries the deterministic gas required by the messages.
+func QueryDeterministicGas(cmd *cobra.Command, msgs []sdk.Msg) (*types.QueryDeterministicGasResponse, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	msgAnys := make([]*codectypes.Any, 0, len(msgs))
+	for _, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to pack message %s", sdk.MsgTypeURL(msg))
+		}
+		msgAnys = append(msgAnys, msgAny)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	return queryClient.DeterministicGas(cmd.Context(), &types.QueryDeterministicGasRequest{
+		Msgs: msgAnys,
+	})
+}
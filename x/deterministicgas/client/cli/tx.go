@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// MsgNameFlag is the flag specifying the name of the top-level contract execute message.
+const MsgNameFlag = "msg-name"
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdTxSetContractGas(),
+	)
+
+	return cmd
+}
+
+// CmdTxSetContractGas returns SetContractGas cobra command.
+func CmdTxSetContractGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-gas [contract_address] [gas] --msg-name [msg_name] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set the deterministic gas of the smart contract execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the deterministic gas of the smart contract execution. The gas is charged for every execution
+and is the hard cap of the gas the execution may consume. If the msg name is set, the gas applies only to the execute
+messages having that top-level name. Zero gas removes the declared gas.
+
+Example:
+$ %s tx %s set-contract-gas [contract_address] 150000 --msg-name transfer --from [admin]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			gas, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid gas")
+			}
+
+			msgName, err := cmd.Flags().GetString(MsgNameFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			msg := &types.MsgSetContractGas{
+				Sender:   sender.String(),
+				Contract: args[0],
+				MsgName:  msgName,
+				Gas:      gas,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(MsgNameFlag, "", "Name of the top-level execute message the gas applies to, all the messages if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
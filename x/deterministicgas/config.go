@@ -5,21 +5,19 @@ import (
 	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
-	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
-	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcconnectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/samber/lo"
 
-	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
-	nfttypes "github.com/CoreumFoundation/coreum/x/nft"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 )
 
 type gasByMsgFunc = func(msg sdk.Msg) (uint64, bool)
@@ -37,78 +35,17 @@ type Config struct {
 }
 
 // DefaultConfig returns default config for deterministic gas.
-//
-//nolint:funlen
 func DefaultConfig() Config {
-	cfg := Config{
-		FixedGas:       50000,
-		freeBytes:      2048,
-		freeSignatures: 1,
-	}
+	return NewConfig(types.DefaultParams())
+}
 
-	cfg.gasByMsg = map[string]gasByMsgFunc{
-		// asset/ft
-		MsgType(&assetfttypes.MsgIssue{}):               constantGasFunc(70000),
-		MsgType(&assetfttypes.MsgMint{}):                constantGasFunc(11000),
-		MsgType(&assetfttypes.MsgBurn{}):                constantGasFunc(23000),
-		MsgType(&assetfttypes.MsgFreeze{}):              constantGasFunc(5000),
-		MsgType(&assetfttypes.MsgUnfreeze{}):            constantGasFunc(2500),
-		MsgType(&assetfttypes.MsgGloballyFreeze{}):      constantGasFunc(5000),
-		MsgType(&assetfttypes.MsgGloballyUnfreeze{}):    constantGasFunc(2500),
-		MsgType(&assetfttypes.MsgSetWhitelistedLimit{}): constantGasFunc(5000),
-
-		// asset/nft
-		MsgType(&assetnfttypes.MsgBurn{}):                constantGasFunc(16000),
-		MsgType(&assetnfttypes.MsgIssueClass{}):          constantGasFunc(16000),
-		MsgType(&assetnfttypes.MsgMint{}):                constantGasFunc(39000),
-		MsgType(&assetnfttypes.MsgFreeze{}):              constantGasFunc(7000),
-		MsgType(&assetnfttypes.MsgUnfreeze{}):            constantGasFunc(5000),
-		MsgType(&assetnfttypes.MsgAddToWhitelist{}):      constantGasFunc(7000),
-		MsgType(&assetnfttypes.MsgRemoveFromWhitelist{}): constantGasFunc(3500),
-
-		// authz
-		MsgType(&authz.MsgExec{}):   cfg.authzMsgExecGasFunc(2000),
-		MsgType(&authz.MsgGrant{}):  constantGasFunc(7000),
-		MsgType(&authz.MsgRevoke{}): constantGasFunc(2500),
-
-		// bank
-		MsgType(&banktypes.MsgSend{}):      bankSendMsgGasFunc(24000),
-		MsgType(&banktypes.MsgMultiSend{}): bankMultiSendMsgGasFunc(11000),
-
-		// distribution
-		MsgType(&distributiontypes.MsgFundCommunityPool{}):           constantGasFunc(15000),
-		MsgType(&distributiontypes.MsgSetWithdrawAddress{}):          constantGasFunc(5000),
-		MsgType(&distributiontypes.MsgWithdrawDelegatorReward{}):     constantGasFunc(65000),
-		MsgType(&distributiontypes.MsgWithdrawValidatorCommission{}): constantGasFunc(22000),
-
-		// feegrant
-		MsgType(&feegranttypes.MsgGrantAllowance{}):  constantGasFunc(10000),
-		MsgType(&feegranttypes.MsgRevokeAllowance{}): constantGasFunc(2500),
-
-		// gov
-		MsgType(&govtypes.MsgVote{}):         constantGasFunc(7000),
-		MsgType(&govtypes.MsgVoteWeighted{}): constantGasFunc(9000),
-		MsgType(&govtypes.MsgDeposit{}):      constantGasFunc(52000),
-
-		// nft
-		MsgType(&nfttypes.MsgSend{}): constantGasFunc(16000),
-
-		// slashing
-		MsgType(&slashingtypes.MsgUnjail{}): constantGasFunc(25000),
-
-		// staking
-		MsgType(&stakingtypes.MsgDelegate{}):        constantGasFunc(69000),
-		MsgType(&stakingtypes.MsgUndelegate{}):      constantGasFunc(112000),
-		MsgType(&stakingtypes.MsgBeginRedelegate{}): constantGasFunc(142000),
-		MsgType(&stakingtypes.MsgCreateValidator{}): constantGasFunc(76000),
-		MsgType(&stakingtypes.MsgEditValidator{}):   constantGasFunc(13000),
-
-		// vesting
-		MsgType(&vestingtypes.MsgCreateVestingAccount{}): constantGasFunc(25000),
-
-		// wasm
-		MsgType(&wasmtypes.MsgUpdateAdmin{}): constantGasFunc(8000),
-		MsgType(&wasmtypes.MsgClearAdmin{}):  constantGasFunc(6500),
+// NewConfig returns config for deterministic gas built from the module params.
+func NewConfig(params types.Params) Config {
+	cfg := Config{
+		FixedGas:       params.FixedGas,
+		freeBytes:      params.FreeBytes,
+		freeSignatures: params.FreeSignatures,
+		gasByMsg:       map[string]gasByMsgFunc{},
 	}
 
 	registerNondeterministicGasFuncs(
@@ -138,9 +75,47 @@ func DefaultConfig() Config {
 			&wasmtypes.MsgMigrateContract{},
 			&wasmtypes.MsgIBCSend{},
 			&wasmtypes.MsgIBCCloseChannel{},
+
+			// ibc/core/client
+			// IBC messages are defined as nondeterministic because gas consumed by them depends on the
+			// light client verification and the callbacks of the application modules.
+			&ibcclienttypes.MsgCreateClient{},
+			&ibcclienttypes.MsgUpdateClient{},
+			&ibcclienttypes.MsgUpgradeClient{},
+			&ibcclienttypes.MsgSubmitMisbehaviour{},
+
+			// ibc/core/connection
+			&ibcconnectiontypes.MsgConnectionOpenInit{},
+			&ibcconnectiontypes.MsgConnectionOpenTry{},
+			&ibcconnectiontypes.MsgConnectionOpenAck{},
+			&ibcconnectiontypes.MsgConnectionOpenConfirm{},
+
+			// ibc/core/channel
+			&ibcchanneltypes.MsgChannelOpenInit{},
+			&ibcchanneltypes.MsgChannelOpenTry{},
+			&ibcchanneltypes.MsgChannelOpenAck{},
+			&ibcchanneltypes.MsgChannelOpenConfirm{},
+			&ibcchanneltypes.MsgChannelCloseInit{},
+			&ibcchanneltypes.MsgChannelCloseConfirm{},
+			&ibcchanneltypes.MsgRecvPacket{},
+			&ibcchanneltypes.MsgTimeout{},
+			&ibcchanneltypes.MsgTimeoutOnClose{},
+			&ibcchanneltypes.MsgAcknowledgement{},
+
+			// ibc/applications/transfer
+			// MsgTransfer is defined as nondeterministic because the amount of gas depends on the
+			// fungible token rules applied to the transferred coins.
+			&ibctransfertypes.MsgTransfer{},
 		},
 	)
 
+	// gas defined by params overrides the nondeterministic defaults
+	for _, msgGas := range params.MsgGas {
+		cfg.gasByMsg[msgGas.TypeURL] = cfg.msgGasFunc(msgGas)
+	}
+	cfg.gasByMsg[MsgType(&banktypes.MsgSend{})] = bankSendMsgGasFunc(params.BankSendPerCoinGas)
+	cfg.gasByMsg[MsgType(&banktypes.MsgMultiSend{})] = bankMultiSendMsgGasFunc(params.BankMultiSendPerOperationGas)
+
 	return cfg
 }
 
@@ -173,6 +148,21 @@ func MsgType(msg sdk.Msg) string {
 	return sdk.MsgTypeURL(msg)
 }
 
+func (cfg *Config) msgGasFunc(msgGas types.MsgGas) gasByMsgFunc {
+	switch msgGas.TypeURL {
+	case MsgType(&authz.MsgExec{}):
+		return cfg.authzMsgExecGasFunc(msgGas.Gas)
+	case MsgType(&assetnfttypes.MsgMintBatch{}):
+		return assetNFTMintBatchMsgGasFunc(msgGas.Gas)
+	case MsgType(&assetnfttypes.MsgBurnBatch{}):
+		return assetNFTBurnBatchMsgGasFunc(msgGas.Gas)
+	case MsgType(&assetnfttypes.MsgSendBatch{}):
+		return assetNFTSendBatchMsgGasFunc(msgGas.Gas)
+	default:
+		return constantGasFunc(msgGas.Gas)
+	}
+}
+
 // NOTE: we need to pass Config by pointer here because
 // it needs to be initialized later map with all msg types inside to estimate gas recursively.
 func (cfg *Config) authzMsgExecGasFunc(authzMsgExecOverhead uint64) gasByMsgFunc {
@@ -248,6 +238,39 @@ func bankMultiSendMsgGasFunc(bankMultiSendPerOperationGas uint64) gasByMsgFunc {
 	}
 }
 
+func assetNFTMintBatchMsgGasFunc(assetNFTMintPerItemGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*assetnfttypes.MsgMintBatch)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]int{len(m.Items), 1})) * assetNFTMintPerItemGas, true
+	}
+}
+
+func assetNFTBurnBatchMsgGasFunc(assetNFTBurnPerItemGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*assetnfttypes.MsgBurnBatch)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]int{len(m.IDs), 1})) * assetNFTBurnPerItemGas, true
+	}
+}
+
+func assetNFTSendBatchMsgGasFunc(assetNFTSendPerItemGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*assetnfttypes.MsgSendBatch)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]int{len(m.IDs), 1})) * assetNFTSendPerItemGas, true
+	}
+}
+
 func reportUnknownMessageMetric(msgName string) {
 	metrics.IncrCounterWithLabels([]string{"deterministic_gas_unknown_message"}, 1, []metrics.Label{
 		{Name: "msg_name", Value: msgName},
@@ -6,15 +6,20 @@ import (
 	_ "unsafe"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/CoreumFoundation/coreum/testutil/simapp"
 	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
 	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
 )
 
 // To access private variable from github.com/gogo/protobuf we link it to local variable.
@@ -31,33 +36,6 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 		// Not-integrated modules:
 		// IBC:
 
-		// ibc/core/client
-		"/ibc.core.client.v1.MsgCreateClient",
-		"/ibc.core.client.v1.MsgUpdateClient",
-		"/ibc.core.client.v1.MsgUpgradeClient",
-		"/ibc.core.client.v1.MsgSubmitMisbehaviour",
-
-		// ibc/core/connection
-		"/ibc.core.connection.v1.MsgConnectionOpenInit",
-		"/ibc.core.connection.v1.MsgConnectionOpenTry",
-		"/ibc.core.connection.v1.MsgConnectionOpenAck",
-		"/ibc.core.connection.v1.MsgConnectionOpenConfirm",
-
-		// ibc/core/channel
-		"/ibc.core.channel.v1.MsgChannelOpenInit",
-		"/ibc.core.channel.v1.MsgChannelOpenTry",
-		"/ibc.core.channel.v1.MsgChannelOpenAck",
-		"/ibc.core.channel.v1.MsgChannelOpenConfirm",
-		"/ibc.core.channel.v1.MsgChannelCloseInit",
-		"/ibc.core.channel.v1.MsgChannelCloseConfirm",
-		"/ibc.core.channel.v1.MsgRecvPacket",
-		"/ibc.core.channel.v1.MsgTimeout",
-		"/ibc.core.channel.v1.MsgTimeoutOnClose",
-		"/ibc.core.channel.v1.MsgAcknowledgement",
-
-		// ibc.applications.transfer
-		"/ibc.applications.transfer.v1.MsgTransfer",
-
 		// ibc.applications.fee
 		"/ibc.applications.fee.v1.MsgRegisterPayee",
 		"/ibc.applications.fee.v1.MsgRegisterCounterpartyPayee",
@@ -89,6 +67,33 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 		"/cosmwasm.wasm.v1.MsgMigrateContract",
 		"/cosmwasm.wasm.v1.MsgIBCCloseChannel",
 		"/cosmwasm.wasm.v1.MsgIBCSend",
+
+		// ibc/core/client
+		"/ibc.core.client.v1.MsgCreateClient",
+		"/ibc.core.client.v1.MsgUpdateClient",
+		"/ibc.core.client.v1.MsgUpgradeClient",
+		"/ibc.core.client.v1.MsgSubmitMisbehaviour",
+
+		// ibc/core/connection
+		"/ibc.core.connection.v1.MsgConnectionOpenInit",
+		"/ibc.core.connection.v1.MsgConnectionOpenTry",
+		"/ibc.core.connection.v1.MsgConnectionOpenAck",
+		"/ibc.core.connection.v1.MsgConnectionOpenConfirm",
+
+		// ibc/core/channel
+		"/ibc.core.channel.v1.MsgChannelOpenInit",
+		"/ibc.core.channel.v1.MsgChannelOpenTry",
+		"/ibc.core.channel.v1.MsgChannelOpenAck",
+		"/ibc.core.channel.v1.MsgChannelOpenConfirm",
+		"/ibc.core.channel.v1.MsgChannelCloseInit",
+		"/ibc.core.channel.v1.MsgChannelCloseConfirm",
+		"/ibc.core.channel.v1.MsgRecvPacket",
+		"/ibc.core.channel.v1.MsgTimeout",
+		"/ibc.core.channel.v1.MsgTimeoutOnClose",
+		"/ibc.core.channel.v1.MsgAcknowledgement",
+
+		// ibc.applications.transfer
+		"/ibc.applications.transfer.v1.MsgTransfer",
 	}
 
 	// This is required to compile all the messages used by the app, not only those included in deterministic gas config
@@ -126,8 +131,8 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 	// To make sure we do not increase/decrease deterministic types accidentally
 	// we assert length to be equal to exact number, so each change requires
 	// explicit adjustment of tests.
-	assert.Equal(t, 10, len(nondeterministicMsgs))
-	assert.Equal(t, 39, len(deterministicMsgs))
+	assert.Equal(t, 29, len(nondeterministicMsgs))
+	assert.Equal(t, 63, len(deterministicMsgs))
 
 	for _, sdkMsg := range deterministicMsgs {
 		sdkMsg := sdkMsg
@@ -158,6 +163,9 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 		bankSendPerEntryGas      = 24000
 		bankMultiSendPerEntryGas = 11000
 		authzMsgExecOverhead     = 2000
+		assetNFTMintPerItemGas   = 35000
+		assetNFTBurnPerItemGas   = 14000
+		assetNFTSendPerItemGas   = 14000
 	)
 
 	cfg := deterministicgas.DefaultConfig()
@@ -251,6 +259,36 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 			expectedGas:             5 * bankMultiSendPerEntryGas,
 			expectedIsDeterministic: true,
 		},
+		{
+			name:                    "assetnft.MsgMintBatch: 0 items",
+			msg:                     &assetnfttypes.MsgMintBatch{},
+			expectedGas:             assetNFTMintPerItemGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetnft.MsgMintBatch: 3 items",
+			msg: &assetnfttypes.MsgMintBatch{
+				Items: make([]assetnfttypes.MintBatchItem, 3),
+			},
+			expectedGas:             3 * assetNFTMintPerItemGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetnft.MsgBurnBatch: 4 items",
+			msg: &assetnfttypes.MsgBurnBatch{
+				IDs: []string{"id1", "id2", "id3", "id4"},
+			},
+			expectedGas:             4 * assetNFTBurnPerItemGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetnft.MsgSendBatch: 2 items",
+			msg: &assetnfttypes.MsgSendBatch{
+				IDs: []string{"id1", "id2"},
+			},
+			expectedGas:             2 * assetNFTSendPerItemGas,
+			expectedIsDeterministic: true,
+		},
 		{
 			name:                    "authz.MsgExec: 0 messages",
 			msg:                     &authz.MsgExec{},
@@ -304,3 +342,111 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestDeterministicGas_ConfigProvider(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	provider := deterministicgas.NewConfigProvider(testApp.DeterministicGasKeeper)
+
+	gas, isDeterministic := provider.GasRequiredByMessage(ctx, &assetfttypes.MsgIssue{})
+	requireT.True(isDeterministic)
+	requireT.EqualValues(70000, gas)
+
+	params := deterministicgastypes.DefaultParams()
+	params.FixedGas = 60000
+	params.BankSendPerCoinGas = 30000
+	params.MsgGas = []deterministicgastypes.MsgGas{
+		{TypeURL: deterministicgas.MsgType(&assetfttypes.MsgIssue{}), Gas: 80000},
+		{TypeURL: deterministicgas.MsgType(&wasmtypes.MsgExecuteContract{}), Gas: 90000},
+	}
+	testApp.DeterministicGasKeeper.SetParams(ctx, params)
+
+	cfg := provider.GetConfig(ctx)
+	requireT.EqualValues(60000, cfg.FixedGas)
+
+	gas, isDeterministic = cfg.GasRequiredByMessage(&assetfttypes.MsgIssue{})
+	requireT.True(isDeterministic)
+	requireT.EqualValues(80000, gas)
+
+	// the params override the nondeterministic messages
+	gas, isDeterministic = cfg.GasRequiredByMessage(&wasmtypes.MsgExecuteContract{})
+	requireT.True(isDeterministic)
+	requireT.EqualValues(90000, gas)
+
+	gas, isDeterministic = cfg.GasRequiredByMessage(&banktypes.MsgSend{})
+	requireT.True(isDeterministic)
+	requireT.EqualValues(30000, gas)
+
+	// messages removed from the params are not deterministic anymore
+	gas, isDeterministic = cfg.GasRequiredByMessage(&assetfttypes.MsgMint{})
+	requireT.False(isDeterministic)
+	requireT.Zero(gas)
+}
+
+func TestDeterministicGas_ConfigProviderCache(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	provider := deterministicgas.NewConfigProvider(testApp.DeterministicGasKeeper)
+
+	cachedCtx, cfg := provider.ContextWithConfig(ctx)
+	requireT.Equal(deterministicgastypes.DefaultParams().FixedGas, cfg.FixedGas)
+
+	params := deterministicgastypes.DefaultParams()
+	params.FixedGas = 60000
+	testApp.DeterministicGasKeeper.SetParams(ctx, params)
+
+	// the cached config is used until the end of the transaction
+	requireT.Equal(deterministicgastypes.DefaultParams().FixedGas, provider.GetConfig(cachedCtx).FixedGas)
+	requireT.EqualValues(60000, provider.GetConfig(ctx).FixedGas)
+}
+
+func TestDeterministicGas_ContractGas(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	provider := deterministicgas.NewConfigProvider(testApp.DeterministicGasKeeper)
+
+	contract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	testApp.DeterministicGasKeeper.StoreContractGas(ctx, contract, "", 100000)
+	testApp.DeterministicGasKeeper.StoreContractGas(ctx, contract, "transfer", 120000)
+
+	executeMsg := func(contract, msg string) *wasmtypes.MsgExecuteContract {
+		return &wasmtypes.MsgExecuteContract{
+			Contract: contract,
+			Msg:      wasmtypes.RawContractMessage(msg),
+		}
+	}
+
+	cfg := provider.GetConfig(ctx)
+
+	// gas declared for the message name
+	gas, isDeterministic := cfg.GasRequiredByMessage(executeMsg(contract.String(), `{"transfer":{"amount":"10"}}`))
+	requireT.True(isDeterministic)
+	requireT.EqualValues(120000, gas)
+
+	// gas declared for all the messages of the contract
+	gas, isDeterministic = cfg.GasRequiredByMessage(executeMsg(contract.String(), `{"mint":{}}`))
+	requireT.True(isDeterministic)
+	requireT.EqualValues(100000, gas)
+
+	gas, isDeterministic = cfg.GasRequiredByMessage(executeMsg(contract.String(), `{"transfer":{},"mint":{}}`))
+	requireT.True(isDeterministic)
+	requireT.EqualValues(100000, gas)
+
+	// execution of the contract without the declared gas is nondeterministic
+	otherContract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	gas, isDeterministic = cfg.GasRequiredByMessage(executeMsg(otherContract.String(), `{"transfer":{}}`))
+	requireT.False(isDeterministic)
+	requireT.Zero(gas)
+
+	// the declared gas is used inside authz MsgExec too
+	execMsg := authz.NewMsgExec(otherContract, []sdk.Msg{executeMsg(contract.String(), `{"transfer":{}}`)})
+	gas, isDeterministic = cfg.GasRequiredByMessage(&execMsg)
+	requireT.True(isDeterministic)
+	requireT.EqualValues(2000+120000, gas)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// SetContractGas sets the deterministic gas of the contract execution. Only the contract admin is allowed to do it.
+// Zero gas removes the declared gas.
+func (k Keeper) SetContractGas(ctx sdk.Context, sender, contract sdk.AccAddress, msgName string, gas uint64) error {
+	contractInfo := k.wasmKeeper.GetContractInfo(ctx, contract)
+	if contractInfo == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "contract %s not found", contract.String())
+	}
+
+	if contractInfo.Admin == "" || contractInfo.Admin != sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the admin of the contract %s", sender.String(), contract.String())
+	}
+
+	previousGas, _ := k.GetContractGas(ctx, contract, msgName)
+	k.StoreContractGas(ctx, contract, msgName, gas)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractGasChanged{
+		Contract:    contract.String(),
+		MsgName:     msgName,
+		PreviousGas: previousGas,
+		CurrentGas:  gas,
+	})
+}
+
+// StoreContractGas stores the deterministic gas of the contract execution, zero gas removes the declared gas.
+func (k Keeper) StoreContractGas(ctx sdk.Context, contract sdk.AccAddress, msgName string, gas uint64) {
+	key := types.CreateContractGasKey(contract, msgName)
+	if gas == 0 {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&types.ContractGas{
+		Contract: contract.String(),
+		MsgName:  msgName,
+		Gas:      gas,
+	}))
+}
+
+// GetContractGas returns the deterministic gas declared for the execute message of the contract and true if it exists.
+func (k Keeper) GetContractGas(ctx sdk.Context, contract sdk.AccAddress, msgName string) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateContractGasKey(contract, msgName))
+	if bz == nil {
+		return 0, false
+	}
+	var contractGas types.ContractGas
+	k.cdc.MustUnmarshal(bz, &contractGas)
+	return contractGas.Gas, true
+}
+
+// GetContractGases returns the deterministic gas declared for the executions of the contract.
+func (k Keeper) GetContractGases(
+	ctx sdk.Context,
+	contract sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.ContractGas, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateContractGasesKey(contract))
+	contractGases := make([]types.ContractGas, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var contractGas types.ContractGas
+		if err := k.cdc.Unmarshal(value, &contractGas); err != nil {
+			return err
+		}
+		contractGases = append(contractGases, contractGas)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return contractGases, pageRes, nil
+}
+
+// IterateAllContractGases iterates over the gas declared for all the contracts and applies the provided callback.
+// If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateAllContractGases(ctx sdk.Context, cb func(types.ContractGas) bool) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractGasKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var contractGas types.ContractGas
+		k.cdc.MustUnmarshal(iterator.Value(), &contractGas)
+
+		if cb(contractGas) {
+			break
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+func TestKeeper_ContractGas(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	keeper := testApp.DeterministicGasKeeper
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	contract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherContract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	_, exists := keeper.GetContractGas(ctx, contract, "")
+	requireT.False(exists)
+
+	keeper.StoreContractGas(ctx, contract, "", 100000)
+	keeper.StoreContractGas(ctx, contract, "transfer", 120000)
+	keeper.StoreContractGas(ctx, otherContract, "transfer", 90000)
+
+	gas, exists := keeper.GetContractGas(ctx, contract, "")
+	requireT.True(exists)
+	requireT.EqualValues(100000, gas)
+
+	gas, exists = keeper.GetContractGas(ctx, contract, "transfer")
+	requireT.True(exists)
+	requireT.EqualValues(120000, gas)
+
+	_, exists = keeper.GetContractGas(ctx, otherContract, "")
+	requireT.False(exists)
+
+	contractGases, _, err := keeper.GetContractGases(ctx, contract, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.ElementsMatch([]types.ContractGas{
+		{Contract: contract.String(), Gas: 100000},
+		{Contract: contract.String(), MsgName: "transfer", Gas: 120000},
+	}, contractGases)
+
+	// zero gas removes the entry
+	keeper.StoreContractGas(ctx, contract, "transfer", 0)
+	_, exists = keeper.GetContractGas(ctx, contract, "transfer")
+	requireT.False(exists)
+
+	contractGases, _, err = keeper.GetContractGases(ctx, contract, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.ContractGas{
+		{Contract: contract.String(), Gas: 100000},
+	}, contractGases)
+
+	// the gas might be set only for the existing contract
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = keeper.SetContractGas(ctx, sender, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "", 100000)
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// InitGenesis initializes the deterministicgas module's state with the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, contractGas := range genState.ContractGas {
+		contract := sdk.MustAccAddressFromBech32(contractGas.Contract)
+		k.StoreContractGas(ctx, contract, contractGas.MsgName, contractGas.Gas)
+	}
+}
+
+// ExportGenesis returns the deterministicgas module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	contractGases := make([]types.ContractGas, 0)
+	k.IterateAllContractGases(ctx, func(contractGas types.ContractGas) bool {
+		contractGases = append(contractGases, contractGas)
+		return false
+	})
+
+	return &types.GenesisState{
+		Params:      k.GetParams(ctx),
+		ContractGas: contractGases,
+	}
+}
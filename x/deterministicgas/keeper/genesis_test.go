@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+func TestKeeper_InitAndExportGenesis(t *testing.T) {
+	testApp := simapp.New()
+	keeper := testApp.DeterministicGasKeeper
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := types.DefaultParams()
+	params.FixedGas = 60000
+	params.MsgGas = params.MsgGas[:3]
+	contract1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	contract2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	genState := types.GenesisState{
+		Params: params,
+		ContractGas: []types.ContractGas{
+			{Contract: contract1, Gas: 100000},
+			{Contract: contract1, MsgName: "transfer", Gas: 120000},
+			{Contract: contract2, MsgName: "mint", Gas: 90000},
+		},
+	}
+	keeper.InitGenesis(ctx, genState)
+
+	requireT := require.New(t)
+	requireT.Equal(params, keeper.GetParams(ctx))
+
+	gas, exists := keeper.GetContractGas(ctx, sdk.MustAccAddressFromBech32(contract1), "transfer")
+	requireT.True(exists)
+	requireT.EqualValues(120000, gas)
+
+	exportedGetState := keeper.ExportGenesis(ctx)
+	requireT.Equal(genState.Params, exportedGetState.Params)
+	requireT.ElementsMatch(genState.ContractGas, exportedGetState.ContractGas)
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetContractGases(
+		ctx sdk.Context,
+		contract sdk.AccAddress,
+		pagination *query.PageRequest,
+	) ([]types.ContractGas, *query.PageResponse, error)
+}
+
+// NewQueryService creates query service.
+func NewQueryService(
+	keeper QueryKeeper,
+	accountKeeper types.AccountKeeper,
+	cdc codec.BinaryCodec,
+	gasProvider types.GasProvider,
+) QueryService {
+	return QueryService{
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+		cdc:           cdc,
+		gasProvider:   gasProvider,
+	}
+}
+
+// QueryService serves grpc requests for the deterministic gas.
+type QueryService struct {
+	keeper        QueryKeeper
+	accountKeeper types.AccountKeeper
+	cdc           codec.BinaryCodec
+	gasProvider   types.GasProvider
+}
+
+// Params returns the deterministic gas params containing the full gas table.
+func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryParamsResponse{
+		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// DeterministicGas returns the deterministic gas required by the messages or the transaction.
+func (qs QueryService) DeterministicGas(
+	ctx context.Context,
+	req *types.QueryDeterministicGasRequest,
+) (*types.QueryDeterministicGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := qs.keeper.GetParams(sdkCtx)
+	res := &types.QueryDeterministicGasResponse{
+		FixedGas:      params.FixedGas,
+		TotalGas:      params.FixedGas,
+		Deterministic: true,
+	}
+
+	var msgs []sdk.Msg
+	if len(req.TxBytes) > 0 {
+		if len(req.Msgs) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "either msgs or tx bytes must be provided, not both")
+		}
+
+		tx, err := qs.decodeTx(req.TxBytes)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		msgs = tx.GetMsgs()
+
+		res.TxSizeAndSignaturesGas = txSizeAndSignaturesGas(
+			params, qs.accountKeeper.GetParams(sdkCtx), uint64(len(req.TxBytes)), uint64(len(tx.Signatures)),
+		)
+		res.TotalGas += res.TxSizeAndSignaturesGas
+	} else {
+		var err error
+		msgs, err = unpackMsgs(req.Msgs)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	res.Msgs = make([]types.MsgDeterministicGas, 0, len(msgs))
+	for _, msg := range msgs {
+		gas, isDeterministic := qs.gasProvider.GasRequiredByMessage(sdkCtx, msg)
+		res.Msgs = append(res.Msgs, types.MsgDeterministicGas{
+			TypeURL:       sdk.MsgTypeURL(msg),
+			Gas:           gas,
+			Deterministic: isDeterministic,
+		})
+		res.TotalGas += gas
+		res.Deterministic = res.Deterministic && isDeterministic
+	}
+
+	return res, nil
+}
+
+// ContractGas returns the deterministic gas declared for the executions of the contract.
+func (qs QueryService) ContractGas(
+	ctx context.Context,
+	req *types.QueryContractGasRequest,
+) (*types.QueryContractGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid contract address")
+	}
+
+	contractGases, pageRes, err := qs.keeper.GetContractGases(sdk.UnwrapSDKContext(ctx), contract, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractGasResponse{
+		ContractGas: contractGases,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (qs QueryService) decodeTx(txBytes []byte) (sdktx.Tx, error) {
+	var tx sdktx.Tx
+	if err := qs.cdc.Unmarshal(txBytes, &tx); err != nil {
+		return sdktx.Tx{}, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "failed to decode tx: %s", err)
+	}
+	if tx.Body == nil {
+		return sdktx.Tx{}, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx body is empty")
+	}
+
+	return tx, nil
+}
+
+func unpackMsgs(msgAnys []*codectypes.Any) ([]sdk.Msg, error) {
+	if len(msgAnys) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msgs or tx bytes must be provided")
+	}
+
+	msgs := make([]sdk.Msg, 0, len(msgAnys))
+	for _, msgAny := range msgAnys {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %s is not a sdk.Msg", msgAny.TypeUrl)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// txSizeAndSignaturesGas returns the gas charged for the tx bytes and signatures exceeding the free ones.
+func txSizeAndSignaturesGas(params types.Params, authParams authtypes.Params, txSize, signatures uint64) uint64 {
+	var gas uint64
+	if txSize > params.FreeBytes {
+		gas += (txSize - params.FreeBytes) * authParams.TxSizeCostPerByte
+	}
+	if signatures > params.FreeSignatures {
+		gas += (signatures - params.FreeSignatures) * authParams.SigVerifyCostSecp256k1
+	}
+
+	return gas
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/keeper"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+func TestQueryService_DeterministicGas(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, testApp.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryService(
+		testApp.DeterministicGasKeeper,
+		testApp.AccountKeeper,
+		testApp.AppCodec(),
+		deterministicgas.NewConfigProvider(testApp.DeterministicGasKeeper),
+	))
+	queryClient := types.NewQueryClient(queryHelper)
+
+	params := types.DefaultParams()
+	authParams := testApp.AccountKeeper.GetParams(ctx)
+	msgs := []sdk.Msg{&assetfttypes.MsgIssue{}, &banktypes.MsgSend{}}
+
+	// messages
+	res, err := queryClient.DeterministicGas(sdk.WrapSDKContext(ctx), &types.QueryDeterministicGasRequest{
+		Msgs: packMsgs(t, msgs...),
+	})
+	requireT.NoError(err)
+	requireT.True(res.Deterministic)
+	requireT.Equal(params.FixedGas, res.FixedGas)
+	requireT.Equal([]types.MsgDeterministicGas{
+		{TypeURL: sdk.MsgTypeURL(&assetfttypes.MsgIssue{}), Gas: 70000, Deterministic: true},
+		{TypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{}), Gas: params.BankSendPerCoinGas, Deterministic: true},
+	}, res.Msgs)
+	requireT.Equal(params.FixedGas+70000+params.BankSendPerCoinGas, res.TotalGas)
+	requireT.Zero(res.TxSizeAndSignaturesGas)
+
+	// nondeterministic message
+	res, err = queryClient.DeterministicGas(sdk.WrapSDKContext(ctx), &types.QueryDeterministicGasRequest{
+		Msgs: packMsgs(t, &assetfttypes.MsgIssue{}, &wasmtypes.MsgExecuteContract{}),
+	})
+	requireT.NoError(err)
+	requireT.False(res.Deterministic)
+	requireT.False(res.Msgs[1].Deterministic)
+	requireT.Zero(res.Msgs[1].Gas)
+
+	// tx bytes exceeding the free bytes and signatures
+	tx := sdktx.Tx{
+		Body: &sdktx.TxBody{
+			Messages: packMsgs(t, msgs...),
+			Memo:     string(make([]byte, params.FreeBytes)),
+		},
+		AuthInfo:   &sdktx.AuthInfo{},
+		Signatures: [][]byte{{0x01}, {0x02}},
+	}
+	txBytes, err := testApp.AppCodec().Marshal(&tx)
+	requireT.NoError(err)
+
+	res, err = queryClient.DeterministicGas(sdk.WrapSDKContext(ctx), &types.QueryDeterministicGasRequest{
+		TxBytes: txBytes,
+	})
+	requireT.NoError(err)
+	requireT.True(res.Deterministic)
+	requireT.Len(res.Msgs, 2)
+	expectedTxGas := (uint64(len(txBytes))-params.FreeBytes)*authParams.TxSizeCostPerByte +
+		authParams.SigVerifyCostSecp256k1
+	requireT.Equal(expectedTxGas, res.TxSizeAndSignaturesGas)
+	requireT.Equal(params.FixedGas+70000+params.BankSendPerCoinGas+expectedTxGas, res.TotalGas)
+
+	// invalid requests
+	_, err = queryClient.DeterministicGas(sdk.WrapSDKContext(ctx), &types.QueryDeterministicGasRequest{})
+	requireT.Error(err)
+
+	_, err = queryClient.DeterministicGas(sdk.WrapSDKContext(ctx), &types.QueryDeterministicGasRequest{
+		Msgs:    packMsgs(t, msgs...),
+		TxBytes: txBytes,
+	})
+	requireT.Error(err)
+
+	_, err = queryClient.DeterministicGas(sdk.WrapSDKContext(ctx), &types.QueryDeterministicGasRequest{
+		Msgs: packMsgs(t, &testdata.TestMsg{}),
+	})
+	requireT.Error(err)
+}
+
+func TestQueryService_ContractGas(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, testApp.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryService(
+		testApp.DeterministicGasKeeper,
+		testApp.AccountKeeper,
+		testApp.AppCodec(),
+		deterministicgas.NewConfigProvider(testApp.DeterministicGasKeeper),
+	))
+	queryClient := types.NewQueryClient(queryHelper)
+
+	contract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	testApp.DeterministicGasKeeper.StoreContractGas(ctx, contract, "transfer", 120000)
+
+	res, err := queryClient.ContractGas(sdk.WrapSDKContext(ctx), &types.QueryContractGasRequest{
+		Contract: contract.String(),
+	})
+	requireT.NoError(err)
+	requireT.Equal([]types.ContractGas{
+		{Contract: contract.String(), MsgName: "transfer", Gas: 120000},
+	}, res.ContractGas)
+
+	// the declared gas is returned by the deterministic gas query
+	gasRes, err := queryClient.DeterministicGas(sdk.WrapSDKContext(ctx), &types.QueryDeterministicGasRequest{
+		Msgs: packMsgs(t, &wasmtypes.MsgExecuteContract{
+			Contract: contract.String(),
+			Msg:      wasmtypes.RawContractMessage(`{"transfer":{}}`),
+		}),
+	})
+	requireT.NoError(err)
+	requireT.True(gasRes.Deterministic)
+	requireT.EqualValues(120000, gasRes.Msgs[0].Gas)
+
+	_, err = queryClient.ContractGas(sdk.WrapSDKContext(ctx), &types.QueryContractGasRequest{
+		Contract: "invalid",
+	})
+	requireT.Error(err)
+}
+
+func packMsgs(t *testing.T, msgs ...sdk.Msg) []*codectypes.Any {
+	msgAnys := make([]*codectypes.Any, 0, len(msgs))
+	for _, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		msgAnys = append(msgAnys, msgAny)
+	}
+	return msgAnys
+}
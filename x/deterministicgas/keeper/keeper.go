@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// Keeper is deterministicgas module Keeper.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      sdk.StoreKey
+	paramSubspace paramtypes.Subspace
+	wasmKeeper    types.WasmKeeper
+}
+
+// NewKeeper returns a new Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	paramSubspace paramtypes.Subspace,
+	wasmKeeper types.WasmKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSubspace.HasKeyTable() {
+		paramSubspace = paramSubspace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramSubspace,
+		wasmKeeper:    wasmKeeper,
+	}
+}
+
+// GetParams returns the set of deterministic gas parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the deterministic gas parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgKeeper defines subscope of keeper methods required by msg service.
+type MsgKeeper interface {
+	SetContractGas(ctx sdk.Context, sender, contract sdk.AccAddress, msgName string, gas uint64) error
+}
+
+// MsgServer serves grpc tx requests for the deterministicgas module.
+type MsgServer struct {
+	keeper MsgKeeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper MsgKeeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// SetContractGas sets the deterministic gas of the contract execution.
+func (ms MsgServer) SetContractGas(goCtx context.Context, req *types.MsgSetContractGas) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid contract address")
+	}
+
+	if err := ms.keeper.SetContractGas(ctx, sender, contract, req.MsgName, req.Gas); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
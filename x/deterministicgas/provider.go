@@ -0,0 +1,84 @@
+package deterministicgas
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// Keeper specifies expected methods of the deterministic gas keeper.
+type Keeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetContractGas(ctx sdk.Context, contract sdk.AccAddress, msgName string) (uint64, bool)
+}
+
+// ConfigProvider provides deterministic gas config built from the current params and the gas declared for
+// the contract executions.
+type ConfigProvider struct {
+	keeper Keeper
+}
+
+// NewConfigProvider returns new instance of the ConfigProvider.
+func NewConfigProvider(keeper Keeper) ConfigProvider {
+	return ConfigProvider{
+		keeper: keeper,
+	}
+}
+
+type configKey struct{}
+
+// ContextWithConfig returns the context caching the deterministic gas config built from the state, so the config is
+// built once per transaction and reused by the ante handler and the message handlers.
+func (p ConfigProvider) ContextWithConfig(ctx sdk.Context) (sdk.Context, Config) {
+	cfg := p.GetConfig(ctx)
+	return ctx.WithValue(configKey{}, cfg), cfg
+}
+
+// GetConfig returns deterministic gas config cached in the context or built from the state stored in the context.
+func (p ConfigProvider) GetConfig(ctx sdk.Context) Config {
+	if cfg, ok := ctx.Value(configKey{}).(Config); ok {
+		return cfg
+	}
+
+	// reading the state must not affect the gas consumed by the transaction
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	cfg := NewConfig(p.keeper.GetParams(ctx))
+	msgType := MsgType(&wasmtypes.MsgExecuteContract{})
+	cfg.gasByMsg[msgType] = p.contractExecuteGasFunc(ctx, cfg.gasByMsg[msgType])
+	return cfg
+}
+
+// GasRequiredByMessage returns gas required by message and true if message is deterministic.
+func (p ConfigProvider) GasRequiredByMessage(ctx sdk.Context, msg sdk.Msg) (uint64, bool) {
+	return p.GetConfig(ctx).GasRequiredByMessage(msg)
+}
+
+// contractExecuteGasFunc returns the gas declared for the top-level execute message of the contract,
+// or if it doesn't exist, the gas declared for all the executions of the contract. If none of them exists,
+// the gas is computed by the fallback function.
+func (p ConfigProvider) contractExecuteGasFunc(ctx sdk.Context, fallback gasByMsgFunc) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok {
+			return 0, false
+		}
+
+		contract, err := sdk.AccAddressFromBech32(m.Contract)
+		if err != nil {
+			return fallback(msg)
+		}
+
+		if msgName, ok := types.ContractMsgName(m.Msg); ok {
+			if gas, exists := p.keeper.GetContractGas(ctx, contract, msgName); exists {
+				return gas, true
+			}
+		}
+		if gas, exists := p.keeper.GetContractGas(ctx, contract, ""); exists {
+			return gas, true
+		}
+
+		return fallback(msg)
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxContractMsgNameLength is the max length of the execute message name the contract gas might be declared for.
+const MaxContractMsgNameLength = 128
+
+// Validate checks that the contract gas is valid.
+func (cg ContractGas) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cg.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address %s", cg.Contract)
+	}
+
+	if err := ValidateContractMsgName(cg.MsgName); err != nil {
+		return err
+	}
+
+	if cg.Gas == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "gas must be positive")
+	}
+
+	return nil
+}
+
+// ValidateContractMsgName checks that the execute message name is valid.
+func ValidateContractMsgName(msgName string) error {
+	if len(msgName) > MaxContractMsgNameLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "msg name must not be longer than %d", MaxContractMsgNameLength)
+	}
+	return nil
+}
+
+// ContractMsgName returns the name of the top-level execute message, which is the only key of the JSON object
+// passed to the contract. False is returned if the message doesn't follow that format.
+func ContractMsgName(msg []byte) (string, bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &fields); err != nil || len(fields) != 1 {
+		return "", false
+	}
+	for name := range fields {
+		return name, true
+	}
+	return "", false
+}
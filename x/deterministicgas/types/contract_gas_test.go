@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractMsgName(t *testing.T) {
+	testCases := []struct {
+		msg          string
+		expectedName string
+		expectedOK   bool
+	}{
+		{msg: `{"transfer":{"amount":"10"}}`, expectedName: "transfer", expectedOK: true},
+		{msg: `{"increment":{}}`, expectedName: "increment", expectedOK: true},
+		{msg: `{"transfer":{},"mint":{}}`},
+		{msg: `{}`},
+		{msg: `"transfer"`},
+		{msg: `invalid`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			name, ok := ContractMsgName([]byte(tc.msg))
+			require.Equal(t, tc.expectedOK, ok)
+			require.Equal(t, tc.expectedName, name)
+		})
+	}
+}
+
+func TestGenesisState_Validate(t *testing.T) {
+	requireT := require.New(t)
+
+	contract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	validGenesis := func() GenesisState {
+		return GenesisState{
+			Params: DefaultParams(),
+			ContractGas: []ContractGas{
+				{Contract: contract, Gas: 100000},
+				{Contract: contract, MsgName: "transfer", Gas: 120000},
+			},
+		}
+	}
+
+	gs := validGenesis()
+	requireT.NoError(gs.Validate())
+
+	gs = validGenesis()
+	gs.ContractGas[0].Contract = "invalid"
+	requireT.Error(gs.Validate())
+
+	gs = validGenesis()
+	gs.ContractGas[0].Gas = 0
+	requireT.Error(gs.Validate())
+
+	gs = validGenesis()
+	gs.ContractGas[1].MsgName = strings.Repeat("a", MaxContractMsgNameLength+1)
+	requireT.Error(gs.Validate())
+
+	gs = validGenesis()
+	gs.ContractGas = append(gs.ContractGas, gs.ContractGas[1])
+	requireT.Error(gs.Validate())
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// ErrInvalidInput defines the common error for the invalid input.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 1, "invalid input")
+	// ErrContractGasExceeded is returned when the smart contract execution consumes more gas than declared
+	// by the contract admin.
+	ErrContractGasExceeded = sdkerrors.Register(ModuleName, 2, "contract gas exceeded")
+)
@@ -0,0 +1,444 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/deterministicgas/v1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventContractGasChanged is emitted on MsgSetContractGas.
+type EventContractGasChanged struct {
+	Contract    string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	MsgName     string `protobuf:"bytes,2,opt,name=msg_name,json=msgName,proto3" json:"msg_name,omitempty"`
+	PreviousGas uint64 `protobuf:"varint,3,opt,name=previous_gas,json=previousGas,proto3" json:"previous_gas,omitempty"`
+	CurrentGas  uint64 `protobuf:"varint,4,opt,name=current_gas,json=currentGas,proto3" json:"current_gas,omitempty"`
+}
+
+func (m *EventContractGasChanged) Reset()         { *m = EventContractGasChanged{} }
+func (m *EventContractGasChanged) String() string { return proto.CompactTextString(m) }
+func (*EventContractGasChanged) ProtoMessage()    {}
+func (*EventContractGasChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75ec1ecffba6f594, []int{0}
+}
+func (m *EventContractGasChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractGasChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractGasChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventContractGasChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractGasChanged.Merge(m, src)
+}
+func (m *EventContractGasChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractGasChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractGasChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractGasChanged proto.InternalMessageInfo
+
+func (m *EventContractGasChanged) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventContractGasChanged) GetMsgName() string {
+	if m != nil {
+		return m.MsgName
+	}
+	return ""
+}
+
+func (m *EventContractGasChanged) GetPreviousGas() uint64 {
+	if m != nil {
+		return m.PreviousGas
+	}
+	return 0
+}
+
+func (m *EventContractGasChanged) GetCurrentGas() uint64 {
+	if m != nil {
+		return m.CurrentGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventContractGasChanged)(nil), "coreum.deterministicgas.v1.EventContractGasChanged")
+}
+
+func init() {
+	proto.RegisterFile("coreum/deterministicgas/v1/event.proto", fileDescriptor_75ec1ecffba6f594)
+}
+
+var fileDescriptor_75ec1ecffba6f594 = []byte{
+	// 252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x6b, 0xa8, 0xa0, 0xb8, 0x4c, 0x59, 0x08, 0x1d, 0x4c, 0x61, 0x40, 0x9d, 0x12, 0x55,
+	0x8c, 0x6c, 0x44, 0x90, 0x8d, 0xa1, 0x12, 0x0b, 0x4b, 0xe5, 0x3a, 0x27, 0xd7, 0x83, 0xed, 0xc8,
+	0x77, 0x89, 0xe0, 0x2d, 0x18, 0x78, 0x28, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0x84, 0x09, 0x0c,
+	0x30, 0xde, 0x77, 0xdf, 0xf2, 0x7f, 0xfc, 0x52, 0xf9, 0x00, 0x8d, 0xcd, 0x2b, 0x20, 0x08, 0xd6,
+	0x38, 0x83, 0x64, 0x94, 0x96, 0x98, 0xb7, 0xcb, 0x1c, 0x5a, 0x70, 0x94, 0xd5, 0xc1, 0x93, 0x4f,
+	0x66, 0xdf, 0x5e, 0xf6, 0xd7, 0xcb, 0xda, 0xe5, 0xc5, 0x2b, 0xe3, 0x27, 0xb7, 0x5f, 0x6e, 0xe1,
+	0x1d, 0x05, 0xa9, 0xa8, 0x94, 0x58, 0x6c, 0xa5, 0xd3, 0x50, 0x25, 0x33, 0x3e, 0x51, 0x03, 0x4d,
+	0xd9, 0x9c, 0x2d, 0x8e, 0x56, 0xbf, 0x77, 0x72, 0xca, 0x27, 0x16, 0xf5, 0xda, 0x49, 0x0b, 0xe9,
+	0x5e, 0xfc, 0x1d, 0x5a, 0xd4, 0xf7, 0xd2, 0x42, 0x72, 0xce, 0x8f, 0xeb, 0x00, 0xad, 0xf1, 0x0d,
+	0xae, 0xb5, 0xc4, 0x74, 0x7f, 0xce, 0x16, 0xe3, 0xd5, 0xf4, 0x87, 0x95, 0x12, 0x93, 0x33, 0x3e,
+	0x55, 0x4d, 0x08, 0xe0, 0x28, 0x1a, 0xe3, 0x68, 0xf0, 0x01, 0x95, 0x12, 0x6f, 0x1e, 0xde, 0x3a,
+	0xc1, 0x76, 0x9d, 0x60, 0x1f, 0x9d, 0x60, 0x2f, 0xbd, 0x18, 0xed, 0x7a, 0x31, 0x7a, 0xef, 0xc5,
+	0xe8, 0xf1, 0x5a, 0x1b, 0xda, 0x36, 0x9b, 0x4c, 0x79, 0x9b, 0x17, 0x71, 0xd7, 0x9d, 0x6f, 0x5c,
+	0x25, 0xc9, 0x78, 0x97, 0x0f, 0x41, 0x9e, 0xfe, 0x27, 0xa1, 0xe7, 0x1a, 0x70, 0x73, 0x10, 0x83,
+	0x5c, 0x7d, 0x0e, 0x00, 0x75, 0x8d, 0xd9, 0x0c, 0x3a, 0x01, 0x00, 0x00,
+}
+
+func (m *EventContractGasChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractGasChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractGasChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentGas != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.CurrentGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousGas != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PreviousGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgName) > 0 {
+		i -= len(m.MsgName)
+		copy(dAtA[i:], m.MsgName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventContractGasChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.MsgName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PreviousGas != 0 {
+		n += 1 + sovEvent(uint64(m.PreviousGas))
+	}
+	if m.CurrentGas != 0 {
+		n += 1 + sovEvent(uint64(m.CurrentGas))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventContractGasChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventContractGasChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventContractGasChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousGas", wireType)
+			}
+			m.PreviousGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentGas", wireType)
+			}
+			m.CurrentGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
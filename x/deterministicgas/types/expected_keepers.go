@@ -0,0 +1,17 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	GetParams(ctx sdk.Context) authtypes.Params
+}
+
+// WasmKeeper defines the expected wasm keeper.
+type WasmKeeper interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
@@ -4,28 +4,33 @@ import (
 	"context"
 	"fmt"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/grpc"
 	"github.com/gogo/protobuf/proto"
 	googlegrpc "google.golang.org/grpc"
-
-	"github.com/CoreumFoundation/coreum/x/deterministicgas"
 )
 
 const fuseGasMultiplier = 5
 
+// GasProvider provides the deterministic gas required by the messages using the gas table stored in the state.
+type GasProvider interface {
+	GasRequiredByMessage(ctx sdk.Context, msg sdk.Msg) (uint64, bool)
+}
+
 // NewDeterministicGasRouter returns wrapped router charging deterministic amount of gas for defined message types.
-func NewDeterministicGasRouter(baseRouter sdk.Router, deterministicGasConfig deterministicgas.Config) sdk.Router {
+func NewDeterministicGasRouter(baseRouter sdk.Router, gasProvider GasProvider) sdk.Router {
 	return &deterministicGasRouter{
-		baseRouter:             baseRouter,
-		deterministicGasConfig: deterministicGasConfig,
+		baseRouter:  baseRouter,
+		gasProvider: gasProvider,
 	}
 }
 
 type deterministicGasRouter struct {
-	baseRouter             sdk.Router
-	deterministicGasConfig deterministicgas.Config
+	baseRouter  sdk.Router
+	gasProvider GasProvider
 }
 
 func (r *deterministicGasRouter) AddRoute(route sdk.Route) sdk.Router {
@@ -38,23 +43,24 @@ func (r *deterministicGasRouter) Route(ctx sdk.Context, path string) sdk.Handler
 }
 
 func (r *deterministicGasRouter) handler(baseHandler sdk.Handler) sdk.Handler {
-	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
-		ctx, _, _ = ctxForDeterministicGas(ctx, msg, r.deterministicGasConfig)
+	return func(ctx sdk.Context, msg sdk.Msg) (res *sdk.Result, err error) {
+		ctx, _, isDeterministic := ctxForDeterministicGas(ctx, msg, r.gasProvider)
+		defer recoverContractGasExceeded(ctx, msg, isDeterministic, &err)
 		return baseHandler(ctx, msg)
 	}
 }
 
 // NewDeterministicMsgServer returns wrapped message server charging deterministic amount of gas for defined message types.
-func NewDeterministicMsgServer(baseServer grpc.Server, deterministicGasConfig deterministicgas.Config) grpc.Server {
+func NewDeterministicMsgServer(baseServer grpc.Server, gasProvider GasProvider) grpc.Server {
 	return &deterministicMsgServer{
-		baseServer:             baseServer,
-		deterministicGasConfig: deterministicGasConfig,
+		baseServer:  baseServer,
+		gasProvider: gasProvider,
 	}
 }
 
 type deterministicMsgServer struct {
-	baseServer             grpc.Server
-	deterministicGasConfig deterministicgas.Config
+	baseServer  grpc.Server
+	gasProvider GasProvider
 }
 
 func (s *deterministicMsgServer) RegisterService(sd *googlegrpc.ServiceDesc, handler interface{}) {
@@ -85,16 +91,23 @@ func (s *deterministicMsgServer) RegisterService(sd *googlegrpc.ServiceDesc, han
 	//
 	// Then we extract cosmos context from `ctx` replace gas meter, pack it into `ctx` again and hall final handler.
 
+	// The service description is a global variable generated by protobuf, so we modify its copy to avoid
+	// wrapping the handlers again each time the service is registered.
+	sdCopy := *sd
+	sdCopy.Methods = append([]googlegrpc.MethodDesc{}, sd.Methods...)
+	sd = &sdCopy
+
 	for i, method := range sd.Methods {
 		method := method
 		sd.Methods[i].Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor googlegrpc.UnaryServerInterceptor) (interface{}, error) {
 			return method.Handler(srv, ctx, dec, func(ctx context.Context, req interface{}, info *googlegrpc.UnaryServerInfo, handler googlegrpc.UnaryHandler) (resp interface{}, err error) {
-				return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (res interface{}, err error) {
 					sdkCtx := sdk.UnwrapSDKContext(ctx)
 					msg := req.(sdk.Msg)
-					newSDKCtx, gasBefore, isDeterministic := ctxForDeterministicGas(sdkCtx, msg, s.deterministicGasConfig)
+					newSDKCtx, gasBefore, isDeterministic := ctxForDeterministicGas(sdkCtx, msg, s.gasProvider)
+					defer recoverContractGasExceeded(newSDKCtx, msg, isDeterministic, &err)
 					//nolint:contextcheck // Naming sdk functions (sdk.WrapSDKContext) is not our responsibility
-					res, err := handler(sdk.WrapSDKContext(newSDKCtx), req)
+					res, err = handler(sdk.WrapSDKContext(newSDKCtx), req)
 					// gas metrics are reported only if message type is deterministic, and was successful
 					// CheckTx and ReCheckTx phases are ignored, since are only interested in the real execution
 					// of the message at DeliverTx phase.
@@ -112,20 +125,56 @@ func (s *deterministicMsgServer) RegisterService(sd *googlegrpc.ServiceDesc, han
 	s.baseServer.RegisterService(sd, handler)
 }
 
-func ctxForDeterministicGas(ctx sdk.Context, msg sdk.Msg, deterministicGasConfig deterministicgas.Config) (sdk.Context, sdk.Gas, bool) {
-	gasRequired, exists := deterministicGasConfig.GasRequiredByMessage(msg)
+func ctxForDeterministicGas(ctx sdk.Context, msg sdk.Msg, gasProvider GasProvider) (sdk.Context, sdk.Gas, bool) {
+	gasRequired, exists := gasProvider.GasRequiredByMessage(ctx, msg)
 	gasBefore := ctx.GasMeter().GasConsumed()
 	if exists {
 		// Fixed gas is consumed on original gas meter to require and report deterministic gas amount
 		ctx.GasMeter().ConsumeGas(gasRequired, fmt.Sprintf("DeterministicGas (gas required: %d, message type: %T)", gasRequired, msg))
 
-		// We pass much higher amount of gas to handler to be sure that it succeeds.
-		// We want to avoid passing infinite gas meter to always have a limit in case of mistake.
-		ctx = ctx.WithGasMeter(sdk.NewGasMeter(fuseGasMultiplier * gasRequired))
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(fuseGasLimit(msg, gasRequired)))
 	}
 	return ctx, gasBefore, exists
 }
 
+func fuseGasLimit(msg sdk.Msg, gasRequired uint64) uint64 {
+	// Gas declared for the contract execution is the hard cap of the gas the contract may consume.
+	if isContractExecution(msg) {
+		return gasRequired
+	}
+
+	// We pass much higher amount of gas to handler to be sure that it succeeds.
+	// We want to avoid passing infinite gas meter to always have a limit in case of mistake.
+	return fuseGasMultiplier * gasRequired
+}
+
+// recoverContractGasExceeded converts the out of gas panic, caused by the contract execution consuming more gas
+// than declared, into an error.
+func recoverContractGasExceeded(ctx sdk.Context, msg sdk.Msg, isDeterministic bool, err *error) {
+	if !isDeterministic || !isContractExecution(msg) {
+		return
+	}
+
+	r := recover()
+	if r == nil {
+		return
+	}
+	outOfGas, ok := r.(sdk.ErrorOutOfGas)
+	if !ok {
+		panic(r)
+	}
+	*err = sdkerrors.Wrapf(
+		ErrContractGasExceeded,
+		"execution of contract %s consumed more than declared %d gas, out of gas in location: %s",
+		msg.(*wasmtypes.MsgExecuteContract).Contract, ctx.GasMeter().Limit(), outOfGas.Descriptor,
+	)
+}
+
+func isContractExecution(msg sdk.Msg) bool {
+	_, ok := msg.(*wasmtypes.MsgExecuteContract)
+	return ok
+}
+
 func reportDeterministicGasMetric(oldCtx, newCtx sdk.Context, gasBefore sdk.Gas, msgName string) {
 	deterministicGas := oldCtx.GasMeter().GasConsumed() - gasBefore
 	if deterministicGas == 0 {
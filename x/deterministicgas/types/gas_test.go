@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type constantGasProvider uint64
+
+func (p constantGasProvider) GasRequiredByMessage(_ sdk.Context, _ sdk.Msg) (uint64, bool) {
+	return uint64(p), true
+}
+
+func TestDeterministicGasRouter_ContractGasCap(t *testing.T) {
+	requireT := require.New(t)
+
+	const gasRequired = 1000
+	var gasToConsume uint64
+	consumeGasHandler := func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+		ctx.GasMeter().ConsumeGas(gasToConsume, "test")
+		return &sdk.Result{}, nil
+	}
+
+	router := NewDeterministicGasRouter(baseapp.NewRouter(), constantGasProvider(gasRequired))
+	router.AddRoute(sdk.NewRoute(wasmtypes.RouterKey, consumeGasHandler))
+	router.AddRoute(sdk.NewRoute(banktypes.RouterKey, consumeGasHandler))
+
+	ctx := sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB()), tmproto.Header{}, false, log.NewNopLogger())
+	execute := func(route string, msg sdk.Msg) error {
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := router.Route(ctx, route)(ctx, msg)
+		return err
+	}
+
+	// contract execution consuming the declared gas succeeds
+	gasToConsume = gasRequired
+	requireT.NoError(execute(wasmtypes.RouterKey, &wasmtypes.MsgExecuteContract{}))
+	requireT.EqualValues(gasRequired, ctx.GasMeter().GasConsumed())
+
+	// contract execution consuming more than the declared gas fails
+	gasToConsume = gasRequired + 1
+	requireT.ErrorIs(execute(wasmtypes.RouterKey, &wasmtypes.MsgExecuteContract{}), ErrContractGasExceeded)
+
+	// other messages are allowed to consume more gas than required
+	requireT.NoError(execute(banktypes.RouterKey, &banktypes.MsgSend{}))
+	requireT.EqualValues(gasRequired, ctx.GasMeter().GasConsumed())
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesisState returns genesis state with default values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate validates genesis parameters.
+func (m *GenesisState) Validate() error {
+	contractGasKeys := map[string]struct{}{}
+	for _, contractGas := range m.ContractGas {
+		if err := contractGas.Validate(); err != nil {
+			return err
+		}
+
+		key := contractGas.Contract + "/" + contractGas.MsgName
+		if _, exists := contractGasKeys[key]; exists {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicated gas of contract %s and msg name %q", contractGas.Contract, contractGas.MsgName,
+			)
+		}
+		contractGasKeys[key] = struct{}{}
+	}
+
+	return m.Params.ValidateBasic()
+}
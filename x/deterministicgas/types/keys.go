@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/CoreumFoundation/coreum/pkg/store"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "deterministicgas"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key.
+	RouterKey = ModuleName
+)
+
+// Store key prefixes.
+var (
+	// ContractGasKeyPrefix defines the key prefix for the deterministic gas declared for the smart contract executions.
+	ContractGasKeyPrefix = []byte{0x01}
+)
+
+// CreateContractGasesKey creates the prefix for the deterministic gas declared for the executions of the contract.
+func CreateContractGasesKey(contract sdk.AccAddress) []byte {
+	return store.JoinKeys(ContractGasKeyPrefix, address.MustLengthPrefix(contract))
+}
+
+// CreateContractGasKey creates the key for the deterministic gas declared for the execute message of the contract.
+func CreateContractGasKey(contract sdk.AccAddress, msgName string) []byte {
+	return store.JoinKeys(CreateContractGasesKey(contract), []byte(msgName))
+}
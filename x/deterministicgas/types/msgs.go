@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetContractGas{}
+
+// ValidateBasic checks that message fields are valid.
+func (msg MsgSetContractGas) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid contract address")
+	}
+
+	return ValidateContractMsgName(msg.MsgName)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg MsgSetContractGas) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
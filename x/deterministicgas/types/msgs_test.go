@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetContractGas_ValidateBasic(t *testing.T) {
+	requireT := require.New(t)
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	validMsg := func() MsgSetContractGas {
+		return MsgSetContractGas{
+			Sender:   acc,
+			Contract: acc,
+			MsgName:  "transfer",
+			Gas:      100000,
+		}
+	}
+
+	msg := validMsg()
+	requireT.NoError(msg.ValidateBasic())
+
+	// zero gas removes the declared gas
+	msg = validMsg()
+	msg.Gas = 0
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = validMsg()
+	msg.Sender = "invalid"
+	requireT.Error(msg.ValidateBasic())
+
+	msg = validMsg()
+	msg.Contract = "invalid"
+	requireT.Error(msg.ValidateBasic())
+
+	msg = validMsg()
+	msg.MsgName = strings.Repeat("a", MaxContractMsgNameLength+1)
+	requireT.Error(msg.ValidateBasic())
+}
@@ -0,0 +1,224 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	nfttypes "github.com/CoreumFoundation/coreum/x/nft"
+)
+
+var (
+	// KeyFixedGas represents the fixed gas param key.
+	KeyFixedGas = []byte("FixedGas")
+	// KeyFreeBytes represents the free bytes param key.
+	KeyFreeBytes = []byte("FreeBytes")
+	// KeyFreeSignatures represents the free signatures param key.
+	KeyFreeSignatures = []byte("FreeSignatures")
+	// KeyBankSendPerCoinGas represents the bank send per coin gas param key.
+	KeyBankSendPerCoinGas = []byte("BankSendPerCoinGas")
+	// KeyBankMultiSendPerOperationGas represents the bank multi send per operation gas param key.
+	KeyBankMultiSendPerOperationGas = []byte("BankMultiSendPerOperationGas")
+	// KeyMsgGas represents the message gas param key.
+	KeyMsgGas = []byte("MsgGas")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of deterministic gas parameters.
+func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFixedGas, &m.FixedGas, validatePositiveGas),
+		paramtypes.NewParamSetPair(KeyFreeBytes, &m.FreeBytes, validateUint64),
+		paramtypes.NewParamSetPair(KeyFreeSignatures, &m.FreeSignatures, validateUint64),
+		paramtypes.NewParamSetPair(KeyBankSendPerCoinGas, &m.BankSendPerCoinGas, validatePositiveGas),
+		paramtypes.NewParamSetPair(KeyBankMultiSendPerOperationGas, &m.BankMultiSendPerOperationGas, validatePositiveGas),
+		paramtypes.NewParamSetPair(KeyMsgGas, &m.MsgGas, validateMsgGas),
+	}
+}
+
+// DefaultParams returns params with default values.
+//
+//nolint:funlen // the list of messages is long by design
+func DefaultParams() Params {
+	return Params{
+		FixedGas:                     50000,
+		FreeBytes:                    2048,
+		FreeSignatures:               1,
+		BankSendPerCoinGas:           24000,
+		BankMultiSendPerOperationGas: 11000,
+		MsgGas: []MsgGas{
+			// asset/ft
+			newMsgGas(&assetfttypes.MsgIssue{}, 70000),
+			newMsgGas(&assetfttypes.MsgMint{}, 11000),
+			newMsgGas(&assetfttypes.MsgBurn{}, 23000),
+			newMsgGas(&assetfttypes.MsgFreeze{}, 5000),
+			newMsgGas(&assetfttypes.MsgUnfreeze{}, 2500),
+			newMsgGas(&assetfttypes.MsgGloballyFreeze{}, 5000),
+			newMsgGas(&assetfttypes.MsgGloballyUnfreeze{}, 2500),
+			newMsgGas(&assetfttypes.MsgSetWhitelistedLimit{}, 5000),
+			newMsgGas(&assetfttypes.MsgClawback{}, 15500),
+			newMsgGas(&assetfttypes.MsgUpdateMetadata{}, 8000),
+			newMsgGas(&assetfttypes.MsgTransferAdmin{}, 5000),
+			newMsgGas(&assetfttypes.MsgClearAdmin{}, 5000),
+			newMsgGas(&assetfttypes.MsgCreateVestingSchedule{}, 30000),
+			newMsgGas(&assetfttypes.MsgGrantMintAllowance{}, 5000),
+			newMsgGas(&assetfttypes.MsgRevokeMintAllowance{}, 5000),
+			newMsgGas(&assetfttypes.MsgSetRateExemption{}, 5000),
+			newMsgGas(&assetfttypes.MsgUpdateCommissionRecipients{}, 8000),
+
+			// asset/nft
+			newMsgGas(&assetnfttypes.MsgBurn{}, 16000),
+			newMsgGas(&assetnfttypes.MsgIssueClass{}, 16000),
+			newMsgGas(&assetnfttypes.MsgMint{}, 39000),
+			newMsgGas(&assetnfttypes.MsgFreeze{}, 7000),
+			newMsgGas(&assetnfttypes.MsgUnfreeze{}, 5000),
+			newMsgGas(&assetnfttypes.MsgAddToWhitelist{}, 7000),
+			newMsgGas(&assetnfttypes.MsgRemoveFromWhitelist{}, 3500),
+			newMsgGas(&assetnfttypes.MsgTransferWithPrice{}, 45000),
+			newMsgGas(&assetnfttypes.MsgClassFreeze{}, 7000),
+			newMsgGas(&assetnfttypes.MsgClassUnfreeze{}, 5000),
+			newMsgGas(&assetnfttypes.MsgAddToClassWhitelist{}, 7000),
+			newMsgGas(&assetnfttypes.MsgRemoveFromClassWhitelist{}, 3500),
+			newMsgGas(&assetnfttypes.MsgRevoke{}, 16000),
+			newMsgGas(&assetnfttypes.MsgSetUser{}, 7000),
+			newMsgGas(&assetnfttypes.MsgGrantMinter{}, 7000),
+			newMsgGas(&assetnfttypes.MsgRevokeMinter{}, 3500),
+			newMsgGas(&assetnfttypes.MsgUpdateNFT{}, 8000),
+			newMsgGas(&assetnfttypes.MsgUpdateClass{}, 8000),
+			newMsgGas(&assetnfttypes.MsgMintBatch{}, 35000),
+			newMsgGas(&assetnfttypes.MsgBurnBatch{}, 14000),
+			newMsgGas(&assetnfttypes.MsgSendBatch{}, 14000),
+
+			// authz
+			newMsgGas(&authz.MsgExec{}, 2000),
+			newMsgGas(&authz.MsgGrant{}, 7000),
+			newMsgGas(&authz.MsgRevoke{}, 2500),
+
+			// deterministicgas
+			newMsgGas(&MsgSetContractGas{}, 7000),
+
+			// distribution
+			newMsgGas(&distributiontypes.MsgFundCommunityPool{}, 15000),
+			newMsgGas(&distributiontypes.MsgSetWithdrawAddress{}, 5000),
+			newMsgGas(&distributiontypes.MsgWithdrawDelegatorReward{}, 65000),
+			newMsgGas(&distributiontypes.MsgWithdrawValidatorCommission{}, 22000),
+
+			// feegrant
+			newMsgGas(&feegranttypes.MsgGrantAllowance{}, 10000),
+			newMsgGas(&feegranttypes.MsgRevokeAllowance{}, 2500),
+
+			// gov
+			newMsgGas(&govtypes.MsgVote{}, 7000),
+			newMsgGas(&govtypes.MsgVoteWeighted{}, 9000),
+			newMsgGas(&govtypes.MsgDeposit{}, 52000),
+
+			// nft
+			newMsgGas(&nfttypes.MsgSend{}, 16000),
+
+			// slashing
+			newMsgGas(&slashingtypes.MsgUnjail{}, 25000),
+
+			// staking
+			newMsgGas(&stakingtypes.MsgDelegate{}, 69000),
+			newMsgGas(&stakingtypes.MsgUndelegate{}, 112000),
+			newMsgGas(&stakingtypes.MsgBeginRedelegate{}, 142000),
+			newMsgGas(&stakingtypes.MsgCreateValidator{}, 76000),
+			newMsgGas(&stakingtypes.MsgEditValidator{}, 13000),
+
+			// vesting
+			newMsgGas(&vestingtypes.MsgCreateVestingAccount{}, 25000),
+
+			// wasm
+			newMsgGas(&wasmtypes.MsgUpdateAdmin{}, 8000),
+			newMsgGas(&wasmtypes.MsgClearAdmin{}, 6500),
+		},
+	}
+}
+
+// ValidateBasic validates deterministic gas parameters.
+func (m Params) ValidateBasic() error {
+	if err := validatePositiveGas(m.FixedGas); err != nil {
+		return errors.Wrap(err, "invalid fixed gas")
+	}
+	if err := validatePositiveGas(m.BankSendPerCoinGas); err != nil {
+		return errors.Wrap(err, "invalid bank send per coin gas")
+	}
+	if err := validatePositiveGas(m.BankMultiSendPerOperationGas); err != nil {
+		return errors.Wrap(err, "invalid bank multi send per operation gas")
+	}
+	return validateMsgGas(m.MsgGas)
+}
+
+func newMsgGas(msg sdk.Msg, gas uint64) MsgGas {
+	return MsgGas{
+		TypeURL: sdk.MsgTypeURL(msg),
+		Gas:     gas,
+	}
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePositiveGas(i interface{}) error {
+	gas, ok := i.(uint64)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+	if gas == 0 {
+		return errors.New("gas must be positive")
+	}
+	return nil
+}
+
+func validateMsgGas(i interface{}) error {
+	msgGas, ok := i.([]MsgGas)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the bank sends are charged per coin by the dedicated params
+	bankSendTypeURLs := map[string]struct{}{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}):      {},
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): {},
+	}
+
+	typeURLs := make(map[string]struct{}, len(msgGas))
+	for _, mg := range msgGas {
+		if mg.TypeURL == "" {
+			return errors.New("message type URL must be set")
+		}
+		if _, exists := bankSendTypeURLs[mg.TypeURL]; exists {
+			return errors.Errorf("gas of the message %s is defined by the bank send params", mg.TypeURL)
+		}
+		if _, exists := typeURLs[mg.TypeURL]; exists {
+			return errors.Errorf("duplicated gas of the message %s", mg.TypeURL)
+		}
+		typeURLs[mg.TypeURL] = struct{}{}
+
+		if mg.Gas == 0 {
+			return errors.Errorf("gas of the message %s must be positive", mg.TypeURL)
+		}
+	}
+
+	return nil
+}
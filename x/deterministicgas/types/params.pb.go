@@ -0,0 +1,738 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/deterministicgas/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of deterministic gas parameters.
+type Params struct {
+	// fixed_gas is the gas charged for every transaction to cover the cost of running the ante handler.
+	FixedGas uint64 `protobuf:"varint,1,opt,name=fixed_gas,json=fixedGas,proto3" json:"fixed_gas,omitempty" yaml:"fixed_gas"`
+	// free_bytes is the size of the transaction in bytes covered by the fixed gas.
+	FreeBytes uint64 `protobuf:"varint,2,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty" yaml:"free_bytes"`
+	// free_signatures is the number of the transaction signatures covered by the fixed gas.
+	FreeSignatures uint64 `protobuf:"varint,3,opt,name=free_signatures,json=freeSignatures,proto3" json:"free_signatures,omitempty" yaml:"free_signatures"`
+	// bank_send_per_coin_gas is the gas charged by the bank MsgSend for every sent coin.
+	BankSendPerCoinGas uint64 `protobuf:"varint,4,opt,name=bank_send_per_coin_gas,json=bankSendPerCoinGas,proto3" json:"bank_send_per_coin_gas,omitempty" yaml:"bank_send_per_coin_gas"`
+	// bank_multi_send_per_operation_gas is the gas charged by the bank MsgMultiSend for every input and output coin.
+	BankMultiSendPerOperationGas uint64 `protobuf:"varint,5,opt,name=bank_multi_send_per_operation_gas,json=bankMultiSendPerOperationGas,proto3" json:"bank_multi_send_per_operation_gas,omitempty" yaml:"bank_multi_send_per_operation_gas"`
+	// msg_gas is the gas charged for the deterministic message types. For the batch messages of the asset nft module
+	// it is the gas per item and for the authz MsgExec it is the overhead added to the gas of the executed messages.
+	MsgGas []MsgGas `protobuf:"bytes,6,rep,name=msg_gas,json=msgGas,proto3" json:"msg_gas" yaml:"msg_gas"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0faecebb7e64b78, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFixedGas() uint64 {
+	if m != nil {
+		return m.FixedGas
+	}
+	return 0
+}
+
+func (m *Params) GetFreeBytes() uint64 {
+	if m != nil {
+		return m.FreeBytes
+	}
+	return 0
+}
+
+func (m *Params) GetFreeSignatures() uint64 {
+	if m != nil {
+		return m.FreeSignatures
+	}
+	return 0
+}
+
+func (m *Params) GetBankSendPerCoinGas() uint64 {
+	if m != nil {
+		return m.BankSendPerCoinGas
+	}
+	return 0
+}
+
+func (m *Params) GetBankMultiSendPerOperationGas() uint64 {
+	if m != nil {
+		return m.BankMultiSendPerOperationGas
+	}
+	return 0
+}
+
+func (m *Params) GetMsgGas() []MsgGas {
+	if m != nil {
+		return m.MsgGas
+	}
+	return nil
+}
+
+// MsgGas defines the deterministic gas of the message type.
+type MsgGas struct {
+	// type_url is the type URL of the message, e.g. /coreum.asset.ft.v1.MsgMint.
+	TypeURL string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	Gas     uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty" yaml:"gas"`
+}
+
+func (m *MsgGas) Reset()         { *m = MsgGas{} }
+func (m *MsgGas) String() string { return proto.CompactTextString(m) }
+func (*MsgGas) ProtoMessage()    {}
+func (*MsgGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0faecebb7e64b78, []int{1}
+}
+func (m *MsgGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGas.Merge(m, src)
+}
+func (m *MsgGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGas proto.InternalMessageInfo
+
+func (m *MsgGas) GetTypeURL() string {
+	if m != nil {
+		return m.TypeURL
+	}
+	return ""
+}
+
+func (m *MsgGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "coreum.deterministicgas.v1.Params")
+	proto.RegisterType((*MsgGas)(nil), "coreum.deterministicgas.v1.MsgGas")
+}
+
+func init() {
+	proto.RegisterFile("coreum/deterministicgas/v1/params.proto", fileDescriptor_d0faecebb7e64b78)
+}
+
+var fileDescriptor_d0faecebb7e64b78 = []byte{
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x3a, 0xd2, 0xd5, 0x48, 0x1d, 0x44, 0xa3, 0xaa, 0x2a, 0x88, 0x3b, 0x5f, 0xe8,
+	0x01, 0x25, 0x2a, 0x70, 0x01, 0x6e, 0xa9, 0xc4, 0x2e, 0x4c, 0x4c, 0x29, 0xbd, 0x70, 0x89, 0xdc,
+	0xc6, 0x33, 0x16, 0x4d, 0x1c, 0xd9, 0xce, 0xb4, 0x7e, 0x0b, 0x3e, 0xd6, 0x8e, 0x3b, 0xc2, 0xc5,
+	0x42, 0xed, 0x37, 0xc8, 0x27, 0x40, 0x76, 0xd2, 0x6e, 0xe2, 0xcf, 0x6e, 0x6f, 0xf2, 0xfc, 0x9e,
+	0xe7, 0xb1, 0xe5, 0x17, 0xbc, 0x58, 0x72, 0x41, 0xca, 0x2c, 0x4c, 0x89, 0x22, 0x22, 0x63, 0x39,
+	0x93, 0x8a, 0x2d, 0x29, 0x96, 0xe1, 0xe5, 0x24, 0x2c, 0xb0, 0xc0, 0x99, 0x0c, 0x0a, 0xc1, 0x15,
+	0xf7, 0x86, 0x35, 0x18, 0xfc, 0x09, 0x06, 0x97, 0x93, 0xe1, 0x31, 0xe5, 0x94, 0x5b, 0x2c, 0x34,
+	0x53, 0xed, 0x40, 0x3f, 0xdb, 0xc0, 0x3d, 0xb7, 0x11, 0xde, 0x04, 0x74, 0x2f, 0xd8, 0x15, 0x49,
+	0x13, 0x8a, 0xe5, 0xc0, 0x19, 0x39, 0xe3, 0x83, 0xe8, 0xb8, 0xd2, 0xf0, 0xf1, 0x1a, 0x67, 0xab,
+	0x77, 0x68, 0x2f, 0xa1, 0xf8, 0xd0, 0xce, 0xa7, 0x58, 0x7a, 0x6f, 0x00, 0xb8, 0x10, 0x84, 0x24,
+	0x8b, 0xb5, 0x22, 0x72, 0xf0, 0xc0, 0x7a, 0x9e, 0x56, 0x1a, 0x3e, 0x69, 0x3c, 0x7b, 0x0d, 0xc5,
+	0x5d, 0xf3, 0x11, 0x99, 0xd9, 0x9b, 0x82, 0x23, 0xab, 0x48, 0x46, 0x73, 0xac, 0x4a, 0x41, 0xe4,
+	0xa0, 0x6d, 0xad, 0xc3, 0x4a, 0xc3, 0xfe, 0x1d, 0xeb, 0x2d, 0x80, 0xe2, 0x9e, 0xf9, 0x33, 0xdb,
+	0xff, 0xf0, 0xe6, 0xa0, 0xbf, 0xc0, 0xf9, 0xb7, 0x44, 0x92, 0x3c, 0x4d, 0x0a, 0x22, 0x92, 0x25,
+	0x67, 0xb9, 0x3d, 0xfa, 0x81, 0xcd, 0x3a, 0xa9, 0x34, 0x7c, 0x5e, 0x67, 0xfd, 0x9b, 0x43, 0xb1,
+	0x67, 0x84, 0x19, 0xc9, 0xd3, 0x73, 0x22, 0xa6, 0x9c, 0xe5, 0xe6, 0x46, 0x25, 0x38, 0xb1, 0x78,
+	0x56, 0xae, 0x14, 0xbb, 0x35, 0xf1, 0x82, 0x08, 0xac, 0x18, 0xaf, 0x1b, 0x1e, 0xda, 0x86, 0x97,
+	0x95, 0x86, 0xe3, 0x3b, 0x0d, 0xf7, 0x59, 0x50, 0xfc, 0xcc, 0x30, 0x67, 0x06, 0x69, 0x1a, 0x3f,
+	0xed, 0x74, 0x53, 0x3b, 0x03, 0x9d, 0x4c, 0x52, 0x1b, 0xee, 0x8e, 0xda, 0xe3, 0x47, 0xaf, 0x50,
+	0xf0, 0xff, 0xa7, 0x0c, 0xce, 0x24, 0x3d, 0xc5, 0x32, 0xea, 0x5f, 0x6b, 0xd8, 0xaa, 0x34, 0xec,
+	0xd5, 0x87, 0x68, 0x02, 0x50, 0xec, 0x66, 0x56, 0x47, 0x04, 0xb8, 0x35, 0xe9, 0xbd, 0x05, 0x87,
+	0x6a, 0x5d, 0x90, 0xa4, 0x14, 0x2b, 0xfb, 0xb2, 0xdd, 0xc8, 0xdf, 0x68, 0xd8, 0xf9, 0xbc, 0x2e,
+	0xc8, 0x3c, 0xfe, 0x58, 0x69, 0x78, 0x54, 0x47, 0xec, 0x20, 0x14, 0x77, 0xcc, 0x38, 0x17, 0x2b,
+	0x6f, 0x04, 0xda, 0x14, 0xef, 0xde, 0xb6, 0x57, 0x69, 0x08, 0x6a, 0xd4, 0x36, 0x19, 0x29, 0x9a,
+	0x5f, 0x6f, 0x7c, 0xe7, 0x66, 0xe3, 0x3b, 0xbf, 0x36, 0xbe, 0xf3, 0x7d, 0xeb, 0xb7, 0x6e, 0xb6,
+	0x7e, 0xeb, 0xc7, 0xd6, 0x6f, 0x7d, 0x79, 0x4f, 0x99, 0xfa, 0x5a, 0x2e, 0x82, 0x25, 0xcf, 0xc2,
+	0xa9, 0xbd, 0xce, 0x07, 0x5e, 0xe6, 0xa9, 0xbd, 0x75, 0xd8, 0xec, 0xf4, 0xd5, 0xdf, 0x5b, 0x6d,
+	0xaa, 0xe5, 0xc2, 0xb5, 0x0b, 0xfa, 0xfa, 0xf7, 0x00, 0xb2, 0xec, 0xb0, 0x5c, 0xfd, 0x02, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgGas) > 0 {
+		for iNdEx := len(m.MsgGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BankMultiSendPerOperationGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BankMultiSendPerOperationGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BankSendPerCoinGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BankSendPerCoinGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FreeSignatures != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FreeSignatures))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FreeBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FreeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FixedGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FixedGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeURL) > 0 {
+		i -= len(m.TypeURL)
+		copy(dAtA[i:], m.TypeURL)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FixedGas != 0 {
+		n += 1 + sovParams(uint64(m.FixedGas))
+	}
+	if m.FreeBytes != 0 {
+		n += 1 + sovParams(uint64(m.FreeBytes))
+	}
+	if m.FreeSignatures != 0 {
+		n += 1 + sovParams(uint64(m.FreeSignatures))
+	}
+	if m.BankSendPerCoinGas != 0 {
+		n += 1 + sovParams(uint64(m.BankSendPerCoinGas))
+	}
+	if m.BankMultiSendPerOperationGas != 0 {
+		n += 1 + sovParams(uint64(m.BankMultiSendPerOperationGas))
+	}
+	if len(m.MsgGas) > 0 {
+		for _, e := range m.MsgGas {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeURL)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovParams(uint64(m.Gas))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedGas", wireType)
+			}
+			m.FixedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FixedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeBytes", wireType)
+			}
+			m.FreeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeSignatures", wireType)
+			}
+			m.FreeSignatures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeSignatures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankSendPerCoinGas", wireType)
+			}
+			m.BankSendPerCoinGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BankSendPerCoinGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankMultiSendPerOperationGas", wireType)
+			}
+			m.BankMultiSendPerOperationGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BankMultiSendPerOperationGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGas = append(m.MsgGas, MsgGas{})
+			if err := m.MsgGas[len(m.MsgGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_ValidateBasic(t *testing.T) {
+	requireT := require.New(t)
+
+	requireT.NoError(DefaultParams().ValidateBasic())
+
+	p := DefaultParams()
+	p.FixedGas = 0
+	requireT.Error(p.ValidateBasic())
+
+	p = DefaultParams()
+	p.BankSendPerCoinGas = 0
+	requireT.Error(p.ValidateBasic())
+
+	p = DefaultParams()
+	p.MsgGas = append(p.MsgGas, MsgGas{TypeURL: "", Gas: 1})
+	requireT.Error(p.ValidateBasic())
+
+	p = DefaultParams()
+	p.MsgGas = append(p.MsgGas, p.MsgGas[0])
+	requireT.Error(p.ValidateBasic())
+
+	p = DefaultParams()
+	p.MsgGas[0].Gas = 0
+	requireT.Error(p.ValidateBasic())
+
+	p = DefaultParams()
+	p.MsgGas = append(p.MsgGas, newMsgGas(&banktypes.MsgSend{}, 1))
+	requireT.Error(p.ValidateBasic())
+
+	p = DefaultParams()
+	p.MsgGas = append(p.MsgGas, MsgGas{TypeURL: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), Gas: 1})
+	requireT.Error(p.ValidateBasic())
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &QueryDeterministicGasRequest{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (m *QueryDeterministicGasRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.Msgs {
+		var sdkMsg sdk.Msg
+		if err := unpacker.UnpackAny(msg, &sdkMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
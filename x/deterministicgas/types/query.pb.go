@@ -0,0 +1,1936 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/deterministicgas/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest defines the request type for querying x/deterministicgas parameters.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6aa07b8fd5b5b9, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for querying x/deterministicgas parameters.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6aa07b8fd5b5b9, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryDeterministicGasRequest defines the request type for querying the deterministic gas of the messages.
+// Either msgs or tx_bytes must be set.
+type QueryDeterministicGasRequest struct {
+	// msgs is the list of the messages to compute the gas for.
+	Msgs []*types.Any `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// tx_bytes is the encoded transaction to compute the gas for.
+	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *QueryDeterministicGasRequest) Reset()         { *m = QueryDeterministicGasRequest{} }
+func (m *QueryDeterministicGasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeterministicGasRequest) ProtoMessage()    {}
+func (*QueryDeterministicGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6aa07b8fd5b5b9, []int{2}
+}
+func (m *QueryDeterministicGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeterministicGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeterministicGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeterministicGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeterministicGasRequest.Merge(m, src)
+}
+func (m *QueryDeterministicGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeterministicGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeterministicGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeterministicGasRequest proto.InternalMessageInfo
+
+func (m *QueryDeterministicGasRequest) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *QueryDeterministicGasRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// MsgDeterministicGas is the deterministic gas of the single message.
+type MsgDeterministicGas struct {
+	TypeURL string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// gas is the gas required by the message, it is 0 if message is nondeterministic.
+	Gas           uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	Deterministic bool   `protobuf:"varint,3,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+}
+
+func (m *MsgDeterministicGas) Reset()         { *m = MsgDeterministicGas{} }
+func (m *MsgDeterministicGas) String() string { return proto.CompactTextString(m) }
+func (*MsgDeterministicGas) ProtoMessage()    {}
+func (*MsgDeterministicGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6aa07b8fd5b5b9, []int{3}
+}
+func (m *MsgDeterministicGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeterministicGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeterministicGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeterministicGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeterministicGas.Merge(m, src)
+}
+func (m *MsgDeterministicGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeterministicGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeterministicGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeterministicGas proto.InternalMessageInfo
+
+func (m *MsgDeterministicGas) GetTypeURL() string {
+	if m != nil {
+		return m.TypeURL
+	}
+	return ""
+}
+
+func (m *MsgDeterministicGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *MsgDeterministicGas) GetDeterministic() bool {
+	if m != nil {
+		return m.Deterministic
+	}
+	return false
+}
+
+// QueryDeterministicGasResponse defines the response type for querying the deterministic gas of the messages.
+type QueryDeterministicGasResponse struct {
+	Msgs []MsgDeterministicGas `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs"`
+	// fixed_gas is the gas charged for every transaction.
+	FixedGas uint64 `protobuf:"varint,2,opt,name=fixed_gas,json=fixedGas,proto3" json:"fixed_gas,omitempty"`
+	// total_gas is the sum of the fixed gas, the gas of all the messages and the tx size and signatures gas.
+	TotalGas uint64 `protobuf:"varint,3,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// deterministic is true if all the messages are deterministic, only then total_gas might be used as the gas limit.
+	Deterministic bool `protobuf:"varint,4,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+	// tx_size_and_signatures_gas is the gas charged for the bytes and signatures exceeding the free ones.
+	// It is computed only if tx_bytes is provided.
+	TxSizeAndSignaturesGas uint64 `protobuf:"varint,5,opt,name=tx_size_and_signatures_gas,json=txSizeAndSignaturesGas,proto3" json:"tx_size_and_signatures_gas,omitempty"`
+}
+
+func (m *QueryDeterministicGasResponse) Reset()         { *m = QueryDeterministicGasResponse{} }
+func (m *QueryDeterministicGasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeterministicGasResponse) ProtoMessage()    {}
+func (*QueryDeterministicGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6aa07b8fd5b5b9, []int{4}
+}
+func (m *QueryDeterministicGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeterministicGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeterministicGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeterministicGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeterministicGasResponse.Merge(m, src)
+}
+func (m *QueryDeterministicGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeterministicGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeterministicGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeterministicGasResponse proto.InternalMessageInfo
+
+func (m *QueryDeterministicGasResponse) GetMsgs() []MsgDeterministicGas {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *QueryDeterministicGasResponse) GetFixedGas() uint64 {
+	if m != nil {
+		return m.FixedGas
+	}
+	return 0
+}
+
+func (m *QueryDeterministicGasResponse) GetTotalGas() uint64 {
+	if m != nil {
+		return m.TotalGas
+	}
+	return 0
+}
+
+func (m *QueryDeterministicGasResponse) GetDeterministic() bool {
+	if m != nil {
+		return m.Deterministic
+	}
+	return false
+}
+
+func (m *QueryDeterministicGasResponse) GetTxSizeAndSignaturesGas() uint64 {
+	if m != nil {
+		return m.TxSizeAndSignaturesGas
+	}
+	return 0
+}
+
+type QueryContractGasRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// contract is the address of the smart contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryContractGasRequest) Reset()         { *m = QueryContractGasRequest{} }
+func (m *QueryContractGasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractGasRequest) ProtoMessage()    {}
+func (*QueryContractGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6aa07b8fd5b5b9, []int{5}
+}
+func (m *QueryContractGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractGasRequest.Merge(m, src)
+}
+func (m *QueryContractGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractGasRequest proto.InternalMessageInfo
+
+func (m *QueryContractGasRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryContractGasRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+type QueryContractGasResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// contract_gas contains the gas declared for the executions of the queried contract.
+	ContractGas []ContractGas `protobuf:"bytes,2,rep,name=contract_gas,json=contractGas,proto3" json:"contract_gas"`
+}
+
+func (m *QueryContractGasResponse) Reset()         { *m = QueryContractGasResponse{} }
+func (m *QueryContractGasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractGasResponse) ProtoMessage()    {}
+func (*QueryContractGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c6aa07b8fd5b5b9, []int{6}
+}
+func (m *QueryContractGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractGasResponse.Merge(m, src)
+}
+func (m *QueryContractGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractGasResponse proto.InternalMessageInfo
+
+func (m *QueryContractGasResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryContractGasResponse) GetContractGas() []ContractGas {
+	if m != nil {
+		return m.ContractGas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.deterministicgas.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.deterministicgas.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDeterministicGasRequest)(nil), "coreum.deterministicgas.v1.QueryDeterministicGasRequest")
+	proto.RegisterType((*MsgDeterministicGas)(nil), "coreum.deterministicgas.v1.MsgDeterministicGas")
+	proto.RegisterType((*QueryDeterministicGasResponse)(nil), "coreum.deterministicgas.v1.QueryDeterministicGasResponse")
+	proto.RegisterType((*QueryContractGasRequest)(nil), "coreum.deterministicgas.v1.QueryContractGasRequest")
+	proto.RegisterType((*QueryContractGasResponse)(nil), "coreum.deterministicgas.v1.QueryContractGasResponse")
+}
+
+func init() {
+	proto.RegisterFile("coreum/deterministicgas/v1/query.proto", fileDescriptor_8c6aa07b8fd5b5b9)
+}
+
+var fileDescriptor_8c6aa07b8fd5b5b9 = []byte{
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4b, 0x1b, 0x4f,
+	0x14, 0xcf, 0x26, 0x51, 0x93, 0x89, 0x5f, 0x90, 0x51, 0xbe, 0x8d, 0xab, 0x8d, 0x61, 0x11, 0x0d,
+	0x82, 0x3b, 0x24, 0x4a, 0xb1, 0xb6, 0x87, 0x1a, 0x8b, 0xa1, 0xd0, 0x82, 0x5d, 0x2b, 0x85, 0x5e,
+	0xc2, 0x64, 0x33, 0x6e, 0x17, 0x92, 0x9d, 0xb8, 0x33, 0x2b, 0x89, 0xc5, 0x4b, 0x0f, 0x3d, 0x17,
+	0x4a, 0x6f, 0xfd, 0x37, 0xda, 0x7b, 0x6f, 0x1e, 0x85, 0x5e, 0x7a, 0x92, 0x12, 0xfb, 0x87, 0x94,
+	0x9d, 0x99, 0x68, 0x62, 0x7e, 0xd9, 0xde, 0x66, 0xde, 0x7b, 0x9f, 0xf7, 0x3e, 0x9f, 0x37, 0xef,
+	0x0d, 0x58, 0xb1, 0xa9, 0x4f, 0x82, 0x3a, 0xaa, 0x12, 0x4e, 0xfc, 0xba, 0xeb, 0xb9, 0x8c, 0xbb,
+	0xb6, 0x83, 0x19, 0x3a, 0xc9, 0xa3, 0xe3, 0x80, 0xf8, 0x2d, 0xb3, 0xe1, 0x53, 0x4e, 0xa1, 0x2e,
+	0xe3, 0xcc, 0xdb, 0x71, 0xe6, 0x49, 0x5e, 0x9f, 0x73, 0xa8, 0x43, 0x45, 0x18, 0x0a, 0x4f, 0x12,
+	0xa1, 0x2f, 0x3a, 0x94, 0x3a, 0x35, 0x82, 0x70, 0xc3, 0x45, 0xd8, 0xf3, 0x28, 0xc7, 0xdc, 0xa5,
+	0x1e, 0x53, 0xde, 0x79, 0xe5, 0x15, 0xb7, 0x4a, 0x70, 0x84, 0xb0, 0xa7, 0x4a, 0xe9, 0x6b, 0x36,
+	0x65, 0x75, 0xca, 0x50, 0x05, 0x33, 0x22, 0x39, 0xa0, 0x93, 0x7c, 0x85, 0x70, 0x9c, 0x47, 0x0d,
+	0xec, 0xb8, 0x9e, 0xc8, 0xa3, 0x62, 0xd7, 0x47, 0xd0, 0xb7, 0xa9, 0xc7, 0x7d, 0x6c, 0xf3, 0x72,
+	0x48, 0x53, 0x86, 0xaf, 0x8e, 0x08, 0x6f, 0x60, 0x1f, 0xd7, 0x55, 0xa0, 0x31, 0x07, 0xe0, 0xcb,
+	0xb0, 0xf2, 0xbe, 0x30, 0x5a, 0xe4, 0x38, 0x20, 0x8c, 0x1b, 0xaf, 0xc1, 0x6c, 0x8f, 0x95, 0x35,
+	0xa8, 0xc7, 0x08, 0x7c, 0x02, 0x26, 0x25, 0x38, 0xad, 0x65, 0xb5, 0x5c, 0xaa, 0x60, 0x98, 0xc3,
+	0x9b, 0x65, 0x4a, 0x6c, 0x31, 0x7e, 0x7e, 0xb9, 0x14, 0xb1, 0x14, 0xce, 0xb0, 0xc1, 0xa2, 0x48,
+	0xfc, 0xb4, 0x1b, 0x50, 0xc2, 0x9d, 0xc2, 0x30, 0x07, 0xe2, 0x75, 0xe6, 0x84, 0xf9, 0x63, 0xb9,
+	0x54, 0x61, 0xce, 0x94, 0xcd, 0x33, 0x3b, 0xcd, 0x33, 0x77, 0xbc, 0x96, 0x25, 0x22, 0xe0, 0x3c,
+	0x48, 0xf0, 0x66, 0xb9, 0xd2, 0xe2, 0x84, 0xa5, 0xa3, 0x59, 0x2d, 0x37, 0x6d, 0x4d, 0xf1, 0x66,
+	0x31, 0xbc, 0x1a, 0x01, 0x98, 0x7d, 0xc1, 0x9c, 0xdb, 0x25, 0xe0, 0x0a, 0x48, 0xf0, 0x56, 0x83,
+	0x94, 0x03, 0xbf, 0x26, 0xf8, 0x27, 0x8b, 0xa9, 0xf6, 0xe5, 0xd2, 0xd4, 0xab, 0x56, 0x83, 0x1c,
+	0x5a, 0xcf, 0xad, 0xa9, 0xd0, 0x79, 0xe8, 0xd7, 0xe0, 0x0c, 0x88, 0x39, 0x58, 0x26, 0x8d, 0x5b,
+	0xe1, 0x11, 0x2e, 0x83, 0xff, 0x7a, 0x14, 0xa6, 0x63, 0x59, 0x2d, 0x97, 0xb0, 0x7a, 0x8d, 0xc6,
+	0x87, 0x28, 0xb8, 0x3f, 0x44, 0x9c, 0xea, 0xdf, 0xb3, 0x1e, 0x75, 0x68, 0x54, 0xf7, 0x06, 0x08,
+	0x50, 0xad, 0x94, 0xf2, 0x17, 0x40, 0xf2, 0xc8, 0x6d, 0x92, 0x6a, 0xf9, 0x86, 0x6a, 0x42, 0x18,
+	0x42, 0xa5, 0x0b, 0x20, 0xc9, 0x29, 0xc7, 0x35, 0xe1, 0x8c, 0x49, 0xa7, 0x30, 0x94, 0x06, 0x89,
+	0x89, 0x0f, 0x10, 0x03, 0xb7, 0x81, 0xce, 0x9b, 0x65, 0xe6, 0x9e, 0x92, 0x32, 0xf6, 0xaa, 0x65,
+	0xe6, 0x3a, 0x1e, 0xe6, 0x81, 0x4f, 0x98, 0xc8, 0x39, 0x21, 0x72, 0xfe, 0xcf, 0x9b, 0x07, 0xee,
+	0x29, 0xd9, 0xf1, 0xaa, 0x07, 0xd7, 0xee, 0x12, 0x66, 0xc6, 0x19, 0xb8, 0x27, 0xfa, 0xb0, 0xab,
+	0xe6, 0xb2, 0xeb, 0x7d, 0xf7, 0x00, 0xb8, 0x19, 0x6d, 0x35, 0x45, 0x2b, 0xa6, 0xdc, 0x03, 0x33,
+	0xdc, 0x03, 0x53, 0xee, 0xa2, 0xda, 0x03, 0x73, 0x1f, 0x3b, 0x44, 0x61, 0xad, 0x2e, 0x24, 0xd4,
+	0x41, 0xa2, 0x33, 0xf5, 0x42, 0x7d, 0xd2, 0xba, 0xbe, 0x1b, 0x5f, 0x35, 0x90, 0xee, 0xaf, 0xaf,
+	0x9e, 0xa0, 0x34, 0x80, 0xc0, 0xea, 0x58, 0x02, 0x12, 0xdc, 0xc3, 0x60, 0x1f, 0x4c, 0x77, 0xef,
+	0x5d, 0x3a, 0x9a, 0x8d, 0xa9, 0x54, 0x43, 0xdf, 0xb4, 0x8b, 0x8f, 0x7a, 0xcb, 0x94, 0x7d, 0x63,
+	0x2a, 0x7c, 0x89, 0x83, 0x09, 0xc1, 0x1b, 0x7e, 0xd6, 0xc0, 0xa4, 0x5c, 0x1f, 0x68, 0x8e, 0x4a,
+	0xd8, 0xbf, 0xb9, 0x3a, 0xba, 0x73, 0xbc, 0xd4, 0x64, 0xac, 0xbd, 0xff, 0xf1, 0xfb, 0x53, 0x74,
+	0x19, 0x1a, 0x68, 0xec, 0x97, 0x01, 0xbf, 0x6b, 0x60, 0xa6, 0x6f, 0xad, 0xb6, 0xc6, 0x56, 0x1c,
+	0xb2, 0xec, 0xfa, 0xc3, 0x7f, 0x40, 0x2a, 0xd6, 0x5b, 0x82, 0x75, 0xc1, 0x58, 0x1f, 0xc5, 0xba,
+	0xc7, 0xb6, 0xee, 0x60, 0xb6, 0xad, 0xad, 0xc1, 0x6f, 0x1a, 0x48, 0x75, 0x3d, 0x04, 0xdc, 0x18,
+	0x4b, 0xa2, 0x7f, 0x8c, 0xf5, 0xcd, 0xbf, 0x03, 0x29, 0xd2, 0x8f, 0x05, 0xe9, 0x07, 0x70, 0x13,
+	0xdd, 0xe1, 0x33, 0x67, 0xe8, 0x5d, 0xe7, 0x78, 0x86, 0x1c, 0xcc, 0x8a, 0x87, 0xe7, 0xed, 0x8c,
+	0x76, 0xd1, 0xce, 0x68, 0xbf, 0xda, 0x19, 0xed, 0xe3, 0x55, 0x26, 0x72, 0x71, 0x95, 0x89, 0xfc,
+	0xbc, 0xca, 0x44, 0xde, 0x3c, 0x72, 0x5c, 0xfe, 0x36, 0xa8, 0x98, 0x36, 0xad, 0xa3, 0x5d, 0x91,
+	0x79, 0x8f, 0x06, 0x5e, 0x55, 0xcc, 0x69, 0xa7, 0x54, 0xb3, 0xbf, 0x58, 0xf8, 0xdf, 0xb1, 0xca,
+	0xa4, 0xf8, 0x5b, 0x37, 0xfe, 0x0c, 0x00, 0x0a, 0xcb, 0xc7, 0xa0, 0x20, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/deterministicgas module, which contain the full deterministic gas table.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DeterministicGas returns the deterministic gas required by the provided messages or transaction.
+	DeterministicGas(ctx context.Context, in *QueryDeterministicGasRequest, opts ...grpc.CallOption) (*QueryDeterministicGasResponse, error)
+	// ContractGas returns the deterministic gas declared for the executions of the smart contract.
+	ContractGas(ctx context.Context, in *QueryContractGasRequest, opts ...grpc.CallOption) (*QueryContractGasResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.deterministicgas.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeterministicGas(ctx context.Context, in *QueryDeterministicGasRequest, opts ...grpc.CallOption) (*QueryDeterministicGasResponse, error) {
+	out := new(QueryDeterministicGasResponse)
+	err := c.cc.Invoke(ctx, "/coreum.deterministicgas.v1.Query/DeterministicGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractGas(ctx context.Context, in *QueryContractGasRequest, opts ...grpc.CallOption) (*QueryContractGasResponse, error) {
+	out := new(QueryContractGasResponse)
+	err := c.cc.Invoke(ctx, "/coreum.deterministicgas.v1.Query/ContractGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/deterministicgas module, which contain the full deterministic gas table.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DeterministicGas returns the deterministic gas required by the provided messages or transaction.
+	DeterministicGas(context.Context, *QueryDeterministicGasRequest) (*QueryDeterministicGasResponse, error)
+	// ContractGas returns the deterministic gas declared for the executions of the smart contract.
+	ContractGas(context.Context, *QueryContractGasRequest) (*QueryContractGasResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DeterministicGas(ctx context.Context, req *QueryDeterministicGasRequest) (*QueryDeterministicGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeterministicGas not implemented")
+}
+func (*UnimplementedQueryServer) ContractGas(ctx context.Context, req *QueryContractGasRequest) (*QueryContractGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractGas not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.deterministicgas.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeterministicGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeterministicGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeterministicGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.deterministicgas.v1.Query/DeterministicGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeterministicGas(ctx, req.(*QueryDeterministicGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.deterministicgas.v1.Query/ContractGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractGas(ctx, req.(*QueryContractGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.deterministicgas.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DeterministicGas",
+			Handler:    _Query_DeterministicGas_Handler,
+		},
+		{
+			MethodName: "ContractGas",
+			Handler:    _Query_ContractGas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/deterministicgas/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeterministicGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeterministicGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeterministicGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeterministicGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeterministicGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeterministicGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deterministic {
+		i--
+		if m.Deterministic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeURL) > 0 {
+		i -= len(m.TypeURL)
+		copy(dAtA[i:], m.TypeURL)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeterministicGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeterministicGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeterministicGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxSizeAndSignaturesGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxSizeAndSignaturesGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Deterministic {
+		i--
+		if m.Deterministic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FixedGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FixedGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractGas) > 0 {
+		for iNdEx := len(m.ContractGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeterministicGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeterministicGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeURL)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	if m.Deterministic {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDeterministicGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FixedGas != 0 {
+		n += 1 + sovQuery(uint64(m.FixedGas))
+	}
+	if m.TotalGas != 0 {
+		n += 1 + sovQuery(uint64(m.TotalGas))
+	}
+	if m.Deterministic {
+		n += 2
+	}
+	if m.TxSizeAndSignaturesGas != 0 {
+		n += 1 + sovQuery(uint64(m.TxSizeAndSignaturesGas))
+	}
+	return n
+}
+
+func (m *QueryContractGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ContractGas) > 0 {
+		for _, e := range m.ContractGas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeterministicGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeterministicGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeterministicGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeterministicGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeterministicGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeterministicGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deterministic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deterministic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeterministicGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeterministicGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeterministicGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, MsgDeterministicGas{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedGas", wireType)
+			}
+			m.FixedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FixedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+			}
+			m.TotalGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deterministic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deterministic = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeAndSignaturesGas", wireType)
+			}
+			m.TxSizeAndSignaturesGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeAndSignaturesGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractGas = append(m.ContractGas, ContractGas{})
+			if err := m.ContractGas[len(m.ContractGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
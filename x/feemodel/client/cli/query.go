@@ -1,13 +1,22 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
+// Flags defined on queries.
+const (
+	FromHeightFlag = "from-height"
+	ToHeightFlag   = "to-height"
+)
+
 // GetQueryCmd returns the parent command for all x/feemodel CLI query commands. The
 // provided clientCtx should have, at a minimum, a verifier, Tendermint RPC client,
 // and marshaler set.
@@ -22,6 +31,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetMinGasPriceCmd(),
+		GetFeeModelStateCmd(),
+		GetRecommendedGasPriceCmd(),
+		GetMinGasPriceHistoryCmd(),
 	)
 
 	return cmd
@@ -52,6 +64,105 @@ func GetMinGasPriceCmd() *cobra.Command {
 	return cmd
 }
 
+// GetFeeModelStateCmd returns command for getting the current state of the fee model.
+func GetFeeModelStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Query for the current state of the fee model",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeModelState(cmd.Context(), &types.QueryFeeModelStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRecommendedGasPriceCmd returns command for getting the range of the gas prices projected for the next blocks.
+func GetRecommendedGasPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recommended-gas-price [after_blocks]",
+		Short: "Query for the low, medium and high gas prices projected by the fee model for the next blocks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			afterBlocks, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return errors.Wrap(err, "invalid after_blocks")
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecommendedGasPrice(cmd.Context(), &types.QueryRecommendedGasPriceRequest{
+				AfterBlocks: uint32(afterBlocks),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMinGasPriceHistoryCmd returns command for getting the min gas prices recorded for the recent blocks.
+func GetMinGasPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-gas-price-history",
+		Short: "Query for the min gas prices and EMA values recorded for the recent blocks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromHeight, err := cmd.Flags().GetInt64(FromHeightFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			toHeight, err := cmd.Flags().GetInt64(ToHeightFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MinGasPriceHistory(cmd.Context(), &types.QueryMinGasPriceHistoryRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Int64(FromHeightFlag, 0, "First height of the range, 0 means the oldest recorded height")
+	cmd.Flags().Int64(ToHeightFlag, 0, "Last height of the range, 0 means the latest height")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryGasPrice queries the gas price.
 func QueryGasPrice(cmd *cobra.Command) (*types.QueryMinGasPriceResponse, error) {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/testutil/network"
 	"github.com/CoreumFoundation/coreum/x/feemodel/client/cli"
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
 func TestMinGasPrice(t *testing.T) {
@@ -27,3 +28,43 @@ func TestMinGasPrice(t *testing.T) {
 	assert.Equal(t, testNetwork.Config.BondDenom, resp.Denom)
 	assert.True(t, resp.Amount.GT(sdk.ZeroDec()))
 }
+
+func TestFeeModelState(t *testing.T) {
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"state", "--output", "json"})
+	require.NoError(t, err)
+
+	var resp types.QueryFeeModelStateResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	assert.Positive(t, resp.EscalationStartBlockGas)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cmd, []string{"recommended-gas-price", "10", "--output", "json"})
+	require.NoError(t, err)
+
+	var gasPriceResp types.QueryRecommendedGasPriceResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &gasPriceResp))
+	assert.Equal(t, testNetwork.Config.BondDenom, gasPriceResp.Med.Denom)
+	assert.True(t, gasPriceResp.Low.Amount.LTE(gasPriceResp.Med.Amount))
+	assert.True(t, gasPriceResp.Med.Amount.LTE(gasPriceResp.High.Amount))
+}
+
+func TestMinGasPriceHistory(t *testing.T) {
+	testNetwork := network.New(t)
+	require.NoError(t, testNetwork.WaitForNextBlock())
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{
+		"min-gas-price-history", "--" + cli.FromHeightFlag, "1", "--output", "json",
+	})
+	require.NoError(t, err)
+
+	var resp types.QueryMinGasPriceHistoryResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	require.NotEmpty(t, resp.Entries)
+	assert.EqualValues(t, 1, resp.Entries[0].Height)
+	assert.Equal(t, testNetwork.Config.BondDenom, resp.Entries[0].MinGasPrice.Denom)
+}
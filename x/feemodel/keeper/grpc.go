@@ -14,8 +14,19 @@ import (
 type QueryKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
+	GetShortEMAGas(ctx sdk.Context) int64
+	GetLongEMAGas(ctx sdk.Context) int64
+	TrackedGas(ctx sdk.Context) int64
+	GetMinGasPriceHistory(ctx sdk.Context, fromHeight, toHeight int64, limit int) []types.MinGasPriceHistoryEntry
 }
 
+const (
+	// maxRecommendedGasPriceAfterBlocks is the max number of blocks the recommended gas price might be projected for.
+	maxRecommendedGasPriceAfterBlocks = 1000
+	// maxMinGasPriceHistoryEntries is the max number of the min gas price history entries returned by single query.
+	maxMinGasPriceHistoryEntries = 1000
+)
+
 // NewQueryService creates query service.
 func NewQueryService(keeper QueryKeeper) QueryService {
 	return QueryService{
@@ -49,3 +60,88 @@ func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest
 		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
 	}, nil
 }
+
+// FeeModelState returns the current state of the fee model.
+func (qs QueryService) FeeModelState(
+	ctx context.Context,
+	req *types.QueryFeeModelStateRequest,
+) (*types.QueryFeeModelStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	model := types.NewModel(qs.keeper.GetParams(sdkCtx).Model)
+	return &types.QueryFeeModelStateResponse{
+		ShortEmaGas:             qs.keeper.GetShortEMAGas(sdkCtx),
+		LongEmaGas:              qs.keeper.GetLongEMAGas(sdkCtx),
+		CurrentBlockGas:         qs.keeper.TrackedGas(sdkCtx),
+		EscalationStartBlockGas: model.CalculateEscalationStartBlockGas(),
+	}, nil
+}
+
+// RecommendedGasPrice returns the range of the min gas prices projected by the fee model over the next blocks.
+func (qs QueryService) RecommendedGasPrice(
+	ctx context.Context,
+	req *types.QueryRecommendedGasPriceRequest,
+) (*types.QueryRecommendedGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.AfterBlocks == 0 || req.AfterBlocks > maxRecommendedGasPriceAfterBlocks {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "after blocks must be between 1 and %d", maxRecommendedGasPriceAfterBlocks,
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	model := types.NewModel(qs.keeper.GetParams(sdkCtx).Model)
+	minGasPrice := qs.keeper.GetMinGasPrice(sdkCtx)
+	low, medium, high := model.CalculateGasPriceRange(
+		minGasPrice.Amount,
+		qs.keeper.GetShortEMAGas(sdkCtx),
+		qs.keeper.GetLongEMAGas(sdkCtx),
+		req.AfterBlocks,
+	)
+
+	return &types.QueryRecommendedGasPriceResponse{
+		Low:  sdk.NewDecCoinFromDec(minGasPrice.Denom, low),
+		Med:  sdk.NewDecCoinFromDec(minGasPrice.Denom, medium),
+		High: sdk.NewDecCoinFromDec(minGasPrice.Denom, high),
+	}, nil
+}
+
+// MinGasPriceHistory returns the min gas prices and EMA values recorded for the range of the recent blocks.
+func (qs QueryService) MinGasPriceHistory(
+	ctx context.Context,
+	req *types.QueryMinGasPriceHistoryRequest,
+) (*types.QueryMinGasPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights must not be negative")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	toHeight := req.ToHeight
+	if toHeight == 0 {
+		toHeight = sdkCtx.BlockHeight()
+	}
+	if req.FromHeight > toHeight {
+		return nil, status.Error(codes.InvalidArgument, "from height must not be greater than to height")
+	}
+
+	// one more entry is read to find out if the range is truncated
+	entries := qs.keeper.GetMinGasPriceHistory(sdkCtx, req.FromHeight, toHeight, maxMinGasPriceHistoryEntries+1)
+	var nextHeight int64
+	if len(entries) > maxMinGasPriceHistoryEntries {
+		nextHeight = entries[maxMinGasPriceHistoryEntries].Height
+		entries = entries[:maxMinGasPriceHistoryEntries]
+	}
+
+	return &types.QueryMinGasPriceHistoryResponse{
+		Entries:    entries,
+		NextHeight: nextHeight,
+	}, nil
+}
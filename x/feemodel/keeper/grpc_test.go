@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/keeper"
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+func TestQueryFeeModelState(t *testing.T) {
+	ctx, k := setup()
+
+	params := types.DefaultParams()
+	k.SetParams(ctx, params)
+	k.SetShortEMAGas(ctx, 20)
+	k.SetLongEMAGas(ctx, 10)
+	k.TrackGas(ctx, 5)
+
+	qs := keeper.NewQueryService(k)
+	res, err := qs.FeeModelState(sdk.WrapSDKContext(ctx), &types.QueryFeeModelStateRequest{})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 20, res.ShortEmaGas)
+	assert.EqualValues(t, 10, res.LongEmaGas)
+	assert.EqualValues(t, 5, res.CurrentBlockGas)
+	assert.Equal(t, types.NewModel(params.Model).CalculateEscalationStartBlockGas(), res.EscalationStartBlockGas)
+}
+
+func TestQueryRecommendedGasPrice(t *testing.T) {
+	ctx, k := setup()
+
+	params := types.DefaultParams()
+	model := types.NewModel(params.Model)
+	blockGas := model.CalculateEscalationStartBlockGas() / 2
+	gasPrice := model.CalculateGasPriceWithMaxDiscount()
+
+	k.SetParams(ctx, params)
+	k.SetShortEMAGas(ctx, blockGas)
+	k.SetLongEMAGas(ctx, blockGas)
+	k.SetMinGasPrice(ctx, sdk.NewDecCoinFromDec("coin", gasPrice))
+
+	qs := keeper.NewQueryService(k)
+	res, err := qs.RecommendedGasPrice(sdk.WrapSDKContext(ctx), &types.QueryRecommendedGasPriceRequest{
+		AfterBlocks: 10,
+	})
+	require.NoError(t, err)
+
+	low, medium, high := model.CalculateGasPriceRange(gasPrice, blockGas, blockGas, 10)
+	assert.Equal(t, sdk.NewDecCoinFromDec("coin", low), res.Low)
+	assert.Equal(t, sdk.NewDecCoinFromDec("coin", medium), res.Med)
+	assert.Equal(t, sdk.NewDecCoinFromDec("coin", high), res.High)
+	assert.True(t, res.Low.Amount.LTE(res.Med.Amount))
+	assert.True(t, res.Med.Amount.LTE(res.High.Amount))
+
+	// invalid number of blocks
+	_, err = qs.RecommendedGasPrice(sdk.WrapSDKContext(ctx), &types.QueryRecommendedGasPriceRequest{})
+	require.Error(t, err)
+	_, err = qs.RecommendedGasPrice(sdk.WrapSDKContext(ctx), &types.QueryRecommendedGasPriceRequest{
+		AfterBlocks: 1001,
+	})
+	require.Error(t, err)
+}
+
+func TestQueryMinGasPriceHistory(t *testing.T) {
+	ctx, k := setup()
+	ctx = ctx.WithBlockHeight(5)
+
+	for height := int64(1); height <= 5; height++ {
+		k.SetMinGasPriceHistoryEntry(ctx, types.MinGasPriceHistoryEntry{
+			Height:      height,
+			MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(height)),
+		})
+	}
+
+	qs := keeper.NewQueryService(k)
+	res, err := qs.MinGasPriceHistory(sdk.WrapSDKContext(ctx), &types.QueryMinGasPriceHistoryRequest{
+		FromHeight: 2,
+		ToHeight:   3,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	assert.EqualValues(t, 2, res.Entries[0].Height)
+	assert.EqualValues(t, 3, res.Entries[1].Height)
+	assert.Zero(t, res.NextHeight)
+
+	// the latest height is used if to height is not set
+	res, err = qs.MinGasPriceHistory(sdk.WrapSDKContext(ctx), &types.QueryMinGasPriceHistoryRequest{
+		FromHeight: 4,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	assert.EqualValues(t, 4, res.Entries[0].Height)
+	assert.EqualValues(t, 5, res.Entries[1].Height)
+
+	// the truncated range returns the next height
+	for height := int64(6); height <= 1200; height++ {
+		k.SetMinGasPriceHistoryEntry(ctx, types.MinGasPriceHistoryEntry{
+			Height:      height,
+			MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(height)),
+		})
+	}
+	ctx = ctx.WithBlockHeight(1200)
+	res, err = qs.MinGasPriceHistory(sdk.WrapSDKContext(ctx), &types.QueryMinGasPriceHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1000)
+	assert.EqualValues(t, 1000, res.Entries[999].Height)
+	assert.EqualValues(t, 1001, res.NextHeight)
+
+	res, err = qs.MinGasPriceHistory(sdk.WrapSDKContext(ctx), &types.QueryMinGasPriceHistoryRequest{
+		FromHeight: res.NextHeight,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 200)
+	assert.EqualValues(t, 1001, res.Entries[0].Height)
+	assert.Zero(t, res.NextHeight)
+
+	// invalid ranges
+	_, err = qs.MinGasPriceHistory(sdk.WrapSDKContext(ctx), &types.QueryMinGasPriceHistoryRequest{
+		FromHeight: 4,
+		ToHeight:   3,
+	})
+	require.Error(t, err)
+	_, err = qs.MinGasPriceHistory(sdk.WrapSDKContext(ctx), &types.QueryMinGasPriceHistoryRequest{
+		FromHeight: -1,
+	})
+	require.Error(t, err)
+}
@@ -10,6 +10,7 @@ import (
 // ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet)
 	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 }
 
@@ -71,6 +72,13 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return params
 }
 
+// GetParamsIfExists gets the parameters of the model, skipping the ones which are not stored.
+func (k Keeper) GetParamsIfExists(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSubspace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
 // GetShortEMAGas retrieves average gas used by previous blocks, used as a representation of smoothed gas used by latest block.
 func (k Keeper) GetShortEMAGas(ctx sdk.Context) int64 {
 	store := ctx.KVStore(k.storeKey)
@@ -151,3 +159,62 @@ func (k Keeper) SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) {
 	}
 	store.Set(gasPriceKey, bz)
 }
+
+// SetMinGasPriceHistoryEntry stores the state of the fee model recorded at the end of the block.
+func (k Keeper) SetMinGasPriceHistoryEntry(ctx sdk.Context, entry types.MinGasPriceHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := entry.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(createMinGasPriceHistoryKey(entry.Height), bz)
+}
+
+// GetMinGasPriceHistory returns up to limit history entries recorded for the heights between fromHeight and toHeight
+// inclusive, ordered by height.
+func (k Keeper) GetMinGasPriceHistory(
+	ctx sdk.Context,
+	fromHeight, toHeight int64,
+	limit int,
+) []types.MinGasPriceHistoryEntry {
+	if fromHeight < 0 {
+		fromHeight = 0
+	}
+	if toHeight < fromHeight {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(createMinGasPriceHistoryKey(fromHeight), createMinGasPriceHistoryKey(toHeight+1))
+	defer iterator.Close()
+
+	var entries []types.MinGasPriceHistoryEntry
+	for ; iterator.Valid() && len(entries) < limit; iterator.Next() {
+		var entry types.MinGasPriceHistoryEntry
+		if err := entry.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// PruneMinGasPriceHistory deletes the history entries recorded for the heights lower than retainFromHeight.
+// At most types.MaxPrunedHistoryEntries entries are deleted, the remaining ones are deleted in the next blocks.
+func (k Keeper) PruneMinGasPriceHistory(ctx sdk.Context, retainFromHeight int64) {
+	if retainFromHeight <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(createMinGasPriceHistoryKey(0), createMinGasPriceHistoryKey(retainFromHeight))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < types.MaxPrunedHistoryEntries; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
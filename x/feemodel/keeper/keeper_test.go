@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -33,6 +34,14 @@ func (psm *paramSubspaceMock) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSe
 	}
 }
 
+func (psm *paramSubspaceMock) GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet) {
+	for _, pair := range ps.ParamSetPairs() {
+		if bz, exists := psm.params[string(pair.Key)]; exists {
+			must.OK(json.Unmarshal(bz, pair.Value))
+		}
+	}
+}
+
 func (psm *paramSubspaceMock) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
 		psm.params[string(pair.Key)] = must.Bytes(json.Marshal(pair.Value))
@@ -111,4 +120,64 @@ func TestParams(t *testing.T) {
 	assert.Equal(t, defParams.Model.MaxBlockGas, params.Model.MaxBlockGas)
 	assert.Equal(t, defParams.Model.ShortEmaBlockLength, params.Model.ShortEmaBlockLength)
 	assert.Equal(t, defParams.Model.LongEmaBlockLength, params.Model.LongEmaBlockLength)
+	assert.Equal(t, defParams.HistoryRetentionBlocks, params.HistoryRetentionBlocks)
+}
+
+func TestMinGasPriceHistory(t *testing.T) {
+	ctx, keeper := setup()
+
+	for height := int64(1); height <= 5; height++ {
+		keeper.SetMinGasPriceHistoryEntry(ctx, types.MinGasPriceHistoryEntry{
+			Height:      height,
+			MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(height)),
+			ShortEmaGas: height * 10,
+			LongEmaGas:  height * 100,
+		})
+	}
+
+	history := keeper.GetMinGasPriceHistory(ctx, 2, 4, 10)
+	require.Len(t, history, 3)
+	for i, entry := range history {
+		height := int64(i + 2)
+		assert.Equal(t, height, entry.Height)
+		assert.Equal(t, sdk.NewDecCoin("coin", sdk.NewInt(height)), entry.MinGasPrice)
+		assert.Equal(t, height*10, entry.ShortEmaGas)
+		assert.Equal(t, height*100, entry.LongEmaGas)
+	}
+
+	// limit
+	history = keeper.GetMinGasPriceHistory(ctx, 0, 5, 2)
+	require.Len(t, history, 2)
+	assert.EqualValues(t, 1, history[0].Height)
+	assert.EqualValues(t, 2, history[1].Height)
+
+	// empty range
+	assert.Empty(t, keeper.GetMinGasPriceHistory(ctx, 4, 3, 10))
+
+	keeper.PruneMinGasPriceHistory(ctx, 4)
+	history = keeper.GetMinGasPriceHistory(ctx, 0, 5, 10)
+	require.Len(t, history, 2)
+	assert.EqualValues(t, 4, history[0].Height)
+	assert.EqualValues(t, 5, history[1].Height)
+}
+
+func TestMinGasPriceHistoryBoundedPruning(t *testing.T) {
+	ctx, keeper := setup()
+
+	entries := types.MaxPrunedHistoryEntries + 10
+	for height := int64(1); height <= int64(entries); height++ {
+		keeper.SetMinGasPriceHistoryEntry(ctx, types.MinGasPriceHistoryEntry{
+			Height:      height,
+			MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(height)),
+		})
+	}
+
+	// the first call deletes the max number of entries only, the rest is deleted by the next one
+	keeper.PruneMinGasPriceHistory(ctx, int64(entries)+1)
+	history := keeper.GetMinGasPriceHistory(ctx, 0, int64(entries), entries)
+	require.Len(t, history, 10)
+	assert.EqualValues(t, types.MaxPrunedHistoryEntries+1, history[0].Height)
+
+	keeper.PruneMinGasPriceHistory(ctx, int64(entries)+1)
+	assert.Empty(t, keeper.GetMinGasPriceHistory(ctx, 0, int64(entries), entries))
 }
@@ -1,8 +1,16 @@
 package keeper
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 var (
-	gasTrackingKey = []byte{0x00}
-	gasPriceKey    = []byte{0x01}
-	shortEMAGasKey = []byte{0x02}
-	longEMAGasKey  = []byte{0x03}
+	gasTrackingKey              = []byte{0x00}
+	gasPriceKey                 = []byte{0x01}
+	shortEMAGasKey              = []byte{0x02}
+	longEMAGasKey               = []byte{0x03}
+	minGasPriceHistoryKeyPrefix = []byte{0x04}
 )
+
+// createMinGasPriceHistoryKey creates the key of the min gas price history entry recorded for the height.
+func createMinGasPriceHistoryKey(height int64) []byte {
+	return append(append([]byte{}, minGasPriceHistoryKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+// MigrationKeeper defines subscope of keeper methods required by migrator.
+type MigrationKeeper interface {
+	GetParamsIfExists(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper MigrationKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper MigrationKeeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It sets the default retention of the min gas price history.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParamsIfExists(ctx)
+	params.HistoryRetentionBlocks = types.DefaultHistoryRetentionBlocks
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/keeper"
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+func TestMigrator_Migrate1to2(t *testing.T) {
+	requireT := require.New(t)
+
+	ctx, feeModelKeeper := setup()
+
+	// store only the model params to simulate the params stored before the history was introduced
+	params := types.DefaultParams()
+	params.HistoryRetentionBlocks = 0
+	feeModelKeeper.SetParams(ctx, params)
+
+	requireT.NoError(keeper.NewMigrator(feeModelKeeper).Migrate1to2(ctx))
+
+	migratedParams := feeModelKeeper.GetParams(ctx)
+	requireT.Equal(params.Model.MaxBlockGas, migratedParams.Model.MaxBlockGas)
+	requireT.EqualValues(types.DefaultHistoryRetentionBlocks, migratedParams.HistoryRetentionBlocks)
+}
@@ -34,12 +34,16 @@ type Keeper interface {
 	TrackedGas(ctx sdk.Context) int64
 	SetParams(ctx sdk.Context, params types.Params)
 	GetParams(ctx sdk.Context) types.Params
+	GetParamsIfExists(ctx sdk.Context) types.Params
 	GetShortEMAGas(ctx sdk.Context) int64
 	SetShortEMAGas(ctx sdk.Context, emaGas int64)
 	GetLongEMAGas(ctx sdk.Context) int64
 	SetLongEMAGas(ctx sdk.Context, emaGas int64)
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
 	SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin)
+	SetMinGasPriceHistoryEntry(ctx sdk.Context, entry types.MinGasPriceHistoryEntry)
+	GetMinGasPriceHistory(ctx sdk.Context, fromHeight, toHeight int64, limit int) []types.MinGasPriceHistoryEntry
+	PruneMinGasPriceHistory(ctx sdk.Context, retainFromHeight int64)
 }
 
 // AppModuleBasic defines the basic application module used by the fee module.
@@ -98,6 +102,11 @@ type AppModule struct {
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryService(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
 
 // NewAppModule creates a new AppModule object.
@@ -145,7 +154,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock performs a no-op.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
@@ -169,6 +178,15 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	am.keeper.SetShortEMAGas(ctx, newShortEMA)
 	am.keeper.SetLongEMAGas(ctx, newLongEMA)
 	am.keeper.SetMinGasPrice(ctx, sdk.NewDecCoinFromDec(previousMinGasPrice.Denom, newMinGasPrice))
+	if params.HistoryRetentionBlocks > 0 {
+		am.keeper.SetMinGasPriceHistoryEntry(ctx, types.MinGasPriceHistoryEntry{
+			Height:      ctx.BlockHeight(),
+			MinGasPrice: sdk.NewDecCoinFromDec(previousMinGasPrice.Denom, newMinGasPrice),
+			ShortEmaGas: newShortEMA,
+			LongEmaGas:  newLongEMA,
+		})
+	}
+	am.keeper.PruneMinGasPriceHistory(ctx, ctx.BlockHeight()-int64(params.HistoryRetentionBlocks)+1)
 	metrics.SetGauge([]string{"min_gas_price"}, float32(newMinGasPrice.MustFloat64()))
 
 	return []abci.ValidatorUpdate{}
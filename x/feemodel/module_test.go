@@ -17,12 +17,14 @@ import (
 
 func newKeeperMock(genesisState types.GenesisState) *keeperMock {
 	return &keeperMock{
-		state: genesisState,
+		state:   genesisState,
+		history: map[int64]types.MinGasPriceHistoryEntry{},
 	}
 }
 
 type keeperMock struct {
-	state types.GenesisState
+	state   types.GenesisState
+	history map[int64]types.MinGasPriceHistoryEntry
 }
 
 func (k *keeperMock) TrackedGas(ctx sdk.Context) int64 {
@@ -37,6 +39,10 @@ func (k *keeperMock) GetParams(ctx sdk.Context) types.Params {
 	return k.state.Params
 }
 
+func (k *keeperMock) GetParamsIfExists(ctx sdk.Context) types.Params {
+	return k.state.Params
+}
+
 func (k *keeperMock) GetShortEMAGas(ctx sdk.Context) int64 {
 	return 0
 }
@@ -57,6 +63,32 @@ func (k *keeperMock) SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) {
 	k.state.MinGasPrice = minGasPrice
 }
 
+func (k *keeperMock) SetMinGasPriceHistoryEntry(ctx sdk.Context, entry types.MinGasPriceHistoryEntry) {
+	k.history[entry.Height] = entry
+}
+
+func (k *keeperMock) GetMinGasPriceHistory(
+	ctx sdk.Context,
+	fromHeight, toHeight int64,
+	limit int,
+) []types.MinGasPriceHistoryEntry {
+	var entries []types.MinGasPriceHistoryEntry
+	for height := fromHeight; height <= toHeight && len(entries) < limit; height++ {
+		if entry, exists := k.history[height]; exists {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func (k *keeperMock) PruneMinGasPriceHistory(ctx sdk.Context, retainFromHeight int64) {
+	for height := range k.history {
+		if height < retainFromHeight {
+			delete(k.history, height)
+		}
+	}
+}
+
 func setup() (feemodel.AppModule, feemodel.Keeper, types.GenesisState, codec.Codec) {
 	genesisState := types.GenesisState{
 		Params: types.Params{
@@ -69,6 +101,7 @@ func setup() (feemodel.AppModule, feemodel.Keeper, types.GenesisState, codec.Cod
 				ShortEmaBlockLength:     1,
 				LongEmaBlockLength:      3,
 			},
+			HistoryRetentionBlocks: 2,
 		},
 		MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(155)),
 	}
@@ -90,6 +123,7 @@ func TestInitGenesis(t *testing.T) {
 	genesisState.Params.Model.MaxBlockGas++
 	genesisState.Params.Model.ShortEmaBlockLength++
 	genesisState.Params.Model.LongEmaBlockLength++
+	genesisState.Params.HistoryRetentionBlocks++
 	genesisState.MinGasPrice.Denom = "coin2"
 	genesisState.MinGasPrice.Amount.Add(sdk.OneDec())
 
@@ -104,6 +138,7 @@ func TestInitGenesis(t *testing.T) {
 	assert.Equal(t, genesisState.Params.Model.MaxBlockGas, params.Model.MaxBlockGas)
 	assert.Equal(t, genesisState.Params.Model.ShortEmaBlockLength, params.Model.ShortEmaBlockLength)
 	assert.Equal(t, genesisState.Params.Model.LongEmaBlockLength, params.Model.LongEmaBlockLength)
+	assert.Equal(t, genesisState.Params.HistoryRetentionBlocks, params.HistoryRetentionBlocks)
 	assert.Equal(t, genesisState.MinGasPrice.Denom, minGasPrice.Denom)
 	assert.True(t, genesisState.MinGasPrice.Amount.Equal(minGasPrice.Amount))
 }
@@ -127,3 +162,19 @@ func TestEndBlock(t *testing.T) {
 	assert.True(t, minGasPrice.Amount.Equal(model.CalculateGasPriceWithMaxDiscount()))
 	assert.Equal(t, minGasPrice.Denom, state.MinGasPrice.Denom)
 }
+
+func TestEndBlockMinGasPriceHistory(t *testing.T) {
+	module, keeper, state, _ := setup()
+
+	for height := int64(1); height <= 3; height++ {
+		module.EndBlock(sdk.Context{}.WithBlockHeight(height), abci.RequestEndBlock{})
+	}
+
+	// only the entries of the last two blocks are retained
+	history := keeper.GetMinGasPriceHistory(sdk.Context{}, 0, 3, 10)
+	require.Len(t, history, 2)
+	assert.EqualValues(t, 2, history[0].Height)
+	assert.EqualValues(t, 3, history[1].Height)
+	assert.Equal(t, keeper.GetMinGasPrice(sdk.Context{}), history[1].MinGasPrice)
+	assert.Equal(t, state.MinGasPrice.Denom, history[1].MinGasPrice.Denom)
+}
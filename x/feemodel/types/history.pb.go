@@ -0,0 +1,437 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/feemodel/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MinGasPriceHistoryEntry is the state of the fee model recorded at the end of the block.
+type MinGasPriceHistoryEntry struct {
+	// height is the height of the block the entry was recorded for.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// min_gas_price is the minimum gas price computed at the end of the block.
+	MinGasPrice types.DecCoin `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+	// short_ema_gas is the short exponential moving average of the block gas computed at the end of the block.
+	ShortEmaGas int64 `protobuf:"varint,3,opt,name=short_ema_gas,json=shortEmaGas,proto3" json:"short_ema_gas,omitempty"`
+	// long_ema_gas is the long exponential moving average of the block gas computed at the end of the block.
+	LongEmaGas int64 `protobuf:"varint,4,opt,name=long_ema_gas,json=longEmaGas,proto3" json:"long_ema_gas,omitempty"`
+}
+
+func (m *MinGasPriceHistoryEntry) Reset()         { *m = MinGasPriceHistoryEntry{} }
+func (m *MinGasPriceHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*MinGasPriceHistoryEntry) ProtoMessage()    {}
+func (*MinGasPriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff14b79ad4bf116c, []int{0}
+}
+func (m *MinGasPriceHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPriceHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPriceHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinGasPriceHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPriceHistoryEntry.Merge(m, src)
+}
+func (m *MinGasPriceHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPriceHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPriceHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinGasPriceHistoryEntry proto.InternalMessageInfo
+
+func (m *MinGasPriceHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MinGasPriceHistoryEntry) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *MinGasPriceHistoryEntry) GetShortEmaGas() int64 {
+	if m != nil {
+		return m.ShortEmaGas
+	}
+	return 0
+}
+
+func (m *MinGasPriceHistoryEntry) GetLongEmaGas() int64 {
+	if m != nil {
+		return m.LongEmaGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MinGasPriceHistoryEntry)(nil), "coreum.feemodel.v1.MinGasPriceHistoryEntry")
+}
+
+func init() { proto.RegisterFile("coreum/feemodel/v1/history.proto", fileDescriptor_ff14b79ad4bf116c) }
+
+var fileDescriptor_ff14b79ad4bf116c = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0x02, 0x31,
+	0x18, 0x80, 0xaf, 0x42, 0x18, 0x8a, 0x2c, 0x17, 0xa3, 0x84, 0x98, 0x7a, 0x61, 0x62, 0x6a, 0x73,
+	0xf2, 0x06, 0x20, 0xe0, 0x42, 0x62, 0x18, 0x5d, 0x48, 0xef, 0xa8, 0x77, 0x4d, 0x68, 0x7f, 0xd2,
+	0x16, 0x22, 0x6f, 0xe1, 0x1b, 0xb9, 0x32, 0x32, 0x3a, 0x19, 0x03, 0x2f, 0x62, 0xae, 0x3d, 0x65,
+	0x6b, 0xff, 0xff, 0x6b, 0xbf, 0xe4, 0xc3, 0x49, 0x0e, 0x46, 0x6c, 0x15, 0x7b, 0x13, 0x42, 0xc1,
+	0x4a, 0xac, 0xd9, 0x2e, 0x65, 0xa5, 0xb4, 0x0e, 0xcc, 0x9e, 0x6e, 0x0c, 0x38, 0x88, 0xe3, 0x40,
+	0xd0, 0x3f, 0x82, 0xee, 0xd2, 0xde, 0x4d, 0x01, 0x05, 0xf8, 0x35, 0xab, 0x4e, 0x81, 0xec, 0x91,
+	0x1c, 0xac, 0x02, 0xcb, 0x32, 0x6e, 0x05, 0xdb, 0xa5, 0x99, 0x70, 0x3c, 0x65, 0x39, 0x48, 0x1d,
+	0xf6, 0xfd, 0x4f, 0x84, 0xef, 0xe6, 0x52, 0xcf, 0xb8, 0x7d, 0x31, 0x32, 0x17, 0xcf, 0x41, 0x33,
+	0xd1, 0xce, 0xec, 0xe3, 0x5b, 0xdc, 0x2a, 0x85, 0x2c, 0x4a, 0xd7, 0x45, 0x09, 0x1a, 0x34, 0x16,
+	0xf5, 0x2d, 0x9e, 0xe2, 0x8e, 0x92, 0x7a, 0x59, 0x70, 0xbb, 0xdc, 0x54, 0x8f, 0xba, 0x57, 0x09,
+	0x1a, 0xb4, 0x1f, 0xef, 0x69, 0x70, 0xd1, 0xca, 0x45, 0x6b, 0x17, 0x7d, 0x12, 0xf9, 0x18, 0xa4,
+	0x1e, 0x35, 0x0f, 0xdf, 0x0f, 0xd1, 0xa2, 0xad, 0x2e, 0xae, 0xb8, 0x8f, 0x3b, 0xb6, 0x04, 0xe3,
+	0x96, 0x42, 0xf1, 0xea, 0xb7, 0x6e, 0xc3, 0x6b, 0xda, 0x7e, 0x38, 0x51, 0x7c, 0xc6, 0x6d, 0x9c,
+	0xe0, 0xeb, 0x35, 0xe8, 0xe2, 0x1f, 0x69, 0x7a, 0x04, 0x57, 0xb3, 0x40, 0x8c, 0xe6, 0x87, 0x13,
+	0x41, 0xc7, 0x13, 0x41, 0x3f, 0x27, 0x82, 0x3e, 0xce, 0x24, 0x3a, 0x9e, 0x49, 0xf4, 0x75, 0x26,
+	0xd1, 0xeb, 0xb0, 0x90, 0xae, 0xdc, 0x66, 0x34, 0x07, 0xc5, 0xc6, 0x3e, 0xd8, 0x14, 0xb6, 0x7a,
+	0xc5, 0x9d, 0x04, 0xcd, 0xea, 0xc6, 0xef, 0x97, 0xca, 0x6e, 0xbf, 0x11, 0x36, 0x6b, 0xf9, 0x2e,
+	0xc3, 0xdf, 0x01, 0x00, 0x2b, 0xab, 0xe4, 0x04, 0x85, 0x01, 0x00, 0x00,
+}
+
+func (m *MinGasPriceHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinGasPriceHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinGasPriceHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LongEmaGas != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.LongEmaGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShortEmaGas != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ShortEmaGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MinGasPriceHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	if m.ShortEmaGas != 0 {
+		n += 1 + sovHistory(uint64(m.ShortEmaGas))
+	}
+	if m.LongEmaGas != 0 {
+		n += 1 + sovHistory(uint64(m.LongEmaGas))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MinGasPriceHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPriceHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortEmaGas", wireType)
+			}
+			m.ShortEmaGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortEmaGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongEmaGas", wireType)
+			}
+			m.LongEmaGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LongEmaGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
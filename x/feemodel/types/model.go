@@ -45,6 +45,44 @@ func (m Model) CalculateNextGasPrice(shortEMA, longEMA int64) sdk.Dec {
 	}
 }
 
+// CalculateGasPriceRange projects the min gas price over the next blocks starting from the current gas price and
+// the current EMA values. The projection is done for three scenarios: empty blocks, blocks using the gas equal
+// to the short EMA (current load continues) and full blocks. Low and high are the lowest and the highest prices
+// met in any scenario, medium is the price after the requested number of blocks if the current load continues.
+func (m Model) CalculateGasPriceRange(
+	currentGasPrice sdk.Dec,
+	shortEMA, longEMA int64,
+	afterBlocks uint32,
+) (low, medium, high sdk.Dec) {
+	emptyBlocks := m.projectGasPrices(shortEMA, longEMA, 0, afterBlocks)
+	currentLoad := m.projectGasPrices(shortEMA, longEMA, shortEMA, afterBlocks)
+	fullBlocks := m.projectGasPrices(shortEMA, longEMA, m.params.MaxBlockGas, afterBlocks)
+
+	low, medium, high = currentGasPrice, currentGasPrice, currentGasPrice
+	if len(currentLoad) > 0 {
+		medium = currentLoad[len(currentLoad)-1]
+	}
+	for _, gasPrices := range [][]sdk.Dec{emptyBlocks, currentLoad, fullBlocks} {
+		for _, gasPrice := range gasPrices {
+			low = sdk.MinDec(low, gasPrice)
+			high = sdk.MaxDec(high, gasPrice)
+		}
+	}
+
+	return low, medium, high
+}
+
+// projectGasPrices returns the min gas prices of the next blocks if each of them uses the provided block gas.
+func (m Model) projectGasPrices(shortEMA, longEMA, blockGas int64, blocks uint32) []sdk.Dec {
+	gasPrices := make([]sdk.Dec, 0, blocks)
+	for i := uint32(0); i < blocks; i++ {
+		shortEMA = CalculateEMA(shortEMA, blockGas, m.params.ShortEmaBlockLength)
+		longEMA = CalculateEMA(longEMA, blockGas, m.params.LongEmaBlockLength)
+		gasPrices = append(gasPrices, m.CalculateNextGasPrice(shortEMA, longEMA))
+	}
+	return gasPrices
+}
+
 // CalculateGasPriceWithMaxDiscount calculates gas price with maximum discount applied.
 func (m Model) CalculateGasPriceWithMaxDiscount() sdk.Dec {
 	return m.params.InitialGasPrice.Mul(sdk.OneDec().Sub(m.params.MaxDiscount))
@@ -99,6 +99,36 @@ func TestShapeInEscalationRegion(t *testing.T) {
 	}
 }
 
+func TestCalculateGasPriceRange(t *testing.T) {
+	// in the flat region the current gas price is the gas price with max discount
+	blockGas := feeModel.CalculateEscalationStartBlockGas() / 2
+
+	low, medium, high := feeModel.CalculateGasPriceRange(gasPriceWithMaxDiscount, blockGas, blockGas, 0)
+	assert.True(t, low.Equal(gasPriceWithMaxDiscount))
+	assert.True(t, medium.Equal(gasPriceWithMaxDiscount))
+	assert.True(t, high.Equal(gasPriceWithMaxDiscount))
+
+	low, medium, high = feeModel.CalculateGasPriceRange(gasPriceWithMaxDiscount, blockGas, blockGas, 10)
+	// gas price can't go below the gas price with max discount
+	assert.True(t, low.Equal(gasPriceWithMaxDiscount))
+	// the same load keeps the gas price unchanged
+	assert.True(t, medium.Equal(gasPriceWithMaxDiscount))
+	// full blocks escalate the gas price
+	assert.True(t, high.GT(gasPriceWithMaxDiscount))
+	assert.True(t, high.LTE(feeModel.CalculateMaxGasPrice()))
+
+	// more blocks widen the range
+	_, _, high2 := feeModel.CalculateGasPriceRange(gasPriceWithMaxDiscount, blockGas, blockGas, 100)
+	assert.True(t, high2.GT(high))
+
+	// in the escalation region the current load keeps the high gas price
+	blockGas = feeModel.params.MaxBlockGas
+	low, medium, high = feeModel.CalculateGasPriceRange(feeModel.CalculateMaxGasPrice(), blockGas, blockGas, 10)
+	assert.True(t, low.LT(feeModel.CalculateMaxGasPrice()))
+	assert.True(t, medium.Equal(feeModel.CalculateMaxGasPrice()))
+	assert.True(t, high.Equal(feeModel.CalculateMaxGasPrice()))
+}
+
 func TestWithRandomModels(t *testing.T) {
 	t.Parallel()
 
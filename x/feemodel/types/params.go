@@ -6,14 +6,29 @@ import (
 	"github.com/pkg/errors"
 )
 
-// KeyModel represents the Model param key with which the ModelParams will be stored.
-var KeyModel = []byte("Model")
+var (
+	// KeyModel represents the Model param key with which the ModelParams will be stored.
+	KeyModel = []byte("Model")
+	// KeyHistoryRetentionBlocks represents the history retention blocks param key.
+	KeyHistoryRetentionBlocks = []byte("HistoryRetentionBlocks")
+)
+
+const (
+	// DefaultHistoryRetentionBlocks is the default number of the latest blocks for which the min gas price history
+	// is kept.
+	DefaultHistoryRetentionBlocks = 10000
+	// MaxHistoryRetentionBlocks is the max number of the latest blocks for which the min gas price history is kept.
+	MaxHistoryRetentionBlocks = 100000
+	// MaxPrunedHistoryEntries is the max number of the min gas price history entries deleted in a single block.
+	MaxPrunedHistoryEntries = 100
+)
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // of model's parameters.
 func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyModel, &m.Model, validateModelParams),
+		paramtypes.NewParamSetPair(KeyHistoryRetentionBlocks, &m.HistoryRetentionBlocks, validateHistoryRetentionBlocks),
 	}
 }
 
@@ -29,12 +44,16 @@ func DefaultParams() Params {
 			ShortEmaBlockLength:     50,
 			LongEmaBlockLength:      1000,
 		},
+		HistoryRetentionBlocks: DefaultHistoryRetentionBlocks,
 	}
 }
 
 // ValidateBasic validates parameters of the model.
 func (m Params) ValidateBasic() error {
-	return validateModelParams(m.Model)
+	if err := validateModelParams(m.Model); err != nil {
+		return err
+	}
+	return validateHistoryRetentionBlocks(m.HistoryRetentionBlocks)
 }
 
 // ValidateBasic validates parameters of the model params.
@@ -93,3 +112,14 @@ func validateModelParams(i interface{}) error {
 
 	return nil
 }
+
+func validateHistoryRetentionBlocks(i interface{}) error {
+	blocks, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+	if blocks > MaxHistoryRetentionBlocks {
+		return errors.Errorf("history retention blocks must not be greater than %d", MaxHistoryRetentionBlocks)
+	}
+	return nil
+}
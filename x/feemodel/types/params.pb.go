@@ -111,6 +111,8 @@ func (m *ModelParams) GetLongEmaBlockLength() uint32 {
 type Params struct {
 	// model is a fee model params.
 	Model ModelParams `protobuf:"bytes,1,opt,name=model,proto3" json:"model" yaml:"model"`
+	// history_retention_blocks is the number of the latest blocks for which the min gas price history is kept. 0 disables the history, the max value is 100000.
+	HistoryRetentionBlocks uint32 `protobuf:"varint,2,opt,name=history_retention_blocks,json=historyRetentionBlocks,proto3" json:"history_retention_blocks,omitempty" yaml:"history_retention_blocks"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -153,6 +155,13 @@ func (m *Params) GetModel() ModelParams {
 	return ModelParams{}
 }
 
+func (m *Params) GetHistoryRetentionBlocks() uint32 {
+	if m != nil {
+		return m.HistoryRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ModelParams)(nil), "coreum.feemodel.v1.ModelParams")
 	proto.RegisterType((*Params)(nil), "coreum.feemodel.v1.Params")
@@ -161,40 +170,42 @@ func init() {
 func init() { proto.RegisterFile("coreum/feemodel/v1/params.proto", fileDescriptor_3500559e6fedefd6) }
 
 var fileDescriptor_3500559e6fedefd6 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x9b, 0xdf, 0x7e, 0x2d, 0xc2, 0xdd, 0x84, 0xf0, 0x3a, 0xc8, 0x26, 0x88, 0x8b, 0x0f,
-	0xa8, 0x17, 0x12, 0x8d, 0xdd, 0x10, 0xa7, 0xb0, 0x3f, 0x12, 0x50, 0x69, 0x64, 0x82, 0x03, 0x97,
-	0xc8, 0x4d, 0xbd, 0x34, 0x5a, 0x1c, 0x57, 0xb1, 0x5b, 0x75, 0x6f, 0x81, 0x03, 0xe2, 0x65, 0xed,
-	0xb8, 0x23, 0xe2, 0x10, 0xa1, 0xf6, 0x1d, 0xe4, 0xc4, 0x11, 0xd9, 0x4e, 0x97, 0xa1, 0x6e, 0x87,
-	0x9e, 0x92, 0xe7, 0x79, 0xbe, 0xfe, 0x7c, 0x6d, 0x3f, 0x7e, 0x00, 0x8a, 0x78, 0x4e, 0x27, 0xcc,
-	0x3b, 0xa7, 0x94, 0xf1, 0x21, 0x4d, 0xbd, 0xe9, 0xbe, 0x37, 0x26, 0x39, 0x61, 0xc2, 0x1d, 0xe7,
-	0x5c, 0x72, 0x08, 0x8d, 0xc0, 0x5d, 0x0a, 0xdc, 0xe9, 0xfe, 0xde, 0x6e, 0xc4, 0x05, 0xe3, 0x22,
-	0xd4, 0x0a, 0xcf, 0x04, 0x46, 0xbe, 0xd7, 0x89, 0x79, 0xcc, 0x4d, 0x5e, 0xfd, 0x99, 0x2c, 0xfe,
-	0xd3, 0x04, 0xed, 0xbe, 0x5a, 0x7d, 0xaa, 0xd1, 0x70, 0x0a, 0x1e, 0x27, 0x59, 0x22, 0x13, 0x92,
-	0x86, 0x31, 0x51, 0x9c, 0x24, 0xa2, 0xb6, 0xd5, 0xb5, 0x7a, 0x0f, 0xfd, 0xf7, 0x57, 0x05, 0x6a,
-	0xfc, 0x2a, 0xd0, 0xcb, 0x38, 0x91, 0xa3, 0xc9, 0xc0, 0x8d, 0x38, 0xab, 0x1c, 0xaa, 0xcf, 0x2b,
-	0x31, 0xbc, 0xf0, 0xe4, 0xe5, 0x98, 0x0a, 0xf7, 0x90, 0x46, 0x65, 0x81, 0xec, 0x4b, 0xc2, 0xd2,
-	0x37, 0x78, 0x05, 0x88, 0x83, 0x47, 0x55, 0xee, 0x84, 0x88, 0x53, 0x95, 0x81, 0xdf, 0x2c, 0x60,
-	0x33, 0x32, 0xab, 0x35, 0x21, 0x9b, 0xa4, 0x32, 0x19, 0xa7, 0x09, 0xcd, 0xed, 0xff, 0xb4, 0xff,
-	0xa7, 0xb5, 0xfd, 0x91, 0xf1, 0xbf, 0x8f, 0x8b, 0x83, 0x1d, 0x46, 0x66, 0xcb, 0x2d, 0xf4, 0x6f,
-	0xf2, 0x70, 0x04, 0x36, 0xd5, 0x9a, 0x61, 0x22, 0x22, 0x3e, 0xc9, 0xa4, 0xbd, 0xa1, 0xfd, 0x8f,
-	0xd6, 0xf6, 0xdf, 0xae, 0xfd, 0x97, 0x2c, 0x1c, 0xb4, 0x19, 0x99, 0x1d, 0x56, 0x11, 0xfc, 0x6e,
-	0x81, 0x5d, 0x2a, 0x22, 0x92, 0x12, 0x99, 0xf0, 0x2c, 0x14, 0x92, 0xe4, 0x32, 0x3c, 0xcf, 0x49,
-	0xa4, 0x42, 0xfb, 0x7f, 0xed, 0x1b, 0xac, 0xed, 0xdb, 0x35, 0xbe, 0xf7, 0x82, 0x71, 0xf0, 0xb4,
-	0xae, 0x9d, 0xa9, 0xd2, 0x71, 0x55, 0x81, 0x6f, 0xc1, 0x96, 0xda, 0xee, 0x20, 0xe5, 0xd1, 0x85,
-	0xba, 0x34, 0xbb, 0xd9, 0xb5, 0x7a, 0x1b, 0xbe, 0x5d, 0x16, 0xa8, 0x53, 0x9f, 0xe6, 0xa6, 0x6c,
-	0x8e, 0xe3, 0xab, 0xf0, 0x84, 0x08, 0xf8, 0x05, 0x3c, 0x11, 0x23, 0x9e, 0xcb, 0x90, 0x32, 0x52,
-	0x89, 0x52, 0x9a, 0xc5, 0x72, 0x64, 0xb7, 0xba, 0x56, 0x6f, 0xcb, 0x7f, 0x51, 0x16, 0xe8, 0xb9,
-	0xc1, 0xdc, 0xad, 0xc3, 0xc1, 0xb6, 0x2e, 0x1c, 0x31, 0xa2, 0xa1, 0x1f, 0x75, 0x16, 0x9e, 0x81,
-	0x9d, 0x94, 0x67, 0xf1, 0x2a, 0xf6, 0x81, 0xc6, 0x76, 0xcb, 0x02, 0x3d, 0x33, 0xd8, 0x3b, 0x65,
-	0x38, 0x80, 0x2a, 0xff, 0x2f, 0x14, 0x7f, 0x06, 0xad, 0xea, 0xd1, 0x7f, 0x00, 0x4d, 0x3d, 0x41,
-	0xfa, 0xa1, 0xb7, 0x5f, 0x23, 0x77, 0x75, 0xb2, 0xdc, 0x5b, 0x43, 0xe2, 0x77, 0x54, 0x47, 0xca,
-	0x02, 0x6d, 0x56, 0x37, 0xa2, 0x4a, 0x38, 0x30, 0x0c, 0xbf, 0x7f, 0x35, 0x77, 0xac, 0xeb, 0xb9,
-	0x63, 0xfd, 0x9e, 0x3b, 0xd6, 0x8f, 0x85, 0xd3, 0xb8, 0x5e, 0x38, 0x8d, 0x9f, 0x0b, 0xa7, 0xf1,
-	0xf5, 0xe0, 0x56, 0x03, 0xdf, 0x69, 0x87, 0x63, 0x3e, 0xc9, 0x86, 0xba, 0x0b, 0x5e, 0x35, 0xed,
-	0xb3, 0x7a, 0xde, 0x75, 0x47, 0x07, 0x2d, 0x3d, 0xa7, 0x07, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x2c, 0xde, 0x9b, 0xa2, 0x0f, 0x04, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x6d, 0x18, 0x2b, 0xc2, 0x5d, 0x85, 0xf0, 0xba, 0x91, 0x4d, 0x90, 0x14, 0x23, 0xa1, 0x5e,
+	0x48, 0x34, 0x76, 0x43, 0x9c, 0xc2, 0xfe, 0x48, 0x40, 0xa5, 0x91, 0x49, 0x1c, 0x90, 0x50, 0xe4,
+	0xa6, 0x5e, 0x1a, 0x2d, 0x8e, 0xab, 0xd8, 0xad, 0xda, 0xaf, 0xc0, 0x01, 0xf1, 0x61, 0xf8, 0x10,
+	0x3b, 0xee, 0x88, 0x38, 0x44, 0xa8, 0xfd, 0x06, 0x39, 0x71, 0x44, 0xb6, 0xd3, 0x65, 0xa8, 0xeb,
+	0xa1, 0xa7, 0xf6, 0xf7, 0xde, 0xcb, 0x7b, 0x3f, 0xdb, 0x3f, 0x1b, 0xd8, 0x21, 0xcb, 0xc8, 0x88,
+	0xba, 0x17, 0x84, 0x50, 0xd6, 0x27, 0x89, 0x3b, 0x3e, 0x70, 0x87, 0x38, 0xc3, 0x94, 0x3b, 0xc3,
+	0x8c, 0x09, 0x06, 0xa1, 0x16, 0x38, 0x0b, 0x81, 0x33, 0x3e, 0xd8, 0xdf, 0x0b, 0x19, 0xa7, 0x8c,
+	0x07, 0x4a, 0xe1, 0xea, 0x42, 0xcb, 0xf7, 0x5b, 0x11, 0x8b, 0x98, 0xc6, 0xe5, 0x3f, 0x8d, 0xa2,
+	0xbf, 0x9b, 0xa0, 0xd1, 0x95, 0x5f, 0x9f, 0x29, 0x6b, 0x38, 0x06, 0x8f, 0xe3, 0x34, 0x16, 0x31,
+	0x4e, 0x82, 0x08, 0x4b, 0x9f, 0x38, 0x24, 0xa6, 0xd1, 0x36, 0x3a, 0x0f, 0xbd, 0xf7, 0x57, 0xb9,
+	0x5d, 0xfb, 0x9d, 0xdb, 0x2f, 0xa3, 0x58, 0x0c, 0x46, 0x3d, 0x27, 0x64, 0xb4, 0x4c, 0x28, 0x7f,
+	0x5e, 0xf1, 0xfe, 0xa5, 0x2b, 0xa6, 0x43, 0xc2, 0x9d, 0x23, 0x12, 0x16, 0xb9, 0x6d, 0x4e, 0x31,
+	0x4d, 0xde, 0xa0, 0x25, 0x43, 0xe4, 0x3f, 0x2a, 0xb1, 0x53, 0xcc, 0xcf, 0x24, 0x02, 0xbf, 0x19,
+	0xc0, 0xa4, 0x78, 0x52, 0x69, 0x02, 0x3a, 0x4a, 0x44, 0x3c, 0x4c, 0x62, 0x92, 0x99, 0xf7, 0x54,
+	0xfe, 0xa7, 0xb5, 0xf3, 0x6d, 0x9d, 0xbf, 0xca, 0x17, 0xf9, 0x3b, 0x14, 0x4f, 0x16, 0x2d, 0x74,
+	0x6f, 0x70, 0x38, 0x00, 0x5b, 0xf2, 0x9b, 0x7e, 0xcc, 0x43, 0x36, 0x4a, 0x85, 0xb9, 0xa1, 0xf2,
+	0x8f, 0xd7, 0xce, 0xdf, 0xae, 0xf2, 0x17, 0x5e, 0xc8, 0x6f, 0x50, 0x3c, 0x39, 0x2a, 0x2b, 0xf8,
+	0xdd, 0x00, 0x7b, 0x84, 0x87, 0x38, 0xc1, 0x22, 0x66, 0x69, 0xc0, 0x05, 0xce, 0x44, 0x70, 0x91,
+	0xe1, 0x50, 0x96, 0xe6, 0x7d, 0x95, 0xeb, 0xaf, 0x9d, 0xdb, 0xd6, 0xb9, 0x2b, 0x8d, 0x91, 0xff,
+	0xa4, 0xe2, 0xce, 0x25, 0x75, 0x52, 0x32, 0xf0, 0x2d, 0x68, 0xca, 0x76, 0x7b, 0x09, 0x0b, 0x2f,
+	0xe5, 0xa6, 0x99, 0x9b, 0x6d, 0xa3, 0xb3, 0xe1, 0x99, 0x45, 0x6e, 0xb7, 0xaa, 0xd5, 0xdc, 0xd0,
+	0x7a, 0x39, 0x9e, 0x2c, 0x4f, 0x31, 0x87, 0x9f, 0xc1, 0x2e, 0x1f, 0xb0, 0x4c, 0x04, 0x84, 0xe2,
+	0x52, 0x94, 0x90, 0x34, 0x12, 0x03, 0xb3, 0xde, 0x36, 0x3a, 0x4d, 0xef, 0x79, 0x91, 0xdb, 0xcf,
+	0xb4, 0xcd, 0xdd, 0x3a, 0xe4, 0x6f, 0x2b, 0xe2, 0x98, 0x62, 0x65, 0xfa, 0x51, 0xa1, 0xf0, 0x1c,
+	0xec, 0x24, 0x2c, 0x8d, 0x96, 0x6d, 0x1f, 0x28, 0xdb, 0x76, 0x91, 0xdb, 0x4f, 0xb5, 0xed, 0x9d,
+	0x32, 0xe4, 0x43, 0x89, 0xff, 0x6f, 0x8a, 0x7e, 0x1a, 0xa0, 0x5e, 0x4e, 0xfd, 0x07, 0xb0, 0xa9,
+	0xae, 0x90, 0x9a, 0xf4, 0xc6, 0x6b, 0xdb, 0x59, 0xbe, 0x5a, 0xce, 0xad, 0x5b, 0xe2, 0xb5, 0xe4,
+	0x91, 0x14, 0xb9, 0xbd, 0x55, 0x6e, 0x89, 0xa4, 0x90, 0xaf, 0x3d, 0xe0, 0x57, 0x60, 0x0e, 0x62,
+	0x2e, 0x58, 0x36, 0x0d, 0x32, 0x22, 0x48, 0xaa, 0x0e, 0x40, 0xb5, 0xc3, 0xd5, 0x24, 0x37, 0xbd,
+	0x17, 0xd5, 0x6c, 0xae, 0x52, 0x22, 0x7f, 0xb7, 0xa4, 0xfc, 0x05, 0xa3, 0x7a, 0xe7, 0x5e, 0xf7,
+	0x6a, 0x66, 0x19, 0xd7, 0x33, 0xcb, 0xf8, 0x33, 0xb3, 0x8c, 0x1f, 0x73, 0xab, 0x76, 0x3d, 0xb7,
+	0x6a, 0xbf, 0xe6, 0x56, 0xed, 0xcb, 0xe1, 0xad, 0x01, 0x79, 0xa7, 0x16, 0x70, 0xc2, 0x46, 0x69,
+	0x5f, 0x9d, 0xb2, 0x5b, 0xbe, 0x26, 0x93, 0xea, 0x3d, 0x51, 0x13, 0xd3, 0xab, 0xab, 0x77, 0xe0,
+	0xf0, 0xdf, 0x00, 0x19, 0xec, 0xeb, 0x27, 0x6f, 0x04, 0x00, 0x00,
 }
 
 func (m *ModelParams) Marshal() (dAtA []byte, err error) {
@@ -295,6 +306,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoryRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Model.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -353,6 +369,9 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.Model.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.HistoryRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.HistoryRetentionBlocks))
+	}
 	return n
 }
 
@@ -667,6 +686,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetentionBlocks", wireType)
+			}
+			m.HistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetentionBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -53,4 +53,12 @@ func TestParamsValidation(t *testing.T) {
 	testParams = params
 	testParams.Model.EscalationStartFraction = sdk.OneDec()
 	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.HistoryRetentionBlocks = MaxHistoryRetentionBlocks
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.HistoryRetentionBlocks = MaxHistoryRetentionBlocks + 1
+	assert.Error(t, testParams.ValidateBasic())
 }
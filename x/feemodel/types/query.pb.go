@@ -195,42 +195,403 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryFeeModelStateRequest is the request type for the Query/FeeModelState RPC method.
+type QueryFeeModelStateRequest struct {
+}
+
+func (m *QueryFeeModelStateRequest) Reset()         { *m = QueryFeeModelStateRequest{} }
+func (m *QueryFeeModelStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeModelStateRequest) ProtoMessage()    {}
+func (*QueryFeeModelStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{4}
+}
+func (m *QueryFeeModelStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeModelStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeModelStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeModelStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeModelStateRequest.Merge(m, src)
+}
+func (m *QueryFeeModelStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeModelStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeModelStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeModelStateRequest proto.InternalMessageInfo
+
+// QueryFeeModelStateResponse is the response type for the Query/FeeModelState RPC method.
+type QueryFeeModelStateResponse struct {
+	// short_ema_gas is the short exponential moving average of the gas used by the previous blocks.
+	ShortEmaGas int64 `protobuf:"varint,1,opt,name=short_ema_gas,json=shortEmaGas,proto3" json:"short_ema_gas,omitempty"`
+	// long_ema_gas is the long exponential moving average of the gas used by the previous blocks.
+	LongEmaGas int64 `protobuf:"varint,2,opt,name=long_ema_gas,json=longEmaGas,proto3" json:"long_ema_gas,omitempty"`
+	// current_block_gas is the gas tracked so far in the current block.
+	CurrentBlockGas int64 `protobuf:"varint,3,opt,name=current_block_gas,json=currentBlockGas,proto3" json:"current_block_gas,omitempty"`
+	// escalation_start_block_gas is the block gas above which the gas price escalation starts.
+	EscalationStartBlockGas int64 `protobuf:"varint,4,opt,name=escalation_start_block_gas,json=escalationStartBlockGas,proto3" json:"escalation_start_block_gas,omitempty"`
+}
+
+func (m *QueryFeeModelStateResponse) Reset()         { *m = QueryFeeModelStateResponse{} }
+func (m *QueryFeeModelStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeModelStateResponse) ProtoMessage()    {}
+func (*QueryFeeModelStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{5}
+}
+func (m *QueryFeeModelStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeModelStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeModelStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeModelStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeModelStateResponse.Merge(m, src)
+}
+func (m *QueryFeeModelStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeModelStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeModelStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeModelStateResponse proto.InternalMessageInfo
+
+func (m *QueryFeeModelStateResponse) GetShortEmaGas() int64 {
+	if m != nil {
+		return m.ShortEmaGas
+	}
+	return 0
+}
+
+func (m *QueryFeeModelStateResponse) GetLongEmaGas() int64 {
+	if m != nil {
+		return m.LongEmaGas
+	}
+	return 0
+}
+
+func (m *QueryFeeModelStateResponse) GetCurrentBlockGas() int64 {
+	if m != nil {
+		return m.CurrentBlockGas
+	}
+	return 0
+}
+
+func (m *QueryFeeModelStateResponse) GetEscalationStartBlockGas() int64 {
+	if m != nil {
+		return m.EscalationStartBlockGas
+	}
+	return 0
+}
+
+// QueryRecommendedGasPriceRequest is the request type for the Query/RecommendedGasPrice RPC method.
+type QueryRecommendedGasPriceRequest struct {
+	// after_blocks is the number of the next blocks the gas price is projected for.
+	AfterBlocks uint32 `protobuf:"varint,1,opt,name=after_blocks,json=afterBlocks,proto3" json:"after_blocks,omitempty"`
+}
+
+func (m *QueryRecommendedGasPriceRequest) Reset()         { *m = QueryRecommendedGasPriceRequest{} }
+func (m *QueryRecommendedGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedGasPriceRequest) ProtoMessage()    {}
+func (*QueryRecommendedGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{6}
+}
+func (m *QueryRecommendedGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommendedGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommendedGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedGasPriceRequest.Merge(m, src)
+}
+func (m *QueryRecommendedGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommendedGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommendedGasPriceRequest proto.InternalMessageInfo
+
+func (m *QueryRecommendedGasPriceRequest) GetAfterBlocks() uint32 {
+	if m != nil {
+		return m.AfterBlocks
+	}
+	return 0
+}
+
+// QueryRecommendedGasPriceResponse is the response type for the Query/RecommendedGasPrice RPC method.
+type QueryRecommendedGasPriceResponse struct {
+	// low is the lowest gas price projected for the next blocks.
+	Low types.DecCoin `protobuf:"bytes,1,opt,name=low,proto3" json:"low"`
+	// med is the gas price projected after the requested number of blocks if the current load continues.
+	Med types.DecCoin `protobuf:"bytes,2,opt,name=med,proto3" json:"med"`
+	// high is the highest gas price projected for the next blocks.
+	High types.DecCoin `protobuf:"bytes,3,opt,name=high,proto3" json:"high"`
+}
+
+func (m *QueryRecommendedGasPriceResponse) Reset()         { *m = QueryRecommendedGasPriceResponse{} }
+func (m *QueryRecommendedGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedGasPriceResponse) ProtoMessage()    {}
+func (*QueryRecommendedGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{7}
+}
+func (m *QueryRecommendedGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommendedGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommendedGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedGasPriceResponse.Merge(m, src)
+}
+func (m *QueryRecommendedGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommendedGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommendedGasPriceResponse proto.InternalMessageInfo
+
+func (m *QueryRecommendedGasPriceResponse) GetLow() types.DecCoin {
+	if m != nil {
+		return m.Low
+	}
+	return types.DecCoin{}
+}
+
+func (m *QueryRecommendedGasPriceResponse) GetMed() types.DecCoin {
+	if m != nil {
+		return m.Med
+	}
+	return types.DecCoin{}
+}
+
+func (m *QueryRecommendedGasPriceResponse) GetHigh() types.DecCoin {
+	if m != nil {
+		return m.High
+	}
+	return types.DecCoin{}
+}
+
+// QueryMinGasPriceHistoryRequest is the request type for the Query/MinGasPriceHistory RPC method.
+type QueryMinGasPriceHistoryRequest struct {
+	// from_height is the first height of the range. 0 means the oldest recorded height.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last height of the range. 0 means the latest height.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryMinGasPriceHistoryRequest) Reset()         { *m = QueryMinGasPriceHistoryRequest{} }
+func (m *QueryMinGasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryMinGasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{8}
+}
+func (m *QueryMinGasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinGasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinGasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryMinGasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinGasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinGasPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryMinGasPriceHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryMinGasPriceHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryMinGasPriceHistoryResponse is the response type for the Query/MinGasPriceHistory RPC method.
+type QueryMinGasPriceHistoryResponse struct {
+	// entries are the history entries recorded for the requested range ordered by height. At most 1000 entries are returned.
+	Entries []MinGasPriceHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// next_height is the height to set as from_height to query the next entries of the range, 0 if all the entries of the
+	// range are returned.
+	NextHeight int64 `protobuf:"varint,2,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *QueryMinGasPriceHistoryResponse) Reset()         { *m = QueryMinGasPriceHistoryResponse{} }
+func (m *QueryMinGasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryMinGasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{9}
+}
+func (m *QueryMinGasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinGasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinGasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryMinGasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinGasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinGasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryMinGasPriceHistoryResponse) GetEntries() []MinGasPriceHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryMinGasPriceHistoryResponse) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryMinGasPriceRequest)(nil), "coreum.feemodel.v1.QueryMinGasPriceRequest")
 	proto.RegisterType((*QueryMinGasPriceResponse)(nil), "coreum.feemodel.v1.QueryMinGasPriceResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.feemodel.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.feemodel.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryFeeModelStateRequest)(nil), "coreum.feemodel.v1.QueryFeeModelStateRequest")
+	proto.RegisterType((*QueryFeeModelStateResponse)(nil), "coreum.feemodel.v1.QueryFeeModelStateResponse")
+	proto.RegisterType((*QueryRecommendedGasPriceRequest)(nil), "coreum.feemodel.v1.QueryRecommendedGasPriceRequest")
+	proto.RegisterType((*QueryRecommendedGasPriceResponse)(nil), "coreum.feemodel.v1.QueryRecommendedGasPriceResponse")
+	proto.RegisterType((*QueryMinGasPriceHistoryRequest)(nil), "coreum.feemodel.v1.QueryMinGasPriceHistoryRequest")
+	proto.RegisterType((*QueryMinGasPriceHistoryResponse)(nil), "coreum.feemodel.v1.QueryMinGasPriceHistoryResponse")
 }
 
 func init() { proto.RegisterFile("coreum/feemodel/v1/query.proto", fileDescriptor_d2036651e57006ae) }
 
 var fileDescriptor_d2036651e57006ae = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xce, 0xd2, 0x40,
-	0x14, 0xc5, 0x5b, 0xa2, 0x2c, 0x86, 0xb8, 0x19, 0x49, 0xc4, 0x86, 0x0c, 0x5a, 0x13, 0xff, 0x44,
-	0x33, 0x93, 0xc2, 0xc6, 0x35, 0x18, 0x5c, 0x11, 0x91, 0xa5, 0x1b, 0x32, 0x2d, 0x63, 0x9d, 0x84,
-	0xce, 0x2d, 0x9d, 0x96, 0xc8, 0xc2, 0x8d, 0x4f, 0x60, 0xc2, 0xd6, 0x07, 0x62, 0x49, 0xe2, 0xc6,
-	0x95, 0x31, 0xe0, 0x83, 0x98, 0x4e, 0x87, 0xf0, 0x11, 0x20, 0xdf, 0xb7, 0x6b, 0x7a, 0xee, 0x3d,
-	0xe7, 0xd7, 0xd3, 0x8b, 0x48, 0x04, 0x99, 0x28, 0x12, 0xf6, 0x59, 0x88, 0x04, 0x66, 0x62, 0xce,
-	0x96, 0x01, 0x5b, 0x14, 0x22, 0x5b, 0xd1, 0x34, 0x83, 0x1c, 0x30, 0xae, 0x74, 0x7a, 0xd0, 0xe9,
-	0x32, 0xf0, 0x9a, 0x31, 0xc4, 0x60, 0x64, 0x56, 0x3e, 0x55, 0x93, 0x5e, 0x3b, 0x06, 0x88, 0xe7,
-	0x82, 0xf1, 0x54, 0x32, 0xae, 0x14, 0xe4, 0x3c, 0x97, 0xa0, 0xb4, 0x55, 0x49, 0x04, 0x3a, 0x01,
-	0xcd, 0x42, 0xae, 0x05, 0x5b, 0x06, 0xa1, 0xc8, 0x79, 0xc0, 0x22, 0x90, 0xca, 0xea, 0x9d, 0x0b,
-	0x1c, 0x29, 0xcf, 0x78, 0x62, 0x0d, 0xfc, 0xc7, 0xe8, 0xd1, 0xc7, 0x92, 0x6b, 0x24, 0xd5, 0x7b,
-	0xae, 0xc7, 0x99, 0x8c, 0xc4, 0x44, 0x2c, 0x0a, 0xa1, 0x73, 0x3f, 0x44, 0xad, 0x73, 0x49, 0xa7,
-	0xa0, 0xb4, 0xc0, 0x43, 0xf4, 0x20, 0x91, 0x6a, 0x1a, 0x73, 0x3d, 0x4d, 0x4b, 0xa1, 0xe5, 0x3e,
-	0x71, 0x5f, 0x36, 0xba, 0x6d, 0x5a, 0xf1, 0xd0, 0x92, 0x87, 0x5a, 0x1e, 0xfa, 0x4e, 0x44, 0x03,
-	0x90, 0xaa, 0x7f, 0x6f, 0xf3, 0xa7, 0xe3, 0x4c, 0x1a, 0xc9, 0xd1, 0xcf, 0x6f, 0x22, 0x6c, 0x32,
-	0xc6, 0x86, 0xe9, 0x90, 0xfc, 0x01, 0x3d, 0x3c, 0x79, 0x6b, 0x43, 0xdf, 0xa2, 0x7a, 0xc5, 0x6e,
-	0xd3, 0x3c, 0x7a, 0xde, 0x22, 0xad, 0x76, 0x6c, 0x96, 0x9d, 0xef, 0xfe, 0xac, 0xa1, 0xfb, 0xc6,
-	0x11, 0xaf, 0x5d, 0xd4, 0xb8, 0xf1, 0x41, 0xf8, 0xf5, 0x25, 0x8f, 0x2b, 0x8d, 0x78, 0x6f, 0xee,
-	0x36, 0x5c, 0xe1, 0xfa, 0xaf, 0xbe, 0xff, 0xfa, 0xb7, 0xae, 0x3d, 0xc3, 0x4f, 0xd9, 0x85, 0x9f,
-	0x70, 0xd2, 0x1e, 0xfe, 0x86, 0xea, 0x15, 0x37, 0x7e, 0x7e, 0x35, 0xe2, 0xa4, 0x22, 0xef, 0xc5,
-	0xad, 0x73, 0x96, 0xc2, 0x37, 0x14, 0x6d, 0xec, 0xb1, 0xab, 0xa7, 0xd0, 0x1f, 0x6d, 0x76, 0xc4,
-	0xdd, 0xee, 0x88, 0xfb, 0x77, 0x47, 0xdc, 0x1f, 0x7b, 0xe2, 0x6c, 0xf7, 0xc4, 0xf9, 0xbd, 0x27,
-	0xce, 0xa7, 0x5e, 0x2c, 0xf3, 0x2f, 0x45, 0x48, 0x23, 0x48, 0xd8, 0xc0, 0xec, 0x0f, 0xa1, 0x50,
-	0x33, 0x73, 0x83, 0x07, 0xc3, 0xaf, 0x47, 0xcb, 0x7c, 0x95, 0x0a, 0x1d, 0xd6, 0xcd, 0x69, 0xf5,
-	0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x74, 0x76, 0xcd, 0xe0, 0x05, 0x03, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0x8e, 0x9b, 0x12, 0xe0, 0xe4, 0x46, 0x88, 0xb9, 0x57, 0xba, 0xa9, 0x5b, 0x39, 0xa9, 0x2b,
+	0xa0, 0xa5, 0x60, 0x2b, 0x49, 0x85, 0x90, 0xd8, 0xf5, 0x5f, 0x42, 0x15, 0x25, 0xdd, 0xb1, 0xc0,
+	0x9a, 0x38, 0x27, 0x8e, 0x45, 0xec, 0x49, 0x3d, 0x93, 0xd2, 0x2e, 0xd8, 0xf0, 0x02, 0x20, 0x75,
+	0xc9, 0x92, 0x17, 0x40, 0xe2, 0x1d, 0x50, 0x25, 0x36, 0x95, 0xd8, 0xb0, 0x42, 0xa8, 0xe5, 0x41,
+	0xd0, 0x8c, 0xc7, 0x4d, 0xd3, 0xd8, 0xba, 0xe9, 0x2e, 0x39, 0xe7, 0x3b, 0xdf, 0xf7, 0xcd, 0xcc,
+	0x39, 0xc7, 0x60, 0xf9, 0x2c, 0xc1, 0x49, 0xe4, 0x0e, 0x10, 0x23, 0xd6, 0xc7, 0x91, 0x7b, 0xd1,
+	0x72, 0xcf, 0x27, 0x98, 0x5c, 0x39, 0xe3, 0x84, 0x09, 0x46, 0x48, 0x9a, 0x77, 0xb2, 0xbc, 0x73,
+	0xd1, 0x32, 0x5f, 0x05, 0x2c, 0x60, 0x2a, 0xed, 0xca, 0x5f, 0x29, 0xd2, 0x5c, 0x0b, 0x18, 0x0b,
+	0x46, 0xe8, 0xd2, 0x71, 0xe8, 0xd2, 0x38, 0x66, 0x82, 0x8a, 0x90, 0xc5, 0x5c, 0x67, 0x2d, 0x9f,
+	0xf1, 0x88, 0x71, 0xb7, 0x47, 0x39, 0xba, 0x17, 0xad, 0x1e, 0x0a, 0xda, 0x72, 0x7d, 0x16, 0xc6,
+	0x3a, 0xdf, 0xcc, 0xf1, 0x31, 0x0c, 0xb9, 0x60, 0x99, 0x13, 0xb3, 0x91, 0x83, 0x18, 0xd3, 0x84,
+	0x46, 0x5a, 0xc2, 0x5e, 0x81, 0xd7, 0x5f, 0x4b, 0xe7, 0x27, 0x61, 0x7c, 0x44, 0xf9, 0x69, 0x12,
+	0xfa, 0xd8, 0xc5, 0xf3, 0x09, 0x72, 0x61, 0xf7, 0xa0, 0x3e, 0x9f, 0xe2, 0x63, 0x16, 0x73, 0x24,
+	0x87, 0x50, 0x8b, 0xc2, 0xd8, 0x0b, 0x28, 0xf7, 0xc6, 0x32, 0x51, 0x37, 0x9a, 0xc6, 0x66, 0xb5,
+	0xbd, 0xe6, 0xa4, 0x8e, 0x1d, 0xe9, 0xd8, 0xd1, 0x8e, 0x9d, 0x7d, 0xf4, 0xf7, 0x58, 0x18, 0xef,
+	0x2e, 0xdf, 0xfc, 0xd3, 0x28, 0x75, 0xab, 0xd1, 0x94, 0xcf, 0x7e, 0x05, 0x44, 0x69, 0x9c, 0x2a,
+	0x4f, 0x99, 0xf2, 0x57, 0xf0, 0x72, 0x26, 0xaa, 0x45, 0x3f, 0x87, 0x4a, 0xea, 0x5d, 0xab, 0x99,
+	0xce, 0xfc, 0x3d, 0x3b, 0x69, 0x8d, 0xd6, 0xd2, 0x78, 0x7b, 0x15, 0x56, 0x14, 0xe1, 0x21, 0xe2,
+	0x89, 0x04, 0x9e, 0x09, 0x2a, 0x1e, 0xce, 0xf9, 0xa7, 0x01, 0x66, 0x5e, 0x56, 0xab, 0xda, 0x50,
+	0xe3, 0x43, 0x96, 0x08, 0x0f, 0x23, 0x2a, 0x0f, 0xac, 0xc4, 0xcb, 0xdd, 0xaa, 0x0a, 0x1e, 0x44,
+	0xf4, 0x88, 0x72, 0xd2, 0x84, 0x17, 0x23, 0x16, 0x07, 0x0f, 0x90, 0x25, 0x05, 0x01, 0x19, 0xd3,
+	0x88, 0x8f, 0xe1, 0x7d, 0x7f, 0x92, 0x24, 0x18, 0x0b, 0xaf, 0x37, 0x62, 0xfe, 0x77, 0x0a, 0x56,
+	0x56, 0xb0, 0xf7, 0x74, 0x62, 0x57, 0xc6, 0x25, 0xf6, 0x0b, 0x30, 0x91, 0xfb, 0x74, 0xa4, 0x7a,
+	0xc1, 0xe3, 0x82, 0x26, 0x8f, 0x8b, 0x96, 0x55, 0xd1, 0xeb, 0x29, 0xe2, 0x4c, 0x02, 0xb2, 0x62,
+	0x7b, 0x1f, 0x1a, 0xea, 0x30, 0x5d, 0xf4, 0x59, 0x14, 0x61, 0xdc, 0xc7, 0xfe, 0x93, 0x87, 0x25,
+	0xeb, 0xf0, 0x82, 0x0e, 0x04, 0x26, 0x29, 0x69, 0x7a, 0xa0, 0x5a, 0xb7, 0xaa, 0x62, 0x8a, 0x87,
+	0xdb, 0x7f, 0x18, 0xd0, 0x2c, 0xa6, 0xd1, 0x37, 0xb3, 0x03, 0xe5, 0x11, 0xfb, 0xfe, 0x19, 0x4f,
+	0x2f, 0xe1, 0xb2, 0x2a, 0xc2, 0x7e, 0x7d, 0x69, 0xf1, 0xaa, 0x08, 0xfb, 0xe4, 0x33, 0x58, 0x1e,
+	0x86, 0xc1, 0xb0, 0x5e, 0x5e, 0xb8, 0x4c, 0xe1, 0xed, 0x6f, 0xc1, 0x7a, 0xda, 0xc4, 0xc7, 0xe9,
+	0x84, 0x64, 0xb7, 0xd1, 0x80, 0xea, 0x20, 0x61, 0x91, 0x37, 0xc4, 0x30, 0x18, 0x0a, 0xfd, 0xba,
+	0x20, 0x43, 0xc7, 0x2a, 0x42, 0x56, 0xe1, 0x5d, 0xc1, 0xb2, 0x74, 0xfa, 0xb2, 0xef, 0x08, 0x96,
+	0x26, 0xed, 0x9f, 0x0c, 0x68, 0x14, 0x0a, 0xe8, 0x7b, 0xfa, 0x12, 0xde, 0xc6, 0x58, 0x24, 0x21,
+	0xca, 0xab, 0x2e, 0x6f, 0x56, 0xdb, 0xdb, 0x79, 0x8d, 0x3b, 0x4f, 0x70, 0x10, 0x8b, 0xe4, 0x4a,
+	0x9f, 0x26, 0x63, 0x90, 0x76, 0x63, 0xbc, 0x14, 0xb3, 0x7e, 0x40, 0x86, 0x52, 0x47, 0xed, 0x5f,
+	0x2b, 0xf0, 0x96, 0x72, 0x44, 0xae, 0x0d, 0xa8, 0x3e, 0x62, 0x25, 0xb9, 0xb2, 0x05, 0xd3, 0x6f,
+	0x7e, 0xb2, 0x18, 0x38, 0x3d, 0xa2, 0xbd, 0xf5, 0xe3, 0x5f, 0xff, 0x5d, 0x2f, 0x6d, 0x90, 0x75,
+	0x37, 0x67, 0xe1, 0xcc, 0x6c, 0x0a, 0xf2, 0x03, 0x54, 0xd2, 0x19, 0x25, 0x1f, 0x16, 0x4a, 0xcc,
+	0xac, 0x03, 0xf3, 0xa3, 0x37, 0xe2, 0xb4, 0x0b, 0x5b, 0xb9, 0x58, 0x23, 0xa6, 0x5b, 0xb8, 0xf6,
+	0xc8, 0x2f, 0x06, 0xd4, 0x66, 0x06, 0x9d, 0x7c, 0x5a, 0x48, 0x9f, 0xb7, 0x2e, 0x4c, 0x67, 0x51,
+	0xb8, 0x36, 0xb5, 0xad, 0x4c, 0x7d, 0x40, 0x36, 0xf2, 0x4c, 0x0d, 0x10, 0x3d, 0xf5, 0x47, 0x8e,
+	0xb9, 0x40, 0xf2, 0xbb, 0x01, 0x2f, 0x73, 0x46, 0x8e, 0x74, 0x0a, 0x45, 0x8b, 0xe7, 0xdc, 0xdc,
+	0x79, 0x5e, 0x91, 0xf6, 0xdb, 0x52, 0x7e, 0xb7, 0xc9, 0x56, 0x9e, 0xdf, 0x64, 0x5a, 0xf8, 0xe8,
+	0x49, 0x7f, 0x33, 0x80, 0xcc, 0xb7, 0x2f, 0x69, 0x2f, 0xd2, 0x42, 0xb3, 0xd3, 0x68, 0x76, 0x9e,
+	0x55, 0xb3, 0x88, 0xe5, 0x99, 0xee, 0xf3, 0xf4, 0xe7, 0x71, 0xf7, 0xe4, 0xe6, 0xce, 0x32, 0x6e,
+	0xef, 0x2c, 0xe3, 0xdf, 0x3b, 0xcb, 0xf8, 0xf9, 0xde, 0x2a, 0xdd, 0xde, 0x5b, 0xa5, 0xbf, 0xef,
+	0xad, 0xd2, 0x37, 0x9d, 0x20, 0x14, 0xc3, 0x49, 0xcf, 0xf1, 0x59, 0xe4, 0xee, 0x29, 0xba, 0x43,
+	0x36, 0x89, 0xfb, 0x6a, 0xd5, 0x66, 0xfc, 0x97, 0x53, 0x05, 0x71, 0x35, 0x46, 0xde, 0xab, 0xa8,
+	0xaf, 0x69, 0xe7, 0xff, 0x01, 0x00, 0xc1, 0x7f, 0x49, 0x0d, 0x1a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -249,6 +610,12 @@ type QueryClient interface {
 	MinGasPrice(ctx context.Context, in *QueryMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMinGasPriceResponse, error)
 	// Params queries the parameters of x/feemodel module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeModelState queries the current state of the fee model.
+	FeeModelState(ctx context.Context, in *QueryFeeModelStateRequest, opts ...grpc.CallOption) (*QueryFeeModelStateResponse, error)
+	// RecommendedGasPrice queries the range of the min gas prices projected by the fee model over the next blocks.
+	RecommendedGasPrice(ctx context.Context, in *QueryRecommendedGasPriceRequest, opts ...grpc.CallOption) (*QueryRecommendedGasPriceResponse, error)
+	// MinGasPriceHistory queries the min gas prices and EMA values recorded for the range of the recent blocks.
+	MinGasPriceHistory(ctx context.Context, in *QueryMinGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryMinGasPriceHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -277,12 +644,45 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FeeModelState(ctx context.Context, in *QueryFeeModelStateRequest, opts ...grpc.CallOption) (*QueryFeeModelStateResponse, error) {
+	out := new(QueryFeeModelStateResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/FeeModelState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecommendedGasPrice(ctx context.Context, in *QueryRecommendedGasPriceRequest, opts ...grpc.CallOption) (*QueryRecommendedGasPriceResponse, error) {
+	out := new(QueryRecommendedGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/RecommendedGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinGasPriceHistory(ctx context.Context, in *QueryMinGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryMinGasPriceHistoryResponse, error) {
+	out := new(QueryMinGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/MinGasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// MinGasPrice queries the current minimum gas price required by the network.
 	MinGasPrice(context.Context, *QueryMinGasPriceRequest) (*QueryMinGasPriceResponse, error)
 	// Params queries the parameters of x/feemodel module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeModelState queries the current state of the fee model.
+	FeeModelState(context.Context, *QueryFeeModelStateRequest) (*QueryFeeModelStateResponse, error)
+	// RecommendedGasPrice queries the range of the min gas prices projected by the fee model over the next blocks.
+	RecommendedGasPrice(context.Context, *QueryRecommendedGasPriceRequest) (*QueryRecommendedGasPriceResponse, error)
+	// MinGasPriceHistory queries the min gas prices and EMA values recorded for the range of the recent blocks.
+	MinGasPriceHistory(context.Context, *QueryMinGasPriceHistoryRequest) (*QueryMinGasPriceHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -295,6 +695,15 @@ func (*UnimplementedQueryServer) MinGasPrice(ctx context.Context, req *QueryMinG
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) FeeModelState(ctx context.Context, req *QueryFeeModelStateRequest) (*QueryFeeModelStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeModelState not implemented")
+}
+func (*UnimplementedQueryServer) RecommendedGasPrice(ctx context.Context, req *QueryRecommendedGasPriceRequest) (*QueryRecommendedGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendedGasPrice not implemented")
+}
+func (*UnimplementedQueryServer) MinGasPriceHistory(ctx context.Context, req *QueryMinGasPriceHistoryRequest) (*QueryMinGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinGasPriceHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -336,6 +745,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeModelState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeModelStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeModelState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/FeeModelState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeModelState(ctx, req.(*QueryFeeModelStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecommendedGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecommendedGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecommendedGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/RecommendedGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecommendedGasPrice(ctx, req.(*QueryRecommendedGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinGasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinGasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinGasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/MinGasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinGasPriceHistory(ctx, req.(*QueryMinGasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.feemodel.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -348,6 +811,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "FeeModelState",
+			Handler:    _Query_FeeModelState_Handler,
+		},
+		{
+			MethodName: "RecommendedGasPrice",
+			Handler:    _Query_RecommendedGasPrice_Handler,
+		},
+		{
+			MethodName: "MinGasPriceHistory",
+			Handler:    _Query_MinGasPriceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/feemodel/v1/query.proto",
@@ -465,13 +940,235 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFeeModelStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeModelStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeModelStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeModelStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeModelStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeModelStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EscalationStartBlockGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EscalationStartBlockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CurrentBlockGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LongEmaGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LongEmaGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShortEmaGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShortEmaGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AfterBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.High.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Med.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Low.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinGasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinGasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -516,6 +1213,96 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeModelStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeModelStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortEmaGas != 0 {
+		n += 1 + sovQuery(uint64(m.ShortEmaGas))
+	}
+	if m.LongEmaGas != 0 {
+		n += 1 + sovQuery(uint64(m.LongEmaGas))
+	}
+	if m.CurrentBlockGas != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentBlockGas))
+	}
+	if m.EscalationStartBlockGas != 0 {
+		n += 1 + sovQuery(uint64(m.EscalationStartBlockGas))
+	}
+	return n
+}
+
+func (m *QueryRecommendedGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AfterBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AfterBlocks))
+	}
+	return n
+}
+
+func (m *QueryRecommendedGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Low.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Med.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMinGasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryMinGasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextHeight))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -788,6 +1575,591 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeModelStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeModelStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeModelStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeModelStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeModelStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeModelStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortEmaGas", wireType)
+			}
+			m.ShortEmaGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortEmaGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongEmaGas", wireType)
+			}
+			m.LongEmaGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LongEmaGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBlockGas", wireType)
+			}
+			m.CurrentBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentBlockGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscalationStartBlockGas", wireType)
+			}
+			m.EscalationStartBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscalationStartBlockGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecommendedGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecommendedGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecommendedGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterBlocks", wireType)
+			}
+			m.AfterBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecommendedGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecommendedGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecommendedGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Med", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Med.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinGasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinGasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, MinGasPriceHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
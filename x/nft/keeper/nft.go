@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CoreumFoundation/coreum/x/nft"
 )
@@ -138,6 +139,35 @@ func (k Keeper) GetNFTsOfClass(ctx sdk.Context, classID string) (nfts []nft.NFT)
 	return nfts
 }
 
+// GetNFTsOfOwner returns the paginated nfts held by the owner using the owner index.
+// If classID is not empty only the nfts of that class are returned.
+func (k Keeper) GetNFTsOfOwner(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	classID string,
+	pagination *query.PageRequest,
+) ([]nft.NFT, *query.PageResponse, error) {
+	var nfts []nft.NFT
+	if classID != "" {
+		pageRes, err := query.Paginate(k.getClassStoreByOwner(ctx, owner, classID), pagination, func(key, _ []byte) error {
+			if n, has := k.GetNFT(ctx, classID, string(key)); has {
+				nfts = append(nfts, n)
+			}
+			return nil
+		})
+		return nfts, pageRes, err
+	}
+
+	pageRes, err := query.Paginate(k.prefixStoreNftOfClassByOwner(ctx, owner), pagination, func(key, _ []byte) error {
+		classID, nftID := parseNftOfClassByOwnerStoreKey(key)
+		if n, has := k.GetNFT(ctx, classID, nftID); has {
+			nfts = append(nfts, n)
+		}
+		return nil
+	})
+	return nfts, pageRes, err
+}
+
 // GetOwner returns the owner information of the specified nft.
 func (k Keeper) GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress {
 	store := ctx.KVStore(k.storeKey)
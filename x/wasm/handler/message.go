@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"encoding/base64"
 	"encoding/json"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -17,14 +18,23 @@ import (
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetFTMsg struct {
-	Issue               *assetfttypes.MsgIssue               `json:"Issue"`
-	Mint                *assetfttypes.MsgMint                `json:"Mint"`
-	Burn                *assetfttypes.MsgBurn                `json:"Burn"`
-	Freeze              *assetfttypes.MsgFreeze              `json:"Freeze"`
-	Unfreeze            *assetfttypes.MsgUnfreeze            `json:"Unfreeze"`
-	GloballyFreeze      *assetfttypes.MsgGloballyFreeze      `json:"GloballyFreeze"`
-	GloballyUnfreeze    *assetfttypes.MsgGloballyUnfreeze    `json:"GloballyUnfreeze"`
-	SetWhitelistedLimit *assetfttypes.MsgSetWhitelistedLimit `json:"SetWhitelistedLimit"`
+	Issue                      *assetfttypes.MsgIssue                      `json:"Issue"`
+	Mint                       *assetfttypes.MsgMint                       `json:"Mint"`
+	Burn                       *assetfttypes.MsgBurn                       `json:"Burn"`
+	Freeze                     *assetfttypes.MsgFreeze                     `json:"Freeze"`
+	Unfreeze                   *assetfttypes.MsgUnfreeze                   `json:"Unfreeze"`
+	GloballyFreeze             *assetfttypes.MsgGloballyFreeze             `json:"GloballyFreeze"`
+	GloballyUnfreeze           *assetfttypes.MsgGloballyUnfreeze           `json:"GloballyUnfreeze"`
+	SetWhitelistedLimit        *assetfttypes.MsgSetWhitelistedLimit        `json:"SetWhitelistedLimit"`
+	Clawback                   *assetfttypes.MsgClawback                   `json:"Clawback"`
+	UpdateMetadata             *assetfttypes.MsgUpdateMetadata             `json:"UpdateMetadata"`
+	TransferAdmin              *assetfttypes.MsgTransferAdmin              `json:"TransferAdmin"`
+	ClearAdmin                 *assetfttypes.MsgClearAdmin                 `json:"ClearAdmin"`
+	CreateVestingSchedule      *assetfttypes.MsgCreateVestingSchedule      `json:"CreateVestingSchedule"`
+	GrantMintAllowance         *assetfttypes.MsgGrantMintAllowance         `json:"GrantMintAllowance"`
+	RevokeMintAllowance        *assetfttypes.MsgRevokeMintAllowance        `json:"RevokeMintAllowance"`
+	SetRateExemption           *assetfttypes.MsgSetRateExemption           `json:"SetRateExemption"`
+	UpdateCommissionRecipients *assetfttypes.MsgUpdateCommissionRecipients `json:"UpdateCommissionRecipients"`
 }
 
 // assetNFTMsgIssueClass defines message for the IssueClass method with string represented data field.
@@ -39,30 +49,92 @@ type assetNFTMsgIssueClass struct {
 	Data        string                       `json:"data"`
 	Features    []assetnfttypes.ClassFeature `json:"features"`
 	RoyaltyRate sdk.Dec                      `json:"royalty_rate"`
+	MaxSupply   uint64                       `json:"max_supply"`
+	MintFee     *sdk.Coin                    `json:"mint_fee"`
+	DataSchema  *assetnfttypes.DataSchema    `json:"data_schema"`
 }
 
 // assetNFTMsgMint defines message for the Mint method with string represented data field.
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetNFTMsgMint struct {
+	ClassID   string `json:"class_id"`
+	ID        string `json:"id"`
+	URI       string `json:"uri"`
+	URIHash   string `json:"uri_hash"`
+	Data      string `json:"data"`
+	Recipient string `json:"recipient"`
+	// DataTypeURL is the type of the data, if it is set the data is the base64 encoded protobuf message of that type.
+	DataTypeURL string `json:"data_type_url"`
+}
+
+// assetNFTMsgUpdateNFT defines message for the UpdateNFT method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMsgUpdateNFT struct {
+	ClassID string `json:"class_id"`
+	ID      string `json:"id"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+	Data    string `json:"data"`
+	// DataTypeURL is the type of the data, if it is set the data is the base64 encoded protobuf message of that type.
+	DataTypeURL string `json:"data_type_url"`
+}
+
+// assetNFTMsgUpdateClass defines message for the UpdateClass method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMsgUpdateClass struct {
 	ClassID string `json:"class_id"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+	Data    string `json:"data"`
+}
+
+// assetNFTMsgMintBatchItem defines a single item of the MintBatch method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMsgMintBatchItem struct {
 	ID      string `json:"id"`
 	URI     string `json:"uri"`
 	URIHash string `json:"uri_hash"`
 	Data    string `json:"data"`
+	// DataTypeURL is the type of the data, if it is set the data is the base64 encoded protobuf message of that type.
+	DataTypeURL string `json:"data_type_url"`
+}
+
+// assetNFTMsgMintBatch defines message for the MintBatch method with string represented data fields.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMsgMintBatch struct {
+	ClassID string                     `json:"class_id"`
+	Items   []assetNFTMsgMintBatchItem `json:"items"`
 }
 
 // assetNFTMsg represents asset nft module messages integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetNFTMsg struct {
-	IssueClass          *assetNFTMsgIssueClass                `json:"IssueClass"`
-	Mint                *assetNFTMsgMint                      `json:"Mint"`
-	Burn                *assetnfttypes.MsgBurn                `json:"Burn"`
-	Freeze              *assetnfttypes.MsgFreeze              `json:"Freeze"`
-	Unfreeze            *assetnfttypes.MsgUnfreeze            `json:"Unfreeze"`
-	AddToWhitelist      *assetnfttypes.MsgAddToWhitelist      `json:"AddToWhitelist"`
-	RemoveFromWhitelist *assetnfttypes.MsgRemoveFromWhitelist `json:"RemoveFromWhitelist"`
+	IssueClass               *assetNFTMsgIssueClass                     `json:"IssueClass"`
+	Mint                     *assetNFTMsgMint                           `json:"Mint"`
+	Burn                     *assetnfttypes.MsgBurn                     `json:"Burn"`
+	Freeze                   *assetnfttypes.MsgFreeze                   `json:"Freeze"`
+	Unfreeze                 *assetnfttypes.MsgUnfreeze                 `json:"Unfreeze"`
+	AddToWhitelist           *assetnfttypes.MsgAddToWhitelist           `json:"AddToWhitelist"`
+	RemoveFromWhitelist      *assetnfttypes.MsgRemoveFromWhitelist      `json:"RemoveFromWhitelist"`
+	MintBatch                *assetNFTMsgMintBatch                      `json:"MintBatch"`
+	BurnBatch                *assetnfttypes.MsgBurnBatch                `json:"BurnBatch"`
+	SendBatch                *assetnfttypes.MsgSendBatch                `json:"SendBatch"`
+	UpdateNFT                *assetNFTMsgUpdateNFT                      `json:"UpdateNFT"`
+	UpdateClass              *assetNFTMsgUpdateClass                    `json:"UpdateClass"`
+	ClassFreeze              *assetnfttypes.MsgClassFreeze              `json:"ClassFreeze"`
+	ClassUnfreeze            *assetnfttypes.MsgClassUnfreeze            `json:"ClassUnfreeze"`
+	AddToClassWhitelist      *assetnfttypes.MsgAddToClassWhitelist      `json:"AddToClassWhitelist"`
+	RemoveFromClassWhitelist *assetnfttypes.MsgRemoveFromClassWhitelist `json:"RemoveFromClassWhitelist"`
+	Revoke                   *assetnfttypes.MsgRevoke                   `json:"Revoke"`
+	SetUser                  *assetnfttypes.MsgSetUser                  `json:"SetUser"`
+	GrantMinter              *assetnfttypes.MsgGrantMinter              `json:"GrantMinter"`
+	RevokeMinter             *assetnfttypes.MsgRevokeMinter             `json:"RevokeMinter"`
 }
 
 // nftMsg represents nft module messages integrated with the wasm handler.
@@ -155,6 +227,42 @@ func decodeAssetFTMessage(assetFTMsg *assetFTMsg, sender string) (sdk.Msg, error
 		assetFTMsg.SetWhitelistedLimit.Sender = sender
 		return assetFTMsg.SetWhitelistedLimit, nil
 	}
+	if assetFTMsg.Clawback != nil {
+		assetFTMsg.Clawback.Sender = sender
+		return assetFTMsg.Clawback, nil
+	}
+	if assetFTMsg.UpdateMetadata != nil {
+		assetFTMsg.UpdateMetadata.Sender = sender
+		return assetFTMsg.UpdateMetadata, nil
+	}
+	if assetFTMsg.TransferAdmin != nil {
+		assetFTMsg.TransferAdmin.Sender = sender
+		return assetFTMsg.TransferAdmin, nil
+	}
+	if assetFTMsg.ClearAdmin != nil {
+		assetFTMsg.ClearAdmin.Sender = sender
+		return assetFTMsg.ClearAdmin, nil
+	}
+	if assetFTMsg.CreateVestingSchedule != nil {
+		assetFTMsg.CreateVestingSchedule.Sender = sender
+		return assetFTMsg.CreateVestingSchedule, nil
+	}
+	if assetFTMsg.GrantMintAllowance != nil {
+		assetFTMsg.GrantMintAllowance.Sender = sender
+		return assetFTMsg.GrantMintAllowance, nil
+	}
+	if assetFTMsg.RevokeMintAllowance != nil {
+		assetFTMsg.RevokeMintAllowance.Sender = sender
+		return assetFTMsg.RevokeMintAllowance, nil
+	}
+	if assetFTMsg.SetRateExemption != nil {
+		assetFTMsg.SetRateExemption.Sender = sender
+		return assetFTMsg.SetRateExemption, nil
+	}
+	if assetFTMsg.UpdateCommissionRecipients != nil {
+		assetFTMsg.UpdateCommissionRecipients.Sender = sender
+		return assetFTMsg.UpdateCommissionRecipients, nil
+	}
 
 	return nil, nil
 }
@@ -181,6 +289,9 @@ func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, er
 			Data:        data,
 			Features:    assetNFTMsg.IssueClass.Features,
 			RoyaltyRate: assetNFTMsg.IssueClass.RoyaltyRate,
+			MaxSupply:   assetNFTMsg.IssueClass.MaxSupply,
+			MintFee:     assetNFTMsg.IssueClass.MintFee,
+			DataSchema:  assetNFTMsg.IssueClass.DataSchema,
 		}, nil
 	}
 	if assetNFTMsg.Mint != nil {
@@ -189,18 +300,19 @@ func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, er
 			err  error
 		)
 		if assetNFTMsg.Mint.Data != "" {
-			data, err = convertStringToDataBytes(assetNFTMsg.Mint.Data)
+			data, err = convertStringToNFTData(assetNFTMsg.Mint.Data, assetNFTMsg.Mint.DataTypeURL)
 			if err != nil {
 				return nil, err
 			}
 		}
 		return &assetnfttypes.MsgMint{
-			Sender:  sender,
-			ClassID: assetNFTMsg.Mint.ClassID,
-			ID:      assetNFTMsg.Mint.ID,
-			URI:     assetNFTMsg.Mint.URI,
-			URIHash: assetNFTMsg.Mint.URIHash,
-			Data:    data,
+			Sender:    sender,
+			ClassID:   assetNFTMsg.Mint.ClassID,
+			ID:        assetNFTMsg.Mint.ID,
+			URI:       assetNFTMsg.Mint.URI,
+			URIHash:   assetNFTMsg.Mint.URIHash,
+			Data:      data,
+			Recipient: assetNFTMsg.Mint.Recipient,
 		}, nil
 	}
 	if assetNFTMsg.Burn != nil {
@@ -215,6 +327,38 @@ func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, er
 		assetNFTMsg.Unfreeze.Sender = sender
 		return assetNFTMsg.Unfreeze, nil
 	}
+	if assetNFTMsg.ClassFreeze != nil {
+		assetNFTMsg.ClassFreeze.Sender = sender
+		return assetNFTMsg.ClassFreeze, nil
+	}
+	if assetNFTMsg.ClassUnfreeze != nil {
+		assetNFTMsg.ClassUnfreeze.Sender = sender
+		return assetNFTMsg.ClassUnfreeze, nil
+	}
+	if assetNFTMsg.AddToClassWhitelist != nil {
+		assetNFTMsg.AddToClassWhitelist.Sender = sender
+		return assetNFTMsg.AddToClassWhitelist, nil
+	}
+	if assetNFTMsg.RemoveFromClassWhitelist != nil {
+		assetNFTMsg.RemoveFromClassWhitelist.Sender = sender
+		return assetNFTMsg.RemoveFromClassWhitelist, nil
+	}
+	if assetNFTMsg.Revoke != nil {
+		assetNFTMsg.Revoke.Sender = sender
+		return assetNFTMsg.Revoke, nil
+	}
+	if assetNFTMsg.SetUser != nil {
+		assetNFTMsg.SetUser.Sender = sender
+		return assetNFTMsg.SetUser, nil
+	}
+	if assetNFTMsg.GrantMinter != nil {
+		assetNFTMsg.GrantMinter.Sender = sender
+		return assetNFTMsg.GrantMinter, nil
+	}
+	if assetNFTMsg.RevokeMinter != nil {
+		assetNFTMsg.RevokeMinter.Sender = sender
+		return assetNFTMsg.RevokeMinter, nil
+	}
 	if assetNFTMsg.AddToWhitelist != nil {
 		assetNFTMsg.AddToWhitelist.Sender = sender
 		return assetNFTMsg.AddToWhitelist, nil
@@ -223,6 +367,79 @@ func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, er
 		assetNFTMsg.RemoveFromWhitelist.Sender = sender
 		return assetNFTMsg.RemoveFromWhitelist, nil
 	}
+	if assetNFTMsg.MintBatch != nil {
+		items := make([]assetnfttypes.MintBatchItem, 0, len(assetNFTMsg.MintBatch.Items))
+		for _, item := range assetNFTMsg.MintBatch.Items {
+			var (
+				data *codectypes.Any
+				err  error
+			)
+			if item.Data != "" {
+				data, err = convertStringToNFTData(item.Data, item.DataTypeURL)
+				if err != nil {
+					return nil, err
+				}
+			}
+			items = append(items, assetnfttypes.MintBatchItem{
+				ID:      item.ID,
+				URI:     item.URI,
+				URIHash: item.URIHash,
+				Data:    data,
+			})
+		}
+		return &assetnfttypes.MsgMintBatch{
+			Sender:  sender,
+			ClassID: assetNFTMsg.MintBatch.ClassID,
+			Items:   items,
+		}, nil
+	}
+	if assetNFTMsg.BurnBatch != nil {
+		assetNFTMsg.BurnBatch.Sender = sender
+		return assetNFTMsg.BurnBatch, nil
+	}
+	if assetNFTMsg.SendBatch != nil {
+		assetNFTMsg.SendBatch.Sender = sender
+		return assetNFTMsg.SendBatch, nil
+	}
+	if assetNFTMsg.UpdateNFT != nil {
+		var (
+			data *codectypes.Any
+			err  error
+		)
+		if assetNFTMsg.UpdateNFT.Data != "" {
+			data, err = convertStringToNFTData(assetNFTMsg.UpdateNFT.Data, assetNFTMsg.UpdateNFT.DataTypeURL)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &assetnfttypes.MsgUpdateNFT{
+			Sender:  sender,
+			ClassID: assetNFTMsg.UpdateNFT.ClassID,
+			ID:      assetNFTMsg.UpdateNFT.ID,
+			URI:     assetNFTMsg.UpdateNFT.URI,
+			URIHash: assetNFTMsg.UpdateNFT.URIHash,
+			Data:    data,
+		}, nil
+	}
+	if assetNFTMsg.UpdateClass != nil {
+		var (
+			data *codectypes.Any
+			err  error
+		)
+		if assetNFTMsg.UpdateClass.Data != "" {
+			data, err = convertStringToDataBytes(assetNFTMsg.UpdateClass.Data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &assetnfttypes.MsgUpdateClass{
+			Sender:  sender,
+			ClassID: assetNFTMsg.UpdateClass.ClassID,
+			URI:     assetNFTMsg.UpdateClass.URI,
+			URIHash: assetNFTMsg.UpdateClass.URIHash,
+			Data:    data,
+		}, nil
+	}
 
 	return nil, nil
 }
@@ -235,3 +452,18 @@ func decodeNFTMessage(nftMsg *nftMsg, sender string) (sdk.Msg, error) {
 
 	return nil, nil
 }
+
+func convertStringToNFTData(dataString, typeURL string) (*codectypes.Any, error) {
+	if typeURL == "" {
+		return convertStringToDataBytes(dataString)
+	}
+
+	value, err := base64.StdEncoding.DecodeString(dataString)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid base64 encoded data")
+	}
+	return &codectypes.Any{
+		TypeUrl: typeURL,
+		Value:   value,
+	}, nil
+}
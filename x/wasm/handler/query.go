@@ -7,6 +7,7 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 
@@ -22,6 +23,9 @@ type assetFTQuery struct {
 	Token              *assetfttypes.QueryTokenRequest              `json:"Token"`
 	FrozenBalance      *assetfttypes.QueryFrozenBalanceRequest      `json:"FrozenBalance"`
 	WhitelistedBalance *assetfttypes.QueryWhitelistedBalanceRequest `json:"WhitelistedBalance"`
+	LockedBalance      *assetfttypes.QueryLockedBalanceRequest      `json:"LockedBalance"`
+	MintAllowance      *assetfttypes.QueryMintAllowanceRequest      `json:"MintAllowance"`
+	Holders            *assetfttypes.QueryHoldersRequest            `json:"Holders"`
 }
 
 // assetNFTClass is the asset nft Class with string data.
@@ -38,6 +42,9 @@ type assetNFTClass struct {
 	Data        string                       `json:"data"`
 	Features    []assetnfttypes.ClassFeature `json:"features"`
 	RoyaltyRate sdk.Dec                      `json:"royalty_rate"`
+	MaxSupply   uint64                       `json:"max_supply"`
+	MintFee     *sdk.Coin                    `json:"mint_fee"`
+	DataSchema  *assetnfttypes.DataSchema    `json:"data_schema"`
 }
 
 // assetNFTClassResponse is the asset nft Class response with string data.
@@ -45,13 +52,27 @@ type assetNFTClassResponse struct {
 	Class assetNFTClass `json:"class"`
 }
 
+// assetNFTClassesResponse is the asset nft Classes response with string data.
+type assetNFTClassesResponse struct {
+	Pagination *query.PageResponse `json:"pagination"`
+	Classes    []assetNFTClass     `json:"classes"`
+}
+
 // assetNFTQuery represents asset nft module queries integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetNFTQuery struct {
-	Class       *assetnfttypes.QueryClassRequest       `json:"Class"`
-	Frozen      *assetnfttypes.QueryFrozenRequest      `json:"Frozen"`
-	Whitelisted *assetnfttypes.QueryWhitelistedRequest `json:"Whitelisted"`
+	Class            *assetnfttypes.QueryClassRequest            `json:"Class"`
+	Classes          *assetnfttypes.QueryClassesRequest          `json:"Classes"`
+	Frozen           *assetnfttypes.QueryFrozenRequest           `json:"Frozen"`
+	Whitelisted      *assetnfttypes.QueryWhitelistedRequest      `json:"Whitelisted"`
+	ClassFrozen      *assetnfttypes.QueryClassFrozenRequest      `json:"ClassFrozen"`
+	OwnerNFTs        *assetnfttypes.QueryOwnerNFTsRequest        `json:"OwnerNFTs"`
+	ClassWhitelisted *assetnfttypes.QueryClassWhitelistedRequest `json:"ClassWhitelisted"`
+	User             *assetnfttypes.QueryUserRequest             `json:"User"`
+	ClassSupply      *assetnfttypes.QueryClassSupplyRequest      `json:"ClassSupply"`
+	ClassMinters     *assetnfttypes.QueryClassMintersRequest     `json:"ClassMinters"`
+	NFTData          *assetnfttypes.QueryNFTDataRequest          `json:"NFTData"`
 }
 
 // nft is the nft with string data.
@@ -151,6 +172,21 @@ func processAssetFTQuery(ctx sdk.Context, assetFTQuery *assetFTQuery, assetFTQue
 			return assetFTQueryServer.WhitelistedBalance(ctx, req)
 		})
 	}
+	if assetFTQuery.LockedBalance != nil {
+		return executeQuery(ctx, assetFTQuery.LockedBalance, func(ctx context.Context, req *assetfttypes.QueryLockedBalanceRequest) (*assetfttypes.QueryLockedBalanceResponse, error) {
+			return assetFTQueryServer.LockedBalance(ctx, req)
+		})
+	}
+	if assetFTQuery.MintAllowance != nil {
+		return executeQuery(ctx, assetFTQuery.MintAllowance, func(ctx context.Context, req *assetfttypes.QueryMintAllowanceRequest) (*assetfttypes.QueryMintAllowanceResponse, error) {
+			return assetFTQueryServer.MintAllowance(ctx, req)
+		})
+	}
+	if assetFTQuery.Holders != nil {
+		return executeQuery(ctx, assetFTQuery.Holders, func(ctx context.Context, req *assetfttypes.QueryHoldersRequest) (*assetfttypes.QueryHoldersResponse, error) {
+			return assetFTQueryServer.Holders(ctx, req)
+		})
+	}
 
 	return nil, nil
 }
@@ -163,26 +199,33 @@ func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNF
 				return nil, err
 			}
 
-			var dataString string
-			if classRes.Class.Data != nil {
-				dataString, err = unmarshalDataBytes(classRes.Class.Data)
+			class, err := newAssetNFTClass(classRes.Class)
+			if err != nil {
+				return nil, err
+			}
+			return &assetNFTClassResponse{
+				Class: class,
+			}, nil
+		})
+	}
+	if assetNFTQuery.Classes != nil {
+		return executeQuery(ctx, assetNFTQuery.Classes, func(ctx context.Context, req *assetnfttypes.QueryClassesRequest) (*assetNFTClassesResponse, error) {
+			classesRes, err := assetNFTQueryServer.Classes(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			classes := make([]assetNFTClass, 0, len(classesRes.Classes))
+			for _, c := range classesRes.Classes {
+				class, err := newAssetNFTClass(c)
 				if err != nil {
 					return nil, err
 				}
+				classes = append(classes, class)
 			}
-			return &assetNFTClassResponse{
-				Class: assetNFTClass{
-					ID:          classRes.Class.Id,
-					Issuer:      classRes.Class.Issuer,
-					Name:        classRes.Class.Name,
-					Symbol:      classRes.Class.Symbol,
-					Description: classRes.Class.Description,
-					URI:         classRes.Class.URI,
-					URIHash:     classRes.Class.URIHash,
-					Data:        dataString,
-					Features:    classRes.Class.Features,
-					RoyaltyRate: classRes.Class.RoyaltyRate,
-				},
+			return &assetNFTClassesResponse{
+				Pagination: classesRes.Pagination,
+				Classes:    classes,
 			}, nil
 		})
 	}
@@ -191,6 +234,41 @@ func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNF
 			return assetNFTQueryServer.Frozen(ctx, req)
 		})
 	}
+	if assetNFTQuery.ClassFrozen != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassFrozen, func(ctx context.Context, req *assetnfttypes.QueryClassFrozenRequest) (*assetnfttypes.QueryClassFrozenResponse, error) {
+			return assetNFTQueryServer.ClassFrozen(ctx, req)
+		})
+	}
+	if assetNFTQuery.OwnerNFTs != nil {
+		return executeQuery(ctx, assetNFTQuery.OwnerNFTs, func(ctx context.Context, req *assetnfttypes.QueryOwnerNFTsRequest) (*assetnfttypes.QueryOwnerNFTsResponse, error) {
+			return assetNFTQueryServer.OwnerNFTs(ctx, req)
+		})
+	}
+	if assetNFTQuery.ClassWhitelisted != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassWhitelisted, func(ctx context.Context, req *assetnfttypes.QueryClassWhitelistedRequest) (*assetnfttypes.QueryClassWhitelistedResponse, error) {
+			return assetNFTQueryServer.ClassWhitelisted(ctx, req)
+		})
+	}
+	if assetNFTQuery.User != nil {
+		return executeQuery(ctx, assetNFTQuery.User, func(ctx context.Context, req *assetnfttypes.QueryUserRequest) (*assetnfttypes.QueryUserResponse, error) {
+			return assetNFTQueryServer.User(ctx, req)
+		})
+	}
+	if assetNFTQuery.ClassSupply != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassSupply, func(ctx context.Context, req *assetnfttypes.QueryClassSupplyRequest) (*assetnfttypes.QueryClassSupplyResponse, error) {
+			return assetNFTQueryServer.ClassSupply(ctx, req)
+		})
+	}
+	if assetNFTQuery.ClassMinters != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassMinters, func(ctx context.Context, req *assetnfttypes.QueryClassMintersRequest) (*assetnfttypes.QueryClassMintersResponse, error) {
+			return assetNFTQueryServer.ClassMinters(ctx, req)
+		})
+	}
+	if assetNFTQuery.NFTData != nil {
+		return executeQuery(ctx, assetNFTQuery.NFTData, func(ctx context.Context, req *assetnfttypes.QueryNFTDataRequest) (*assetnfttypes.QueryNFTDataResponse, error) {
+			return assetNFTQueryServer.NFTData(ctx, req)
+		})
+	}
 	if assetNFTQuery.Whitelisted != nil {
 		return executeQuery(ctx, assetNFTQuery.Whitelisted, func(ctx context.Context, req *assetnfttypes.QueryWhitelistedRequest) (*assetnfttypes.QueryWhitelistedResponse, error) {
 			return assetNFTQueryServer.Whitelisted(ctx, req)
@@ -275,3 +353,30 @@ func unmarshalDataBytes(data *codectypes.Any) (string, error) {
 
 	return string(dataBytes.Data), nil
 }
+
+func newAssetNFTClass(class assetnfttypes.Class) (assetNFTClass, error) {
+	var dataString string
+	if class.Data != nil {
+		var err error
+		dataString, err = unmarshalDataBytes(class.Data)
+		if err != nil {
+			return assetNFTClass{}, err
+		}
+	}
+
+	return assetNFTClass{
+		ID:          class.Id,
+		Issuer:      class.Issuer,
+		Name:        class.Name,
+		Symbol:      class.Symbol,
+		Description: class.Description,
+		URI:         class.URI,
+		URIHash:     class.URIHash,
+		Data:        dataString,
+		Features:    class.Features,
+		RoyaltyRate: class.RoyaltyRate,
+		MaxSupply:   class.MaxSupply,
+		MintFee:     class.MintFee,
+		DataSchema:  class.DataSchema,
+	}, nil
+}
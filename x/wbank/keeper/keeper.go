@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -86,7 +87,12 @@ func (k BaseKeeperWrapper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAd
 		return err
 	}
 
-	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	if err := k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	k.ftProvider.AfterSendCoins(ctx, fromAddr, toAddr, amt)
+	return nil
 }
 
 // InputOutputCoins is a BaseKeeper InputOutputCoins wrapped method.
@@ -95,5 +101,88 @@ func (k BaseKeeperWrapper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.
 		return err
 	}
 
-	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+	if err := k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
+	k.ftProvider.AfterInputOutputCoins(ctx, inputs, outputs)
+	return nil
+}
+
+// MintCoins is a BaseKeeper MintCoins wrapped method.
+func (k BaseKeeperWrapper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	if err := k.BaseKeeper.MintCoins(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+
+	k.ftProvider.AfterBalanceChange(ctx, k.ak.GetModuleAddress(moduleName), amounts)
+	return nil
+}
+
+// BurnCoins is a BaseKeeper BurnCoins wrapped method.
+func (k BaseKeeperWrapper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	if err := k.BaseKeeper.BurnCoins(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+
+	k.ftProvider.AfterBalanceChange(ctx, k.ak.GetModuleAddress(moduleName), amounts)
+	return nil
+}
+
+// DelegateCoinsFromAccountToModule delegates coins and transfers them from a
+// delegator account to a module account.
+// It will panic if the module account does not exist or is unauthorized.
+// !!! The code is the copy of the corresponding func of the bank module !!!
+func (k BaseKeeperWrapper) DelegateCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+
+	if !recipientAcc.HasPermission(authtypes.Staking) {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to receive delegated coins", recipientModule))
+	}
+
+	return k.DelegateCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+}
+
+// UndelegateCoinsFromModuleToAccount undelegates the unbonding coins and transfers
+// them from a module account to the delegator account.
+// It will panic if the module account does not exist or is unauthorized.
+// !!! The code is the copy of the corresponding func of the bank module !!!
+func (k BaseKeeperWrapper) UndelegateCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	acc := k.ak.GetModuleAccount(ctx, senderModule)
+	if acc == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+
+	if !acc.HasPermission(authtypes.Staking) {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to undelegate coins", senderModule))
+	}
+
+	return k.UndelegateCoins(ctx, acc.GetAddress(), recipientAddr, amt)
+}
+
+// DelegateCoins is a BaseKeeper DelegateCoins wrapped method.
+func (k BaseKeeperWrapper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.BaseKeeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+
+	k.ftProvider.AfterSendCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+	return nil
+}
+
+// UndelegateCoins is a BaseKeeper UndelegateCoins wrapped method.
+func (k BaseKeeperWrapper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.BaseKeeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+
+	k.ftProvider.AfterSendCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+	return nil
 }
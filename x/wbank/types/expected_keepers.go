@@ -9,4 +9,7 @@ import (
 type FungibleTokenProvider interface {
 	BeforeSendCoins(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, coins sdk.Coins) error
 	BeforeInputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error
+	AfterSendCoins(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, coins sdk.Coins)
+	AfterInputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output)
+	AfterBalanceChange(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins)
 }
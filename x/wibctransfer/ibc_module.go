@@ -0,0 +1,54 @@
+package wibctransfer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	wibctransfertypes "github.com/CoreumFoundation/coreum/x/wibctransfer/types"
+)
+
+// IBCModule wraps the ICS-20 transfer IBC module to mark the purpose of the funds moved by the packet callbacks.
+type IBCModule struct {
+	porttypes.IBCModule
+}
+
+// NewIBCModule creates a new wrapped ICS-20 transfer IBC module.
+func NewIBCModule(keeper ibctransferkeeper.Keeper) IBCModule {
+	return IBCModule{
+		IBCModule: transfer.NewIBCModule(keeper),
+	}
+}
+
+// OnRecvPacket marks the funds received from another chain.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return im.IBCModule.OnRecvPacket(wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeIn), packet, relayer)
+}
+
+// OnAcknowledgementPacket marks the funds refunded after the packet was rejected.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.IBCModule.OnAcknowledgementPacket(
+		wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeAck), packet, acknowledgement, relayer,
+	)
+}
+
+// OnTimeoutPacket marks the funds refunded after the packet timed out.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.IBCModule.OnTimeoutPacket(wibctransfertypes.WithPurpose(ctx, wibctransfertypes.PurposeTimeout), packet, relayer)
+}
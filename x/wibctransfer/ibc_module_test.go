@@ -0,0 +1,76 @@
+package wibctransfer
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	wibctransfertypes "github.com/CoreumFoundation/coreum/x/wibctransfer/types"
+)
+
+// purposeRecorder records the context passed to the packet callbacks of the wrapped IBC module.
+type purposeRecorder struct {
+	porttypes.IBCModule
+	ctx sdk.Context
+}
+
+func (r *purposeRecorder) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	r.ctx = ctx
+	return channeltypes.NewResultAcknowledgement([]byte{0x01})
+}
+
+func (r *purposeRecorder) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	r.ctx = ctx
+	return nil
+}
+
+func (r *purposeRecorder) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	r.ctx = ctx
+	return nil
+}
+
+func TestIBCModule_Purpose(t *testing.T) {
+	requireT := require.New(t)
+
+	ctx := sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB()), tmproto.Header{}, false, log.NewNopLogger())
+	recorder := &purposeRecorder{}
+	ibcModule := IBCModule{IBCModule: recorder}
+
+	ack := ibcModule.OnRecvPacket(ctx, channeltypes.Packet{}, nil)
+	requireT.True(ack.Success())
+	requireT.True(wibctransfertypes.IsPurposeIn(recorder.ctx))
+	requireT.False(wibctransfertypes.IsPurposeRefund(recorder.ctx))
+
+	requireT.NoError(ibcModule.OnAcknowledgementPacket(ctx, channeltypes.Packet{}, nil, nil))
+	purpose, ok := wibctransfertypes.GetPurpose(recorder.ctx)
+	requireT.True(ok)
+	requireT.Equal(wibctransfertypes.PurposeAck, purpose)
+	requireT.True(wibctransfertypes.IsPurposeRefund(recorder.ctx))
+
+	requireT.NoError(ibcModule.OnTimeoutPacket(ctx, channeltypes.Packet{}, nil))
+	purpose, ok = wibctransfertypes.GetPurpose(recorder.ctx)
+	requireT.True(ok)
+	requireT.Equal(wibctransfertypes.PurposeTimeout, purpose)
+	requireT.True(wibctransfertypes.IsPurposeRefund(recorder.ctx))
+
+	// the purpose is not set on the original context
+	_, ok = wibctransfertypes.GetPurpose(ctx)
+	requireT.False(ok)
+}
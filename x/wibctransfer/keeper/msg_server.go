@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	wibctransfertypes "github.com/CoreumFoundation/coreum/x/wibctransfer/types"
+)
+
+// MsgServer is wrapper of the ibc transfer message server.
+type MsgServer struct {
+	ibctransfertypes.MsgServer
+}
+
+// NewMsgServerImpl returns an implementation of the ibc transfer wrapped MsgServer.
+func NewMsgServerImpl(transferMsgSrv ibctransfertypes.MsgServer) ibctransfertypes.MsgServer {
+	return MsgServer{
+		MsgServer: transferMsgSrv,
+	}
+}
+
+// Transfer defines wrapped method marking the funds sent by the transfer to be moved out of the chain.
+func (s MsgServer) Transfer(goCtx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	ctx := wibctransfertypes.WithPurpose(sdk.UnwrapSDKContext(goCtx), wibctransfertypes.PurposeOut)
+	return s.MsgServer.Transfer(sdk.WrapSDKContext(ctx), msg)
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CoreumFoundation/coreum/x/wibctransfer/keeper"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/x/wibctransfer/types"
+)
+
+// purposeRecorder records the context passed to the wrapped transfer message server.
+type purposeRecorder struct {
+	ibctransfertypes.MsgServer
+	ctx sdk.Context
+}
+
+func (r *purposeRecorder) Transfer(
+	goCtx context.Context,
+	msg *ibctransfertypes.MsgTransfer,
+) (*ibctransfertypes.MsgTransferResponse, error) {
+	r.ctx = sdk.UnwrapSDKContext(goCtx)
+	return &ibctransfertypes.MsgTransferResponse{}, nil
+}
+
+func TestMsgServer_Transfer(t *testing.T) {
+	requireT := require.New(t)
+
+	ctx := sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB()), tmproto.Header{}, false, log.NewNopLogger())
+	recorder := &purposeRecorder{}
+	msgServer := keeper.NewMsgServerImpl(recorder)
+
+	_, err := msgServer.Transfer(sdk.WrapSDKContext(ctx), &ibctransfertypes.MsgTransfer{})
+	requireT.NoError(err)
+	requireT.True(wibctransfertypes.IsPurposeOut(recorder.ctx))
+
+	// the purpose is not set on the original context
+	_, ok := wibctransfertypes.GetPurpose(ctx)
+	requireT.False(ok)
+}
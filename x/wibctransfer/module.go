@@ -0,0 +1,42 @@
+package wibctransfer
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/x/wibctransfer/keeper"
+)
+
+// AppModuleBasic defines the basic application module used by the wrapped ibc transfer module.
+type AppModuleBasic struct {
+	transfer.AppModuleBasic
+}
+
+// AppModule implements an application module for the wrapped ibc transfer module.
+type AppModule struct {
+	transfer.AppModule
+	keeper ibctransferkeeper.Keeper
+}
+
+// NewAppModule creates a new wrapped ibc transfer AppModule object.
+func NewAppModule(keeper ibctransferkeeper.Keeper) AppModule {
+	return AppModule{
+		AppModule: transfer.NewAppModule(keeper),
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	// wrap the ibc transfer message server to mark the funds sent out of the chain
+	ibctransfertypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	ibctransfertypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := ibctransferkeeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(ibctransfertypes.ModuleName, 1, m.MigrateTraces); err != nil {
+		panic(errors.Wrap(err, "can't register ibc transfer migration"))
+	}
+}
@@ -0,0 +1,15 @@
+package wibctransfer
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAppModuleOriginalTransferModule_GetConsensusVersion checks that the wrapped module still uses the same consensus version.
+func TestAppModuleOriginalTransferModule_GetConsensusVersion(t *testing.T) {
+	transferModule := transfer.NewAppModule(ibctransferkeeper.Keeper{})
+	require.Equal(t, uint64(2), transferModule.ConsensusVersion())
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Purpose defines the reason why the ICS-20 transfer module moves funds.
+type Purpose string
+
+// Purposes of the ICS-20 fund movements.
+const (
+	// PurposeOut is used when funds are escrowed or burnt to be sent to another chain.
+	PurposeOut Purpose = "ibcTransferOut"
+	// PurposeIn is used when funds are unescrowed or minted after being received from another chain.
+	PurposeIn Purpose = "ibcTransferIn"
+	// PurposeAck is used when funds are refunded after the counterparty chain rejected the packet.
+	PurposeAck Purpose = "ibcTransferAck"
+	// PurposeTimeout is used when funds are refunded after the packet timed out.
+	PurposeTimeout Purpose = "ibcTransferTimeout"
+)
+
+type purposeKey struct{}
+
+// WithPurpose returns the context storing the purpose of the ICS-20 fund movement.
+func WithPurpose(ctx sdk.Context, purpose Purpose) sdk.Context {
+	return ctx.WithValue(purposeKey{}, purpose)
+}
+
+// GetPurpose returns the purpose of the ICS-20 fund movement stored in the context.
+// Returns false if funds are not moved by the ICS-20 transfer module.
+func GetPurpose(ctx sdk.Context) (Purpose, bool) {
+	purpose, ok := ctx.Value(purposeKey{}).(Purpose)
+	return purpose, ok
+}
+
+// IsPurposeOut returns true if funds are moved to be sent to another chain.
+func IsPurposeOut(ctx sdk.Context) bool {
+	return isPurpose(ctx, PurposeOut)
+}
+
+// IsPurposeIn returns true if funds are moved after being received from another chain.
+func IsPurposeIn(ctx sdk.Context) bool {
+	return isPurpose(ctx, PurposeIn)
+}
+
+// IsPurposeRefund returns true if funds are refunded because the packet was rejected or timed out.
+func IsPurposeRefund(ctx sdk.Context) bool {
+	return isPurpose(ctx, PurposeAck) || isPurpose(ctx, PurposeTimeout)
+}
+
+func isPurpose(ctx sdk.Context, expected Purpose) bool {
+	purpose, ok := GetPurpose(ctx)
+	return ok && purpose == expected
+}